@@ -0,0 +1,293 @@
+package libsql
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned by operations rejected by an open CircuitBreaker
+var ErrCircuitOpen = errors.New("libsql: circuit breaker is open")
+
+// CircuitState is the state of a CircuitBreaker
+type CircuitState int
+
+const (
+	// CircuitClosed is the state of a CircuitBreaker letting all operations through
+	CircuitClosed CircuitState = iota
+	// CircuitOpen is the state of a CircuitBreaker rejecting all operations with ErrCircuitOpen
+	CircuitOpen
+	// CircuitHalfOpen is the state of a CircuitBreaker letting probe operations through
+	// to decide whether to close again
+	CircuitHalfOpen
+)
+
+var circuitStateNames = map[CircuitState]string{
+	CircuitClosed:   "closed",
+	CircuitOpen:     "open",
+	CircuitHalfOpen: "half-open",
+}
+
+// String implements fmt.Stringer
+func (s CircuitState) String() string {
+	if name, ok := circuitStateNames[s]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// CircuitBreakerConfig configures a CircuitBreaker
+type CircuitBreakerConfig struct {
+	// Window is the period over which failures and slow operations are counted.
+	// The counts are reset at the end of each window. Defaults to 10 seconds
+	Window time.Duration
+
+	// MinOperations is the number of operations of a window from which the
+	// breaker may trip. Defaults to 20
+	MinOperations int
+
+	// FailureRate is the ratio of failed operations of a window tripping the breaker.
+	// Defaults to 0.5
+	FailureRate float64
+
+	// SlowDuration is the duration from which operations are slow.
+	// Zero disables tripping on slow operations
+	SlowDuration time.Duration
+
+	// SlowRate is the ratio of slow operations of a window tripping the breaker.
+	// Defaults to 0.5
+	SlowRate float64
+
+	// OpenDuration is how long the breaker stays open before half-opening. Defaults to 30 seconds
+	OpenDuration time.Duration
+
+	// HalfOpenProbes is the number of operations let through while half-open.
+	// The breaker closes once they all succeed, and opens again as soon as one fails.
+	// Defaults to 1
+	HalfOpenProbes int
+
+	// IsFailure reports whether an operation failing with err counts as a failure.
+	// Defaults to counting only connection errors and timeouts, see
+	// IsConnectionError, and not the errors of queries such as constraint
+	// violations nor context cancellations. For transactions only the errors of
+	// the operations performed in them, i.e. QueryErrors, count, to not count
+	// the errors of their work
+	IsFailure func(op *Operation, err error) bool
+
+	// OnStateChange is called when the state changes
+	OnStateChange func(from, to CircuitState)
+
+	// Now returns the current time. Defaults to time.Now
+	Now func() time.Time
+}
+
+// NewCircuitBreaker returns a CircuitBreaker with the default configuration.
+// It is a shorthand for CircuitBreakerConfig{}.New()
+func NewCircuitBreaker() *CircuitBreaker {
+	return CircuitBreakerConfig{}.New()
+}
+
+// New returns a CircuitBreaker with the configuration
+func (c CircuitBreakerConfig) New() *CircuitBreaker {
+	if c.Window <= 0 {
+		c.Window = 10 * time.Second
+	}
+	if c.MinOperations <= 0 {
+		c.MinOperations = 20
+	}
+	if c.FailureRate <= 0 {
+		c.FailureRate = 0.5
+	}
+	if c.SlowRate <= 0 {
+		c.SlowRate = 0.5
+	}
+	if c.OpenDuration <= 0 {
+		c.OpenDuration = 30 * time.Second
+	}
+	if c.HalfOpenProbes <= 0 {
+		c.HalfOpenProbes = 1
+	}
+	if c.IsFailure == nil {
+		c.IsFailure = isCircuitFailure
+	}
+	if c.Now == nil {
+		c.Now = time.Now
+	}
+	return &CircuitBreaker{cfg: c, windowStart: c.Now()}
+}
+
+// CircuitBreaker fails operations fast with ErrCircuitOpen once too many of
+// them failed or were slow, instead of letting them wait on an overloaded database.
+//
+// The breaker applies to the operations its Interceptor intercepts, except
+// the operations of transactions: their transactions as a whole are let
+// through or rejected. Apply it with WithCircuitBreaker, or add its Interceptor
+// first with WithInterceptors for rejected operations to skip the other interceptors.
+// CircuitBreaker is safe for concurrent use.
+type CircuitBreaker struct {
+	cfg CircuitBreakerConfig
+
+	mu          sync.Mutex
+	state       CircuitState
+	windowStart time.Time
+	operations  int
+	failures    int
+	slow        int
+	openedAt    time.Time
+	probes      int
+	probed      int
+	// generation is incremented on each state change, so that the outcomes of
+	// operations admitted in a previous state are not recorded
+	generation uint64
+}
+
+// State returns the current state of the breaker
+func (b *CircuitBreaker) State() CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == CircuitOpen && !b.cfg.Now().Before(b.openedAt.Add(b.cfg.OpenDuration)) {
+		return CircuitHalfOpen
+	}
+	return b.state
+}
+
+// Interceptor returns an Interceptor applying the breaker to the intercepted operations
+func (b *CircuitBreaker) Interceptor() Interceptor {
+	return b.intercept
+}
+
+// WithCircuitBreaker applies breaker to the operations of a Database.
+// Its Interceptor is added before the other interceptors, for rejected
+// operations to skip them. A breaker may be shared between Databases
+func WithCircuitBreaker(breaker *CircuitBreaker) Option {
+	return func(c *config) {
+		c.interceptors = append([]Interceptor{breaker.intercept}, c.interceptors...)
+	}
+}
+
+func (b *CircuitBreaker) intercept(ctx context.Context, op *Operation, next func(context.Context) error) error {
+	if op.InTransaction || op.Kind == OperationBegin {
+		// part of an OperationTransaction
+		return next(ctx)
+	}
+	generation, ok := b.admit()
+	if !ok {
+		return ErrCircuitOpen
+	}
+	start := b.cfg.Now()
+	err := next(ctx)
+	b.record(generation, b.cfg.IsFailure(op, err), b.cfg.SlowDuration > 0 && b.cfg.Now().Sub(start) >= b.cfg.SlowDuration)
+	return err
+}
+
+// admit reports whether an operation is let through, and the generation of
+// the state it is let through in
+func (b *CircuitBreaker) admit() (uint64, bool) {
+	b.mu.Lock()
+	var changed func()
+	defer func() {
+		b.mu.Unlock()
+		if changed != nil {
+			changed()
+		}
+	}()
+
+	switch b.state {
+	case CircuitOpen:
+		if b.cfg.Now().Before(b.openedAt.Add(b.cfg.OpenDuration)) {
+			return 0, false
+		}
+		changed = b.setState(CircuitHalfOpen)
+		fallthrough
+	case CircuitHalfOpen:
+		if b.probes >= b.cfg.HalfOpenProbes {
+			return 0, false
+		}
+		b.probes++
+	}
+	return b.generation, true
+}
+
+// record records the outcome of an operation let through in generation.
+// Outcomes of operations let through before the last state change are ignored:
+// e.g. a slow operation let through while closed is not a probe of the
+// half-open breaker.
+func (b *CircuitBreaker) record(generation uint64, failed, slow bool) {
+	b.mu.Lock()
+	var changed func()
+	defer func() {
+		b.mu.Unlock()
+		if changed != nil {
+			changed()
+		}
+	}()
+
+	if generation != b.generation {
+		return
+	}
+	switch b.state {
+	case CircuitHalfOpen:
+		if failed || slow {
+			changed = b.setState(CircuitOpen)
+			return
+		}
+		b.probed++
+		if b.probed >= b.cfg.HalfOpenProbes {
+			changed = b.setState(CircuitClosed)
+		}
+	case CircuitClosed:
+		now := b.cfg.Now()
+		if now.Sub(b.windowStart) >= b.cfg.Window {
+			b.resetWindow(now)
+		}
+		b.operations++
+		if failed {
+			b.failures++
+		}
+		if slow {
+			b.slow++
+		}
+		if b.operations >= b.cfg.MinOperations &&
+			(float64(b.failures) >= b.cfg.FailureRate*float64(b.operations) ||
+				float64(b.slow) >= b.cfg.SlowRate*float64(b.operations)) {
+			changed = b.setState(CircuitOpen)
+		}
+	}
+}
+
+// setState sets the state with b.mu held, returning the call of OnStateChange to perform once released
+func (b *CircuitBreaker) setState(state CircuitState) func() {
+	from := b.state
+	b.state = state
+	b.generation++
+	b.probes, b.probed = 0, 0
+	switch state {
+	case CircuitOpen:
+		b.openedAt = b.cfg.Now()
+	case CircuitClosed:
+		b.resetWindow(b.cfg.Now())
+	}
+	if b.cfg.OnStateChange == nil {
+		return nil
+	}
+	return func() {
+		b.cfg.OnStateChange(from, state)
+	}
+}
+
+func (b *CircuitBreaker) resetWindow(now time.Time) {
+	b.windowStart = now
+	b.operations, b.failures, b.slow = 0, 0, 0
+}
+
+// isCircuitFailure is the default CircuitBreakerConfig.IsFailure
+func isCircuitFailure(op *Operation, err error) bool {
+	if op.Kind == OperationTransaction {
+		var queryErr *QueryError
+		if !errors.As(err, &queryErr) {
+			return false
+		}
+	}
+	return isUnavailabilityError(err)
+}
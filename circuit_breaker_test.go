@@ -0,0 +1,273 @@
+package libsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type circuitStateChange struct {
+	from, to CircuitState
+}
+
+func newTestCircuitBreaker(clock *fakeClock, changes *[]circuitStateChange) *CircuitBreaker {
+	return CircuitBreakerConfig{
+		Window:         time.Minute,
+		MinOperations:  4,
+		FailureRate:    0.5,
+		SlowDuration:   time.Second,
+		OpenDuration:   30 * time.Second,
+		HalfOpenProbes: 2,
+		OnStateChange: func(from, to CircuitState) {
+			*changes = append(*changes, circuitStateChange{from: from, to: to})
+		},
+		Now: clock.Now,
+	}.New()
+}
+
+func Test_CircuitBreaker_TripsOnFailures(t *testing.T) {
+	ctx := context.Background()
+	clock := newFakeClock()
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	expErr := fmt.Errorf("a-test-error: %w", driver.ErrBadConn)
+	failing := true
+	sqlDB.ExecMock.Set(func(context.Context, string, ...interface{}) (sql.Result, error) {
+		if failing {
+			return nil, expErr
+		}
+		return NewSqlResultMock(t), nil
+	})
+
+	var changes []circuitStateChange
+	breaker := newTestCircuitBreaker(clock, &changes)
+	db := newDatabase(sqlDB, newConfig([]Option{WithCircuitBreaker(breaker)}))
+
+	update := func() error {
+		_, err := db.Update(ctx, "UPDATE aTable SET x = 1")
+		return err
+	}
+
+	// below MinOperations
+	for i := 0; i < 3; i++ {
+		require.ErrorIs(t, update(), expErr)
+	}
+	require.Equal(t, CircuitClosed, breaker.State())
+
+	require.ErrorIs(t, update(), expErr)
+	require.Equal(t, CircuitOpen, breaker.State())
+	require.ErrorIs(t, update(), ErrCircuitOpen)
+	require.Equal(t, uint64(4), sqlDB.ExecAfterCounter())
+
+	// half-open after OpenDuration, failing probes reopen the breaker
+	clock.Advance(30 * time.Second)
+	require.Equal(t, CircuitHalfOpen, breaker.State())
+	require.ErrorIs(t, update(), expErr)
+	require.Equal(t, CircuitOpen, breaker.State())
+
+	// successful probes close the breaker
+	clock.Advance(30 * time.Second)
+	failing = false
+	require.NoError(t, update())
+	require.Equal(t, CircuitHalfOpen, breaker.State())
+	require.NoError(t, update())
+	require.Equal(t, CircuitClosed, breaker.State())
+
+	require.Equal(t, []circuitStateChange{
+		{from: CircuitClosed, to: CircuitOpen},
+		{from: CircuitOpen, to: CircuitHalfOpen},
+		{from: CircuitHalfOpen, to: CircuitOpen},
+		{from: CircuitOpen, to: CircuitHalfOpen},
+		{from: CircuitHalfOpen, to: CircuitClosed},
+	}, changes)
+}
+
+func Test_CircuitBreaker_TripsOnSlowOperations(t *testing.T) {
+	clock := newFakeClock()
+	var changes []circuitStateChange
+	breaker := newTestCircuitBreaker(clock, &changes)
+	intercept := breaker.Interceptor()
+
+	slow := func(ctx context.Context) error {
+		clock.Advance(2 * time.Second)
+		return nil
+	}
+	fast := func(ctx context.Context) error {
+		return nil
+	}
+
+	op := &Operation{Kind: OperationScan}
+	require.NoError(t, intercept(context.Background(), op, fast))
+	require.NoError(t, intercept(context.Background(), op, slow))
+	require.NoError(t, intercept(context.Background(), op, fast))
+	require.Equal(t, CircuitClosed, breaker.State())
+	require.NoError(t, intercept(context.Background(), op, slow))
+	require.Equal(t, CircuitOpen, breaker.State())
+	require.Equal(t, ErrCircuitOpen, intercept(context.Background(), op, fast))
+}
+
+func Test_CircuitBreaker_IgnoresOutcomesOfPreviousStates(t *testing.T) {
+	ctx := context.Background()
+	clock := newFakeClock()
+	var changes []circuitStateChange
+	breaker := newTestCircuitBreaker(clock, &changes)
+	intercept := breaker.Interceptor()
+
+	op := &Operation{Kind: OperationScan}
+	succeed := func(context.Context) error { return nil }
+	fail := func(context.Context) error { return driver.ErrBadConn }
+
+	// an operation let through while closed finishes after the breaker half-opened
+	require.NoError(t, intercept(ctx, op, func(context.Context) error {
+		for i := 0; i < 4; i++ {
+			require.Equal(t, driver.ErrBadConn, intercept(ctx, op, fail))
+		}
+		require.Equal(t, CircuitOpen, breaker.State())
+
+		clock.Advance(30 * time.Second)
+		require.NoError(t, intercept(ctx, op, succeed))
+		return nil
+	}))
+	// it is not a probe, so the breaker waits for the second probe
+	require.Equal(t, CircuitHalfOpen, breaker.State())
+
+	require.NoError(t, intercept(ctx, op, succeed))
+	require.Equal(t, CircuitClosed, breaker.State())
+	require.Equal(t, []circuitStateChange{
+		{from: CircuitClosed, to: CircuitOpen},
+		{from: CircuitOpen, to: CircuitHalfOpen},
+		{from: CircuitHalfOpen, to: CircuitClosed},
+	}, changes)
+}
+
+func Test_CircuitBreaker_CountsPerWindow(t *testing.T) {
+	clock := newFakeClock()
+	var changes []circuitStateChange
+	breaker := newTestCircuitBreaker(clock, &changes)
+	intercept := breaker.Interceptor()
+
+	expErr := driver.ErrBadConn
+	op := &Operation{Kind: OperationUpdate}
+	for i := 0; i < 3; i++ {
+		require.Equal(t, expErr, intercept(context.Background(), op, func(context.Context) error { return expErr }))
+	}
+	clock.Advance(time.Minute)
+	require.Equal(t, expErr, intercept(context.Background(), op, func(context.Context) error { return expErr }))
+	require.Equal(t, CircuitClosed, breaker.State())
+	require.Empty(t, changes)
+}
+
+func Test_CircuitBreaker_Transactions(t *testing.T) {
+	ctx := context.Background()
+	clock := newFakeClock()
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	sqlTx := NewSqlTxMock(t)
+	defer sqlTx.MinimockFinish()
+
+	sqlDB.BeginMock.Return(sqlTx, nil)
+	sqlTx.RollbackMock.Return(nil)
+
+	var changes []circuitStateChange
+	breaker := newTestCircuitBreaker(clock, &changes)
+	db := newDatabase(sqlDB, newConfig([]Option{WithInterceptors(breaker.Interceptor())}))
+
+	// errors of the work of transactions are not failures
+	expErr := driver.ErrBadConn
+	for i := 0; i < 4; i++ {
+		require.Equal(t, expErr, db.Transaction(ctx, func(Transaction) error {
+			return expErr
+		}))
+	}
+	require.Equal(t, CircuitClosed, breaker.State())
+
+	// errors of the operations of transactions are
+	sqlTx.ExecMock.Return(nil, expErr)
+	for i := 0; i < 4; i++ {
+		err := db.Transaction(ctx, func(tx Transaction) error {
+			_, err := tx.Update(ctx, "UPDATE aTable SET x = 1")
+			return err
+		})
+		require.ErrorIs(t, err, expErr)
+	}
+	require.Equal(t, CircuitOpen, breaker.State())
+
+	err := db.Transaction(ctx, func(Transaction) error {
+		require.Fail(t, "the transaction was not rejected")
+		return nil
+	})
+	require.ErrorIs(t, err, ErrCircuitOpen)
+}
+
+func Test_CircuitBreaker_QueryErrorsAreNotFailures(t *testing.T) {
+	ctx := context.Background()
+	clock := newFakeClock()
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	expErr := errors.New("a-test-error")
+	sqlDB.ExecMock.Return(nil, expErr)
+
+	var changes []circuitStateChange
+	breaker := newTestCircuitBreaker(clock, &changes)
+	db := newDatabase(sqlDB, newConfig([]Option{WithCircuitBreaker(breaker)}))
+
+	for i := 0; i < 8; i++ {
+		_, err := db.Update(ctx, "UPDATE aTable SET x = 1")
+		require.ErrorIs(t, err, expErr)
+	}
+	require.Equal(t, CircuitClosed, breaker.State())
+}
+
+func Test_WithCircuitBreaker_InterceptsFirst(t *testing.T) {
+	ctx := context.Background()
+	clock := newFakeClock()
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	sqlDB.ExecMock.Return(nil, driver.ErrBadConn)
+
+	var changes []circuitStateChange
+	breaker := newTestCircuitBreaker(clock, &changes)
+	intercepted := 0
+	db := newDatabase(sqlDB, newConfig([]Option{
+		WithInterceptors(func(ctx context.Context, _ *Operation, next func(context.Context) error) error {
+			intercepted++
+			return next(ctx)
+		}),
+		WithCircuitBreaker(breaker),
+	}))
+
+	for i := 0; i < 5; i++ {
+		_, _ = db.Update(ctx, "UPDATE aTable SET x = 1")
+	}
+	require.Equal(t, CircuitOpen, breaker.State())
+	require.Equal(t, 4, intercepted)
+}
+
+func Test_isCircuitFailure(t *testing.T) {
+	op := &Operation{Kind: OperationScanOne}
+	require.False(t, isCircuitFailure(op, nil))
+	require.False(t, isCircuitFailure(op, ErrNoRows))
+	require.False(t, isCircuitFailure(op, context.Canceled))
+	require.False(t, isCircuitFailure(op, ErrCircuitOpen))
+	require.False(t, isCircuitFailure(op, errors.New("a-test-error")))
+	require.True(t, isCircuitFailure(op, context.DeadlineExceeded))
+	require.True(t, isCircuitFailure(op, driver.ErrBadConn))
+
+	tx := &Operation{Kind: OperationTransaction}
+	require.False(t, isCircuitFailure(tx, driver.ErrBadConn))
+	require.True(t, isCircuitFailure(tx, &QueryError{Op: OperationCommit, Err: driver.ErrBadConn}))
+}
@@ -0,0 +1,432 @@
+package libsql
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// WrapCluster returns a Database routing reads to replicas and everything else to primary.
+// It is a shorthand for ClusterConfig{}.Wrap(primary, replicas...)
+func WrapCluster(primary *sql.DB, replicas ...*sql.DB) Database {
+	return ClusterConfig{}.Wrap(primary, replicas...)
+}
+
+// ReadFromPrimary returns a context making reads performed with it go to the primary
+func ReadFromPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readFromPrimaryKey{}, true)
+}
+
+type readFromPrimaryKey struct{}
+
+func isReadFromPrimary(ctx context.Context) bool {
+	forced, _ := ctx.Value(readFromPrimaryKey{}).(bool)
+	return forced
+}
+
+// WithReadYourWrites returns a context starting a read-your-writes session.
+//
+// Successful writes performed with the returned context, or contexts derived from it,
+// through a Database returned by ClusterConfig are recorded in the session.
+// Subsequent reads in the session then avoid replicas that may not have applied
+// the writes yet, as configured by ClusterConfig.StickyPrimaryFor and
+// ClusterConfig.PrimaryPosition.
+// Writes are recorded by the Update methods and Transaction.
+func WithReadYourWrites(ctx context.Context) context.Context {
+	return context.WithValue(ctx, consistencySessionKey{}, &consistencySession{})
+}
+
+type consistencySessionKey struct{}
+
+func consistencySessionFrom(ctx context.Context) *consistencySession {
+	session, _ := ctx.Value(consistencySessionKey{}).(*consistencySession)
+	return session
+}
+
+// consistencySession is the consistency marker of a read-your-writes session
+type consistencySession struct {
+	mu sync.Mutex
+
+	// stickyUntil is the time until which reads go to the primary
+	stickyUntil time.Time
+	// position is the replication position of the primary after the last write
+	position string
+	// positionUnknown is set when the position could not be fetched after a write
+	positionUnknown bool
+	// reached are replicas known to have applied position
+	reached map[*replica]bool
+}
+
+// recordWrite updates the session after a successful write
+func (s *consistencySession) recordWrite(stickyUntil time.Time, position string, positionErr error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if stickyUntil.After(s.stickyUntil) {
+		s.stickyUntil = stickyUntil
+	}
+	if positionErr != nil {
+		s.positionUnknown = true
+		return
+	}
+	if position != "" && position != s.position {
+		s.position = position
+		s.reached = nil
+	}
+}
+
+// readRequirements returns whether reads must go to the primary and the replication
+// position a replica must have applied to serve reads
+func (s *consistencySession) readRequirements(now time.Time) (primaryOnly bool, position string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.positionUnknown || now.Before(s.stickyUntil), s.position
+}
+
+func (s *consistencySession) hasReached(r *replica, position string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.position == position && s.reached[r]
+}
+
+func (s *consistencySession) markReached(r *replica, position string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.position != position {
+		return
+	}
+	if s.reached == nil {
+		s.reached = make(map[*replica]bool)
+	}
+	s.reached[r] = true
+}
+
+// ReplicaBalancing is a strategy for choosing a replica to read from
+type ReplicaBalancing int
+
+const (
+	// RoundRobin distributes reads evenly across healthy replicas
+	RoundRobin ReplicaBalancing = iota
+	// LeastInFlight sends reads to the healthy replica with the fewest reads in progress
+	LeastInFlight
+)
+
+const (
+	defaultEjectAfterFailures = 3
+	defaultEjectionDuration   = 30 * time.Second
+)
+
+// ClusterConfig configures a Database made of a primary and its read replicas.
+//
+// Scan and ScanOne go to a healthy replica unless the context was made with
+// ReadFromPrimary. All other operations go to the primary.
+// Reads go to the primary when there are no healthy replicas.
+// Replicas returned by HealthChecker.Database receive no reads while unhealthy.
+type ClusterConfig struct {
+	// Balancing is the strategy for choosing a replica. Defaults to RoundRobin
+	Balancing ReplicaBalancing
+
+	// EjectAfterFailures is the number of consecutive failed reads after which
+	// a replica stops receiving reads. Defaults to 3
+	EjectAfterFailures int
+
+	// EjectionDuration is how long an ejected replica receives no reads. Defaults to 30s
+	EjectionDuration time.Duration
+
+	// StickyPrimaryFor is how long reads in a read-your-writes session go to
+	// the primary after a write. See WithReadYourWrites
+	StickyPrimaryFor time.Duration
+
+	// PrimaryPosition, if set, returns the replication position of the primary,
+	// e.g. a GTID set or a WAL LSN. It is called after each write in a
+	// read-your-writes session. If it fails, the remaining reads of the session
+	// go to the primary
+	PrimaryPosition func(ctx context.Context, primary Queryer) (string, error)
+
+	// ReplicaReached reports whether a replica has applied the position
+	// returned by PrimaryPosition. Reads in a read-your-writes session only go
+	// to replicas that have applied the position of the session's last write.
+	// Required if PrimaryPosition is set
+	ReplicaReached func(ctx context.Context, replica Queryer, position string) (bool, error)
+
+	// Options are applied to the primary and each replica by Wrap.
+	// Each of them is configured separately, e.g. a StatementCache caches
+	// the statements of each of them separately
+	Options []Option
+
+	// Now returns the current time. Defaults to time.Now
+	Now func() time.Time
+}
+
+// Wrap returns a Database wrapping the given primary and replica *sql.DB with Options
+func (c ClusterConfig) Wrap(primary *sql.DB, replicas ...*sql.DB) Database {
+	wrapped := make([]Database, 0, len(replicas))
+	for _, r := range replicas {
+		wrapped = append(wrapped, Wrap(r, c.Options...))
+	}
+	return c.New(Wrap(primary, c.Options...), wrapped...)
+}
+
+// New returns a Database routing queries across the given primary and replica Databases
+func (c ClusterConfig) New(primary Database, replicas ...Database) Database {
+	if c.EjectAfterFailures <= 0 {
+		c.EjectAfterFailures = defaultEjectAfterFailures
+	}
+	if c.EjectionDuration <= 0 {
+		c.EjectionDuration = defaultEjectionDuration
+	}
+	if c.Now == nil {
+		c.Now = time.Now
+	}
+	cluster := &clusterDatabase{
+		Database: primary,
+		cfg:      c,
+	}
+	for _, r := range replicas {
+		cluster.replicas = append(cluster.replicas, &replica{db: r})
+	}
+	return cluster
+}
+
+type replica struct {
+	db Database
+
+	inFlight int64
+
+	mu                  sync.Mutex
+	consecutiveFailures int
+	ejectedUntil        time.Time
+}
+
+// available reports whether the replica may receive reads at now
+func (r *replica) available(now time.Time) bool {
+	if h, ok := r.db.(healthReporter); ok && h.unhealthy() {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return !now.Before(r.ejectedUntil)
+}
+
+// recordResult updates the replica's failure statistics with the outcome of a read
+func (r *replica) recordResult(err error, cfg ClusterConfig) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// errors of queries would fail on any replica
+	if !isUnavailabilityError(err) {
+		r.consecutiveFailures = 0
+		return
+	}
+	r.consecutiveFailures++
+	if r.consecutiveFailures >= cfg.EjectAfterFailures {
+		r.consecutiveFailures = 0
+		r.ejectedUntil = cfg.Now().Add(cfg.EjectionDuration)
+	}
+}
+
+// clusterDatabase is a Database whose embedded Database is the primary
+type clusterDatabase struct {
+	Database
+
+	cfg      ClusterConfig
+	replicas []*replica
+	next     uint64
+}
+
+var _ Database = (*clusterDatabase)(nil)
+
+// Scan implements Queryer.Scan
+func (c *clusterDatabase) Scan(ctx context.Context, scanner RowScanner, sql string, args ...interface{}) error {
+	return c.read(ctx, func(q Queryer) error {
+		return q.Scan(ctx, scanner, sql, args...)
+	})
+}
+
+// ScanOne implements Queryer.ScanOne
+func (c *clusterDatabase) ScanOne(ctx context.Context, scanner RowScanner, sql string, args ...interface{}) error {
+	return c.read(ctx, func(q Queryer) error {
+		return q.ScanOne(ctx, scanner, sql, args...)
+	})
+}
+
+// ScanOptional implements Queryer.ScanOptional
+func (c *clusterDatabase) ScanOptional(ctx context.Context, scanner RowScanner, sql string, args ...interface{}) (bool, error) {
+	return found(c.ScanOne(ctx, scanner, sql, args...))
+}
+
+// Update implements Queryer.Update
+func (c *clusterDatabase) Update(ctx context.Context, sql string, args ...interface{}) (sql.Result, error) {
+	result, err := c.Database.Update(ctx, sql, args...)
+	c.recordWrite(ctx, err)
+	return result, err
+}
+
+// UpdateAndGetRowsAffected implements Queryer.UpdateAndGetRowsAffected
+func (c *clusterDatabase) UpdateAndGetRowsAffected(ctx context.Context, sql string, args ...interface{}) (int64, error) {
+	rowsAffected, err := c.Database.UpdateAndGetRowsAffected(ctx, sql, args...)
+	c.recordWrite(ctx, err)
+	return rowsAffected, err
+}
+
+// UpdateAndGetLastInsertID implements Queryer.UpdateAndGetLastInsertID
+func (c *clusterDatabase) UpdateAndGetLastInsertID(ctx context.Context, sql string, args ...interface{}) (int64, error) {
+	lastInsertID, err := c.Database.UpdateAndGetLastInsertID(ctx, sql, args...)
+	c.recordWrite(ctx, err)
+	return lastInsertID, err
+}
+
+// UpdateReturning implements Queryer.UpdateReturning
+func (c *clusterDatabase) UpdateReturning(ctx context.Context, scanner RowScanner, sql string, args ...interface{}) (int64, error) {
+	rowsAffected, err := c.Database.UpdateReturning(ctx, scanner, sql, args...)
+	c.recordWrite(ctx, err)
+	return rowsAffected, err
+}
+
+// Transaction implements Database.Transaction
+func (c *clusterDatabase) Transaction(ctx context.Context, work func(Transaction) error) error {
+	err := c.Database.Transaction(ctx, work)
+	c.recordWrite(ctx, err)
+	return err
+}
+
+// Ping implements Database.Ping, pinging the primary and the replicas
+func (c *clusterDatabase) Ping(ctx context.Context) error {
+	err := c.Database.Ping(ctx)
+	for _, r := range c.replicas {
+		if replicaErr := r.db.Ping(ctx); err == nil {
+			err = replicaErr
+		}
+	}
+	return err
+}
+
+// Stats implements Database.Stats, summing the statistics of the primary and the replicas
+func (c *clusterDatabase) Stats() sql.DBStats {
+	stats := c.Database.Stats()
+	for _, r := range c.replicas {
+		replicaStats := r.db.Stats()
+		if stats.MaxOpenConnections == 0 || replicaStats.MaxOpenConnections == 0 {
+			// any unlimited pool makes the cluster unlimited
+			stats.MaxOpenConnections = 0
+		} else {
+			stats.MaxOpenConnections += replicaStats.MaxOpenConnections
+		}
+		stats.OpenConnections += replicaStats.OpenConnections
+		stats.InUse += replicaStats.InUse
+		stats.Idle += replicaStats.Idle
+		stats.WaitCount += replicaStats.WaitCount
+		stats.WaitDuration += replicaStats.WaitDuration
+		stats.MaxIdleClosed += replicaStats.MaxIdleClosed
+		stats.MaxIdleTimeClosed += replicaStats.MaxIdleTimeClosed
+		stats.MaxLifetimeClosed += replicaStats.MaxLifetimeClosed
+	}
+	return stats
+}
+
+// Close implements io.Closer
+func (c *clusterDatabase) Close() error {
+	err := c.Database.Close()
+	for _, r := range c.replicas {
+		if replicaErr := r.db.Close(); err == nil {
+			err = replicaErr
+		}
+	}
+	return err
+}
+
+func (c *clusterDatabase) read(ctx context.Context, query func(Queryer) error) error {
+	r := c.chooseReplica(ctx)
+	if r == nil {
+		return query(c.Database)
+	}
+
+	atomic.AddInt64(&r.inFlight, 1)
+	err := query(r.db)
+	atomic.AddInt64(&r.inFlight, -1)
+
+	r.recordResult(err, c.cfg)
+	return err
+}
+
+// recordWrite records a write in the context's read-your-writes session, if any
+func (c *clusterDatabase) recordWrite(ctx context.Context, err error) {
+	session := consistencySessionFrom(ctx)
+	if session == nil || err != nil {
+		return
+	}
+
+	var stickyUntil time.Time
+	if c.cfg.StickyPrimaryFor > 0 {
+		stickyUntil = c.cfg.Now().Add(c.cfg.StickyPrimaryFor)
+	}
+
+	var position string
+	var positionErr error
+	if c.cfg.PrimaryPosition != nil {
+		position, positionErr = c.cfg.PrimaryPosition(ctx, c.Database)
+	}
+
+	session.recordWrite(stickyUntil, position, positionErr)
+}
+
+// chooseReplica returns the replica to read from, or nil to read from the primary
+func (c *clusterDatabase) chooseReplica(ctx context.Context) *replica {
+	if len(c.replicas) == 0 || isReadFromPrimary(ctx) {
+		return nil
+	}
+
+	now := c.cfg.Now()
+	session := consistencySessionFrom(ctx)
+	if session == nil {
+		return c.firstCandidate(now, nil)
+	}
+
+	primaryOnly, position := session.readRequirements(now)
+	if primaryOnly {
+		return nil
+	}
+	if position == "" || c.cfg.ReplicaReached == nil {
+		return c.firstCandidate(now, nil)
+	}
+
+	return c.firstCandidate(now, func(r *replica) bool {
+		if session.hasReached(r, position) {
+			return true
+		}
+		reached, err := c.cfg.ReplicaReached(ctx, r.db, position)
+		if err != nil || !reached {
+			return false
+		}
+		session.markReached(r, position)
+		return true
+	})
+}
+
+// firstCandidate returns the preferred available replica satisfying accept,
+// or nil if there is no such replica
+func (c *clusterDatabase) firstCandidate(now time.Time, accept func(*replica) bool) *replica {
+	start := int(atomic.AddUint64(&c.next, 1) % uint64(len(c.replicas)))
+
+	candidates := make([]*replica, 0, len(c.replicas))
+	for i := range c.replicas {
+		r := c.replicas[(start+i)%len(c.replicas)]
+		if r.available(now) {
+			candidates = append(candidates, r)
+		}
+	}
+
+	if c.cfg.Balancing == LeastInFlight {
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return atomic.LoadInt64(&candidates[i].inFlight) < atomic.LoadInt64(&candidates[j].inFlight)
+		})
+	}
+
+	for _, r := range candidates {
+		if accept == nil || accept(r) {
+			return r
+		}
+	}
+	return nil
+}
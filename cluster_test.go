@@ -0,0 +1,418 @@
+package libsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)}
+}
+
+func Test_clusterDatabase_ReadsAreBalancedRoundRobin(t *testing.T) {
+	ctx := context.Background()
+	const expQuery = "SELECT x FROM aTable"
+
+	primary := NewDatabaseMock(t)
+	defer primary.MinimockFinish()
+
+	replica1 := NewDatabaseMock(t)
+	defer replica1.MinimockFinish()
+
+	replica2 := NewDatabaseMock(t)
+	defer replica2.MinimockFinish()
+
+	scanner := Into()
+	replica1.ScanMock.Expect(ctx, scanner, expQuery).Return(nil)
+	replica2.ScanOneMock.Expect(ctx, scanner, expQuery).Return(nil)
+
+	db := ClusterConfig{}.New(primary, replica1, replica2)
+
+	require.NoError(t, db.ScanOne(ctx, scanner, expQuery))
+	require.NoError(t, db.Scan(ctx, scanner, expQuery))
+	require.NoError(t, db.ScanOne(ctx, scanner, expQuery))
+	require.NoError(t, db.Scan(ctx, scanner, expQuery))
+
+	require.Equal(t, uint64(2), replica1.ScanAfterCounter())
+	require.Equal(t, uint64(2), replica2.ScanOneAfterCounter())
+}
+
+func Test_clusterDatabase_LeastInFlight(t *testing.T) {
+	ctx := context.Background()
+	const expQuery = "SELECT x FROM aTable"
+
+	primary := NewDatabaseMock(t)
+	defer primary.MinimockFinish()
+
+	busyReplica := NewDatabaseMock(t)
+	defer busyReplica.MinimockFinish()
+
+	idleReplica := NewDatabaseMock(t)
+	defer idleReplica.MinimockFinish()
+
+	idleReplica.ScanMock.Return(nil)
+
+	db := ClusterConfig{Balancing: LeastInFlight}.New(primary, busyReplica, idleReplica).(*clusterDatabase)
+	db.replicas[0].inFlight = 5
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, db.Scan(ctx, Into(), expQuery))
+	}
+	require.Equal(t, uint64(3), idleReplica.ScanAfterCounter())
+}
+
+func Test_clusterDatabase_WritesGoToPrimary(t *testing.T) {
+	ctx := context.Background()
+	const expQuery = "UPDATE aTable SET x = ?"
+
+	primary := NewDatabaseMock(t)
+	defer primary.MinimockFinish()
+
+	replica := NewDatabaseMock(t)
+	defer replica.MinimockFinish()
+
+	primary.UpdateAndGetRowsAffectedMock.Expect(ctx, expQuery, 1).Return(1, nil)
+	primary.TransactionMock.Return(nil)
+	primary.PrepareStatementMock.Expect(ctx, expQuery).Return(nil, nil)
+
+	db := ClusterConfig{}.New(primary, replica)
+
+	rowsAffected, err := db.UpdateAndGetRowsAffected(ctx, expQuery, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rowsAffected)
+
+	require.NoError(t, db.Transaction(ctx, nil))
+
+	_, err = db.PrepareStatement(ctx, expQuery)
+	require.NoError(t, err)
+}
+
+func Test_clusterDatabase_ReadFromPrimary(t *testing.T) {
+	ctx := ReadFromPrimary(context.Background())
+	const expQuery = "SELECT x FROM aTable"
+
+	primary := NewDatabaseMock(t)
+	defer primary.MinimockFinish()
+
+	replica := NewDatabaseMock(t)
+	defer replica.MinimockFinish()
+
+	primary.ScanMock.Return(nil)
+
+	db := ClusterConfig{}.New(primary, replica)
+	require.NoError(t, db.Scan(ctx, Into(), expQuery))
+}
+
+func Test_clusterDatabase_ReplicaIsEjectedAfterConsecutiveFailures(t *testing.T) {
+	ctx := context.Background()
+	const expQuery = "SELECT x FROM aTable"
+	clock := newFakeClock()
+
+	primary := NewDatabaseMock(t)
+	defer primary.MinimockFinish()
+
+	replica := NewDatabaseMock(t)
+	defer replica.MinimockFinish()
+
+	expErr := driver.ErrBadConn
+	replica.ScanMock.Return(expErr)
+	primary.ScanMock.Return(nil)
+
+	db := ClusterConfig{
+		EjectAfterFailures: 2,
+		EjectionDuration:   time.Minute,
+		Now:                clock.Now,
+	}.New(primary, replica)
+
+	require.Equal(t, expErr, db.Scan(ctx, Into(), expQuery))
+	require.Equal(t, expErr, db.Scan(ctx, Into(), expQuery))
+
+	require.NoError(t, db.Scan(ctx, Into(), expQuery))
+	require.Equal(t, uint64(1), primary.ScanAfterCounter())
+
+	clock.Advance(time.Minute)
+	require.Equal(t, expErr, db.Scan(ctx, Into(), expQuery))
+	require.Equal(t, uint64(3), replica.ScanAfterCounter())
+}
+
+func Test_clusterDatabase_NoRowsIsNotAReplicaFailure(t *testing.T) {
+	ctx := context.Background()
+	const expQuery = "SELECT x FROM aTable"
+
+	primary := NewDatabaseMock(t)
+	defer primary.MinimockFinish()
+
+	replica := NewDatabaseMock(t)
+	defer replica.MinimockFinish()
+
+	replica.ScanOneMock.Return(ErrNoRows)
+
+	db := ClusterConfig{EjectAfterFailures: 1}.New(primary, replica)
+	require.Equal(t, ErrNoRows, db.ScanOne(ctx, Into(), expQuery))
+	require.Equal(t, ErrNoRows, db.ScanOne(ctx, Into(), expQuery))
+	require.Equal(t, uint64(2), replica.ScanOneAfterCounter())
+}
+
+func Test_clusterDatabase_QueryErrorsAreNotReplicaFailures(t *testing.T) {
+	ctx := context.Background()
+	const expQuery = "SELECT x FROM aTable"
+
+	primary := NewDatabaseMock(t)
+	defer primary.MinimockFinish()
+
+	replica := NewDatabaseMock(t)
+	defer replica.MinimockFinish()
+
+	expErr := errors.New("a-test-syntax-error")
+	replica.ScanMock.Return(expErr)
+
+	db := ClusterConfig{EjectAfterFailures: 1}.New(primary, replica)
+	require.Equal(t, expErr, db.Scan(ctx, Into(), expQuery))
+	require.Equal(t, expErr, db.Scan(ctx, Into(), expQuery))
+	require.Equal(t, uint64(2), replica.ScanAfterCounter())
+}
+
+// failingConnector is a driver.Connector failing to connect with err
+type failingConnector struct {
+	err error
+}
+
+// Connect implements driver.Connector.Connect
+func (c failingConnector) Connect(context.Context) (driver.Conn, error) {
+	return nil, c.err
+}
+
+// Driver implements driver.Connector.Driver
+func (c failingConnector) Driver() driver.Driver {
+	return nil
+}
+
+func Test_WrapCluster_RoutesReadsToReplicas(t *testing.T) {
+	ctx := context.Background()
+	primaryErr := errors.New("a-test-primary-error")
+	replicaErr := errors.New("a-test-replica-error")
+
+	db := WrapCluster(sql.OpenDB(failingConnector{err: primaryErr}), sql.OpenDB(failingConnector{err: replicaErr}))
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	require.True(t, errors.Is(db.Scan(ctx, Into(), "SELECT x FROM aTable"), replicaErr))
+	_, err := db.Update(ctx, "UPDATE aTable SET x = 1")
+	require.True(t, errors.Is(err, primaryErr))
+}
+
+func Test_ClusterConfig_Wrap_WithStatementCache(t *testing.T) {
+	ctx := context.Background()
+	expErr := errors.New("a-test-error")
+	primary := sql.OpenDB(failingConnector{err: expErr})
+	replica := sql.OpenDB(failingConnector{err: expErr})
+
+	cache := NewStatementCache(10)
+	db := ClusterConfig{Options: []Option{WithStatementCache(cache)}}.Wrap(primary, replica)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+
+	require.True(t, errors.Is(db.Scan(ctx, Into(), "SELECT x FROM aTable"), expErr))
+	_, err := db.Update(ctx, "UPDATE aTable SET x = 1")
+	require.True(t, errors.Is(err, expErr))
+	require.Equal(t, StatementCacheStats{Misses: 2}, cache.Stats())
+}
+
+func Test_clusterDatabase_CloseClosesAll(t *testing.T) {
+	primary := NewDatabaseMock(t)
+	defer primary.MinimockFinish()
+
+	replica := NewDatabaseMock(t)
+	defer replica.MinimockFinish()
+
+	expErr := errors.New("a-test-error")
+	primary.CloseMock.Return(nil)
+	replica.CloseMock.Return(expErr)
+
+	require.Equal(t, expErr, ClusterConfig{}.New(primary, replica).Close())
+}
+
+func Test_clusterDatabase_PingPingsAll(t *testing.T) {
+	ctx := context.Background()
+
+	primary := NewDatabaseMock(t)
+	defer primary.MinimockFinish()
+
+	replica := NewDatabaseMock(t)
+	defer replica.MinimockFinish()
+
+	expErr := errors.New("a-test-error")
+	primary.PingMock.Expect(ctx).Return(nil)
+	replica.PingMock.Expect(ctx).Return(expErr)
+
+	require.Equal(t, expErr, ClusterConfig{}.New(primary, replica).Ping(ctx))
+}
+
+func Test_clusterDatabase_StatsSumsAll(t *testing.T) {
+	primary := NewDatabaseMock(t)
+	defer primary.MinimockFinish()
+
+	replica1 := NewDatabaseMock(t)
+	defer replica1.MinimockFinish()
+
+	replica2 := NewDatabaseMock(t)
+	defer replica2.MinimockFinish()
+
+	primary.StatsMock.Return(sql.DBStats{MaxOpenConnections: 10, OpenConnections: 4, InUse: 3, Idle: 1, WaitCount: 2})
+	replica1.StatsMock.Return(sql.DBStats{MaxOpenConnections: 5, OpenConnections: 2, InUse: 1, Idle: 1, WaitDuration: time.Second})
+	replica2.StatsMock.Return(sql.DBStats{OpenConnections: 1, Idle: 1})
+
+	require.Equal(t, sql.DBStats{
+		OpenConnections: 7,
+		InUse:           4,
+		Idle:            3,
+		WaitCount:       2,
+		WaitDuration:    time.Second,
+	}, ClusterConfig{}.New(primary, replica1, replica2).Stats())
+}
+
+func Test_clusterDatabase_ReadYourWrites_StickyPrimary(t *testing.T) {
+	ctx := WithReadYourWrites(context.Background())
+	clock := newFakeClock()
+
+	primary := NewDatabaseMock(t)
+	defer primary.MinimockFinish()
+
+	replica := NewDatabaseMock(t)
+	defer replica.MinimockFinish()
+
+	primary.UpdateMock.Return(nil, nil)
+	primary.ScanMock.Return(nil)
+	replica.ScanMock.Return(nil)
+
+	db := ClusterConfig{
+		StickyPrimaryFor: 5 * time.Second,
+		Now:              clock.Now,
+	}.New(primary, replica)
+
+	require.NoError(t, db.Scan(ctx, Into(), "SELECT 1"))
+	require.Equal(t, uint64(1), replica.ScanAfterCounter())
+
+	_, err := db.Update(ctx, "UPDATE aTable SET x = 1")
+	require.NoError(t, err)
+
+	require.NoError(t, db.Scan(ctx, Into(), "SELECT 1"))
+	require.Equal(t, uint64(1), primary.ScanAfterCounter())
+
+	// other sessions are not affected
+	require.NoError(t, db.Scan(context.Background(), Into(), "SELECT 1"))
+	require.Equal(t, uint64(2), replica.ScanAfterCounter())
+
+	clock.Advance(5 * time.Second)
+	require.NoError(t, db.Scan(ctx, Into(), "SELECT 1"))
+	require.Equal(t, uint64(3), replica.ScanAfterCounter())
+}
+
+func Test_clusterDatabase_ReadYourWrites_FailedWriteIsNotRecorded(t *testing.T) {
+	ctx := WithReadYourWrites(context.Background())
+
+	primary := NewDatabaseMock(t)
+	defer primary.MinimockFinish()
+
+	replica := NewDatabaseMock(t)
+	defer replica.MinimockFinish()
+
+	expErr := errors.New("a-test-error")
+	primary.TransactionMock.Return(expErr)
+	replica.ScanMock.Return(nil)
+
+	db := ClusterConfig{StickyPrimaryFor: time.Hour}.New(primary, replica)
+
+	require.Equal(t, expErr, db.Transaction(ctx, nil))
+	require.NoError(t, db.Scan(ctx, Into(), "SELECT 1"))
+	require.Equal(t, uint64(1), replica.ScanAfterCounter())
+}
+
+func Test_clusterDatabase_ReadYourWrites_ReplicationPosition(t *testing.T) {
+	ctx := WithReadYourWrites(context.Background())
+
+	primary := NewDatabaseMock(t)
+	defer primary.MinimockFinish()
+
+	laggingReplica := NewDatabaseMock(t)
+	defer laggingReplica.MinimockFinish()
+
+	upToDateReplica := NewDatabaseMock(t)
+	defer upToDateReplica.MinimockFinish()
+
+	primary.TransactionMock.Return(nil)
+	upToDateReplica.ScanMock.Return(nil)
+
+	positionCalls := 0
+	reachedCalls := map[Queryer]int{}
+	db := ClusterConfig{
+		PrimaryPosition: func(ctx context.Context, q Queryer) (string, error) {
+			require.Equal(t, primary, q)
+			positionCalls++
+			return "gtid:42", nil
+		},
+		ReplicaReached: func(ctx context.Context, q Queryer, position string) (bool, error) {
+			require.Equal(t, "gtid:42", position)
+			reachedCalls[q]++
+			return q == upToDateReplica, nil
+		},
+	}.New(primary, laggingReplica, upToDateReplica)
+
+	require.NoError(t, db.Transaction(ctx, nil))
+	require.Equal(t, 1, positionCalls)
+
+	for i := 0; i < 4; i++ {
+		require.NoError(t, db.Scan(ctx, Into(), "SELECT 1"))
+	}
+	require.Equal(t, uint64(4), upToDateReplica.ScanAfterCounter())
+	require.Equal(t, 1, reachedCalls[upToDateReplica])
+}
+
+func Test_clusterDatabase_ReadYourWrites_PositionErrorForcesPrimary(t *testing.T) {
+	ctx := WithReadYourWrites(context.Background())
+
+	primary := NewDatabaseMock(t)
+	defer primary.MinimockFinish()
+
+	replica := NewDatabaseMock(t)
+	defer replica.MinimockFinish()
+
+	primary.UpdateAndGetLastInsertIDMock.Return(7, nil)
+	primary.ScanOneMock.Return(nil)
+
+	db := ClusterConfig{
+		PrimaryPosition: func(context.Context, Queryer) (string, error) {
+			return "", errors.New("a-test-error")
+		},
+		ReplicaReached: func(context.Context, Queryer, string) (bool, error) {
+			return true, nil
+		},
+	}.New(primary, replica)
+
+	_, err := db.UpdateAndGetLastInsertID(ctx, "INSERT INTO aTable VALUES (1)")
+	require.NoError(t, err)
+
+	require.NoError(t, db.ScanOne(ctx, Into(), "SELECT 1"))
+	require.Equal(t, uint64(1), primary.ScanOneAfterCounter())
+}
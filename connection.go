@@ -0,0 +1,29 @@
+package libsql
+
+import "context"
+
+func newConnection(conn sqlConn, cfg *config) Connection {
+	return &connectionImpl{
+		Queryer:  newQueryerMixin(conn, cfg),
+		Preparer: newPreparerMixin(conn, cfg),
+		conn:     conn,
+		newTX:    newTransaction,
+		cfg:      cfg,
+	}
+}
+
+type connectionImpl struct {
+	Queryer
+	Preparer
+
+	conn  sqlConn
+	newTX func(sqlTx, *config) Transaction
+	cfg   *config
+}
+
+var _ Connection = (*connectionImpl)(nil)
+
+// Transaction implements Connection.Transaction
+func (c connectionImpl) Transaction(ctx context.Context, work func(Transaction) error) error {
+	return runTransaction(ctx, c.conn, c.newTX, work, c.cfg)
+}
@@ -0,0 +1,84 @@
+package libsql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_connectionImpl_Transaction(t *testing.T) {
+	sqlConn := NewSqlConnMock(t)
+	defer sqlConn.MinimockFinish()
+
+	expSQLTx := NewSqlTxMock(t)
+	defer expSQLTx.MinimockFinish()
+
+	expCtx := context.Background()
+
+	sqlConn.BeginMock.When(expCtx).Then(expSQLTx, (error)(nil))
+
+	expSQLTx.CommitMock.Return((error)(nil))
+
+	expSQLTx.RollbackMock.Return(sql.ErrTxDone)
+
+	expTx := newTransaction(expSQLTx, nil)
+
+	newTXFuncCalls := 0
+	newTXFunc := func(actualSQLTX sqlTx, _ *config) Transaction {
+		require.Equal(t, expSQLTx, actualSQLTX)
+		newTXFuncCalls++
+		return expTx
+	}
+
+	workFuncCalls := 0
+	workFunc := func(actualTX Transaction) error {
+		require.Equal(t, expTx, actualTX)
+		workFuncCalls++
+		return nil
+	}
+
+	connection := &connectionImpl{
+		conn:  sqlConn,
+		newTX: newTXFunc,
+	}
+	err := connection.Transaction(expCtx, workFunc)
+	require.NoError(t, err)
+
+	require.Equal(t, 1, newTXFuncCalls)
+	require.Equal(t, 1, workFuncCalls)
+}
+
+func Test_connectionImpl_TransactionBeginErrorIsReturned(t *testing.T) {
+	sqlConn := NewSqlConnMock(t)
+	defer sqlConn.MinimockFinish()
+
+	expCtx := context.Background()
+	expErr := errors.New("a-test-error")
+
+	sqlConn.BeginMock.When(expCtx).Then(nil, expErr)
+
+	actualError := newConnection(sqlConn, nil).Transaction(expCtx, nil)
+	require.ErrorIs(t, actualError, expErr)
+}
+
+func Test_connectionImpl_QueriesUseTheConnection(t *testing.T) {
+	sqlConn := NewSqlConnMock(t)
+	defer sqlConn.MinimockFinish()
+
+	sqlResult := NewSqlResultMock(t)
+	defer sqlResult.MinimockFinish()
+
+	expCtx := context.Background()
+	expQuery := "SET @a_variable = ?"
+	expArgs := []interface{}{42}
+
+	sqlConn.ExecMock.When(expCtx, expQuery, expArgs...).Then(sqlResult, (error)(nil))
+
+	actualResult, err := newConnection(sqlConn, nil).Update(expCtx, expQuery, expArgs...)
+	require.NoError(t, err)
+	require.Equal(t, sqlResult, actualResult)
+}
@@ -4,15 +4,27 @@ import (
 	"context"
 	"database/sql"
 	"io"
+	"runtime"
+	"time"
 )
 
-func newDatabase(db sqlDB) Database {
+func newDatabase(db sqlDB, cfg *config) Database {
+	if cfg != nil && cfg.slowQueries != nil {
+		// slow queries are explained bypassing interceptors
+		cfg.slowQueries.bind(newDatabase(db, nil))
+	}
 	return &databaseImpl{
-		Queryer:      newQueryerMixin(db),
-		Preparer:     newPreparerMixin(db),
-		db:           db,
-		newTX:        newTransaction,
-		newStatement: newStatement,
+		Queryer:  newQueryerMixin(db, cfg),
+		Preparer: newPreparerMixin(db, cfg),
+		db:       db,
+		newTX:    newTransaction,
+		newConn: func(conn sqlConn) Connection {
+			return newConnection(conn, cfg.forConnection())
+		},
+		newStatement: func(stmt sqlStmt, sql string) Statement {
+			return newStatement(stmt, sql, cfg)
+		},
+		cfg: cfg,
 	}
 }
 
@@ -21,48 +33,58 @@ type databaseImpl struct {
 	Preparer
 
 	db           sqlDB
-	newTX        func(sqlTx) Transaction
-	newStatement func(sqlStmt) Statement
+	newTX        func(sqlTx, *config) Transaction
+	newConn      func(sqlConn) Connection
+	newStatement func(sqlStmt, string) Statement
+	cfg          *config
 }
 
 var _ Database = (*databaseImpl)(nil)
 
 // Transaction implements Database.Transaction
 func (d databaseImpl) Transaction(ctx context.Context, work func(Transaction) error) error {
-	tx, err := d.db.Begin(ctx)
-	if err != nil {
-		return err
-	}
-
-	rollbackIfNeeded := func() {
-		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
-			// failed to rollback a transaction
-		}
-	}
-	defer rollbackIfNeeded()
+	return runTransaction(ctx, d.db, d.newTX, work, d.cfg)
+}
 
-	if err := work(d.newTX(tx)); err != nil {
-		return err
+// Conn implements Database.Conn
+func (d databaseImpl) Conn(ctx context.Context, work func(Connection) error) error {
+	start := time.Now()
+	conn, err := d.db.Conn(ctx)
+	if err != nil {
+		return queryError(&Operation{Kind: OperationConn}, start, err)
 	}
-
-	return tx.Commit()
+	defer ignoreClose(conn)
+	return work(d.newConn(conn))
 }
 
 // PrepareStatement implements Database.PrepareStatement
 func (d databaseImpl) PrepareStatement(ctx context.Context, sql string) (PreparedStatement, error) {
-	sqlStmt, err := d.db.Prepare(ctx, sql)
+	sqlStmt, sql, err := prepare(ctx, d.db, sql, d.cfg)
 	if err != nil {
 		return nil, err
 	}
 	ps := &preparedStatementImpl{
-		Statement: d.newStatement(sqlStmt),
+		Statement: d.newStatement(sqlStmt, sql),
 		Closer:    sqlStmt,
 	}
+	d.cfg.trackStatement(ps, sql)
 	return ps, nil
 }
 
+// Ping implements Database.Ping
+func (d databaseImpl) Ping(ctx context.Context) error {
+	start := time.Now()
+	return queryError(&Operation{Kind: OperationPing}, start, d.db.Ping(ctx))
+}
+
+// Stats implements Database.Stats
+func (d databaseImpl) Stats() sql.DBStats {
+	return d.db.Stats()
+}
+
 // Close implements io.Close
 func (d *databaseImpl) Close() error {
+	d.cfg.reportLeaks()
 	return d.db.Close()
 }
 
@@ -72,3 +94,9 @@ type preparedStatementImpl struct {
 }
 
 var _ PreparedStatement = (*preparedStatementImpl)(nil)
+
+// Close implements io.Closer, clearing the finalizer reporting ps as leaked, if any
+func (ps *preparedStatementImpl) Close() error {
+	runtime.SetFinalizer(ps, nil)
+	return ps.Closer.Close()
+}
@@ -25,10 +25,10 @@ func Test_databaseImpl_Transaction(t *testing.T) {
 
 	expSQLTx.RollbackMock.Return(sql.ErrTxDone)
 
-	expTx := newTransaction(expSQLTx)
+	expTx := newTransaction(expSQLTx, nil)
 
 	newTXFuncCalls := 0
-	newTXFunc := func(actualSQLTX sqlTx) Transaction {
+	newTXFunc := func(actualSQLTX sqlTx, _ *config) Transaction {
 		require.Equal(t, expSQLTx, actualSQLTX)
 		newTXFuncCalls++
 		return expTx
@@ -64,8 +64,8 @@ func Test_databaseImpl_TransactionBeginErrorIsReturned(t *testing.T) {
 
 	sqlDB.BeginMock.When(expCtx).Then(sqlTx, expErr)
 
-	actualError := newDatabase(sqlDB).Transaction(expCtx, nil)
-	require.Equal(t, expErr, actualError)
+	actualError := newDatabase(sqlDB, nil).Transaction(expCtx, nil)
+	require.ErrorIs(t, actualError, expErr)
 }
 
 func Test_databaseImpl_TransactionWorkErrorIsReturned(t *testing.T) {
@@ -81,10 +81,10 @@ func Test_databaseImpl_TransactionWorkErrorIsReturned(t *testing.T) {
 
 	expSQLTx.RollbackMock.Return(sql.ErrTxDone)
 
-	expectedTX := newTransaction(expSQLTx)
+	expectedTX := newTransaction(expSQLTx, nil)
 
 	newTXFuncCalls := 0
-	newTXFunc := func(actualSQLTX sqlTx) Transaction {
+	newTXFunc := func(actualSQLTX sqlTx, _ *config) Transaction {
 		require.Equal(t, expSQLTx, actualSQLTX)
 		newTXFuncCalls++
 		return expectedTX
@@ -123,10 +123,10 @@ func Test_databaseImpl_TransactionIsRolledBackOnWorkPanic(t *testing.T) {
 
 	expSQLTx.RollbackMock.Return(sql.ErrTxDone)
 
-	expTx := newTransaction(expSQLTx)
+	expTx := newTransaction(expSQLTx, nil)
 
 	newTXFuncCalls := 0
-	newTXFunc := func(actualSQLTX sqlTx) Transaction {
+	newTXFunc := func(actualSQLTX sqlTx, _ *config) Transaction {
 		require.Equal(t, expSQLTx, actualSQLTX)
 		newTXFuncCalls++
 		return expTx
@@ -152,6 +152,86 @@ func Test_databaseImpl_TransactionIsRolledBackOnWorkPanic(t *testing.T) {
 	require.Equal(t, 1, workFuncCalls)
 }
 
+func Test_databaseImpl_Conn(t *testing.T) {
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	expSQLConn := NewSqlConnMock(t)
+	defer expSQLConn.MinimockFinish()
+
+	expCtx := context.Background()
+
+	sqlDB.ConnMock.When(expCtx).Then(expSQLConn, (error)(nil))
+
+	expSQLConn.CloseMock.Return((error)(nil))
+
+	expConn := newConnection(expSQLConn, nil)
+
+	newConnFuncCalls := 0
+	newConnFunc := func(actualSQLConn sqlConn) Connection {
+		require.Equal(t, expSQLConn, actualSQLConn)
+		newConnFuncCalls++
+		return expConn
+	}
+
+	expErr := errors.New("a-test-error")
+
+	workFuncCalls := 0
+	workFunc := func(actualConn Connection) error {
+		require.Equal(t, expConn, actualConn)
+		workFuncCalls++
+		return expErr
+	}
+
+	database := &databaseImpl{
+		db:      sqlDB,
+		newConn: newConnFunc,
+	}
+	actualError := database.Conn(expCtx, workFunc)
+	require.Equal(t, expErr, actualError)
+
+	require.Equal(t, 1, newConnFuncCalls)
+	require.Equal(t, 1, workFuncCalls)
+	require.Equal(t, uint64(1), expSQLConn.CloseAfterCounter())
+}
+
+func Test_databaseImpl_ConnIsClosedOnWorkPanic(t *testing.T) {
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	expSQLConn := NewSqlConnMock(t)
+	defer expSQLConn.MinimockFinish()
+
+	expCtx := context.Background()
+
+	sqlDB.ConnMock.When(expCtx).Then(expSQLConn, (error)(nil))
+
+	expSQLConn.CloseMock.Return((error)(nil))
+
+	require.Panics(t, func() {
+		_ = newDatabase(sqlDB, nil).Conn(expCtx, func(Connection) error {
+			panic("an-expected-panic")
+		})
+	})
+	require.Equal(t, uint64(1), expSQLConn.CloseAfterCounter())
+}
+
+func Test_databaseImpl_ConnErrorIsReturned(t *testing.T) {
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	expCtx := context.Background()
+	expErr := errors.New("a-test-error")
+
+	sqlDB.ConnMock.When(expCtx).Then(nil, expErr)
+
+	actualError := newDatabase(sqlDB, nil).Conn(expCtx, nil)
+	var queryErr *QueryError
+	require.ErrorAs(t, actualError, &queryErr)
+	require.Equal(t, OperationConn, queryErr.Op)
+	require.Equal(t, expErr, queryErr.Err)
+}
+
 func Test_databaseImpl_PrepareStatement(t *testing.T) {
 	ctx := context.WithValue(context.Background(), "a-key-to-make-a-unique-context", "a-value")
 	const expectedQuery = "SELECT 1 FROM DUAL"
@@ -171,11 +251,11 @@ func Test_databaseImpl_PrepareStatement(t *testing.T) {
 		Expect(ctx, expectedQuery).
 		Return(sqlStmt, nil)
 
-	s, err := newDatabase(sqlDB).PrepareStatement(ctx, expectedQuery)
+	s, err := newDatabase(sqlDB, nil).PrepareStatement(ctx, expectedQuery)
 	require.NoError(t, err)
 	_, err = s.Update(ctx)
 	require.Error(t, err)
-	require.Equal(t, expectedExecError, err)
+	require.ErrorIs(t, err, expectedExecError)
 	err = s.Close()
 	require.NoError(t, err)
 }
@@ -192,9 +272,9 @@ func Test_databaseImpl_PrepareStatement_propagatesErrors(t *testing.T) {
 		Expect(ctx, expectedQuery).
 		Return(nil, expectedError)
 
-	_, err := newDatabase(sqlDB).PrepareStatement(ctx, expectedQuery)
+	_, err := newDatabase(sqlDB, nil).PrepareStatement(ctx, expectedQuery)
 	require.Error(t, err)
-	require.Equal(t, expectedError, err)
+	require.ErrorIs(t, err, expectedError)
 }
 
 func Test_databaseImpl_CloseIsPropagated(t *testing.T) {
@@ -205,6 +285,39 @@ func Test_databaseImpl_CloseIsPropagated(t *testing.T) {
 
 	sqlDB.CloseMock.Return(expErr)
 
-	actualError := newDatabase(sqlDB).Close()
+	actualError := newDatabase(sqlDB, nil).Close()
 	require.Equal(t, expErr, actualError)
 }
+
+func Test_databaseImpl_PingIsPropagated(t *testing.T) {
+	ctx := context.Background()
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	expErr := errors.New("a-test-error")
+
+	sqlDB.PingMock.Expect(ctx).Return(expErr)
+
+	actualError := newDatabase(sqlDB, nil).Ping(ctx)
+	var queryErr *QueryError
+	require.ErrorAs(t, actualError, &queryErr)
+	require.Equal(t, OperationPing, queryErr.Op)
+	require.Equal(t, expErr, queryErr.Err)
+}
+
+func Test_databaseImpl_Stats(t *testing.T) {
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	expStats := sql.DBStats{OpenConnections: 3, InUse: 2, Idle: 1}
+
+	sqlDB.StatsMock.Return(expStats)
+
+	require.Equal(t, expStats, newDatabase(sqlDB, nil).Stats())
+}
+
+func Test_Open_propagatesErrors(t *testing.T) {
+	_, err := Open("an-unknown-driver", "")
+	require.Error(t, err)
+}
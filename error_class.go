@@ -0,0 +1,446 @@
+package libsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"syscall"
+)
+
+// ErrorClass is a driver independent class of database errors
+type ErrorClass int
+
+const (
+	// ErrorClassUnknown is the class of errors not recognized by any ErrorClassifier
+	ErrorClassUnknown ErrorClass = iota
+	// ErrorClassUniqueViolation is the class of duplicate key errors
+	ErrorClassUniqueViolation
+	// ErrorClassForeignKeyViolation is the class of foreign key constraint errors
+	ErrorClassForeignKeyViolation
+	// ErrorClassNotNullViolation is the class of errors inserting NULL into NOT NULL columns
+	ErrorClassNotNullViolation
+	// ErrorClassCheckViolation is the class of check constraint errors
+	ErrorClassCheckViolation
+	// ErrorClassDeadlock is the class of errors of transactions chosen as deadlock victims
+	ErrorClassDeadlock
+	// ErrorClassLockTimeout is the class of errors waiting too long for, or failing to acquire, a lock
+	ErrorClassLockTimeout
+	// ErrorClassSerializationFailure is the class of errors of transactions
+	// which could not be serialized with concurrent transactions
+	ErrorClassSerializationFailure
+	// ErrorClassConnection is the class of errors of broken or refused connections
+	ErrorClassConnection
+)
+
+var errorClassNames = map[ErrorClass]string{
+	ErrorClassUnknown:              "unknown",
+	ErrorClassUniqueViolation:      "unique_violation",
+	ErrorClassForeignKeyViolation:  "foreign_key_violation",
+	ErrorClassNotNullViolation:     "not_null_violation",
+	ErrorClassCheckViolation:       "check_violation",
+	ErrorClassDeadlock:             "deadlock",
+	ErrorClassLockTimeout:          "lock_timeout",
+	ErrorClassSerializationFailure: "serialization_failure",
+	ErrorClassConnection:           "connection",
+}
+
+// String implements fmt.Stringer
+func (c ErrorClass) String() string {
+	if name, ok := errorClassNames[c]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (c ErrorClass) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// ErrorClassification is the classification of an error
+type ErrorClassification struct {
+	Class ErrorClass
+	// Constraint is the name of the violated constraint, if reported by the database
+	Constraint string
+}
+
+// ErrorClassifier classifies errors of a driver, returning false if it does not recognize err.
+// Classifiers are called with each error of the chain of the classified error.
+type ErrorClassifier func(err error) (ErrorClassification, bool)
+
+var errorClassifiers struct {
+	sync.RWMutex
+	registered []ErrorClassifier
+}
+
+// builtinErrorClassifiers classify the errors of the common MySQL, Postgres,
+// SQLite and SQL Server drivers by their error codes, without importing them
+var builtinErrorClassifiers = []ErrorClassifier{
+	classifyDriverError,
+	classifySQLServerError,
+	classifySQLStateError,
+	classifyConnectionError,
+}
+
+// driverErrorType identifies an error type of a driver by its package path and name
+type driverErrorType struct {
+	pkgPath string
+	name    string
+}
+
+// driverErrorClassifiers classify the errors of the types of the drivers,
+// which are matched by name to not import the drivers
+var driverErrorClassifiers = map[driverErrorType]ErrorClassifier{
+	{"github.com/go-sql-driver/mysql", "MySQLError"}: classifyMySQLError,
+	{"github.com/mattn/go-sqlite3", "Error"}:         classifyMattnSQLiteError,
+	{"modernc.org/sqlite", "Error"}:                  classifyModerncSQLiteError,
+	{"github.com/lib/pq", "Error"}:                   classifyPqError,
+	{"github.com/jackc/pgconn", "PgError"}:           classifyPgError,
+	{"github.com/jackc/pgx/v5/pgconn", "PgError"}:    classifyPgError,
+}
+
+// classifyDriverError classifies err with the driverErrorClassifiers of its type, or of the type it points to
+func classifyDriverError(err error) (ErrorClassification, bool) {
+	t := reflect.TypeOf(err)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	classify, ok := driverErrorClassifiers[driverErrorType{pkgPath: t.PkgPath(), name: t.Name()}]
+	if !ok {
+		return ErrorClassification{}, false
+	}
+	return classify(err)
+}
+
+// RegisterErrorClassifier registers an ErrorClassifier used by ClassifyError.
+// Registered classifiers take precedence over the builtin ones and over
+// classifiers registered before them.
+func RegisterErrorClassifier(classifier ErrorClassifier) {
+	errorClassifiers.Lock()
+	defer errorClassifiers.Unlock()
+	errorClassifiers.registered = append([]ErrorClassifier{classifier}, errorClassifiers.registered...)
+}
+
+// ClassifyError classifies err, or the first error of its chain recognized by
+// an ErrorClassifier, e.g. the driver error wrapped in a QueryError
+func ClassifyError(err error) ErrorClassification {
+	errorClassifiers.RLock()
+	classifiers := make([]ErrorClassifier, 0, len(errorClassifiers.registered)+len(builtinErrorClassifiers))
+	classifiers = append(classifiers, errorClassifiers.registered...)
+	errorClassifiers.RUnlock()
+	classifiers = append(classifiers, builtinErrorClassifiers...)
+
+	classification, _ := classifyErrorChain(err, classifiers)
+	return classification
+}
+
+func classifyErrorChain(err error, classifiers []ErrorClassifier) (ErrorClassification, bool) {
+	for err != nil {
+		for _, classify := range classifiers {
+			if classification, ok := classify(err); ok {
+				return classification, true
+			}
+		}
+		switch wrapper := err.(type) {
+		case interface{ Unwrap() []error }:
+			for _, wrapped := range wrapper.Unwrap() {
+				if classification, ok := classifyErrorChain(wrapped, classifiers); ok {
+					return classification, true
+				}
+			}
+			return ErrorClassification{}, false
+		default:
+			err = errors.Unwrap(err)
+		}
+	}
+	return ErrorClassification{}, false
+}
+
+// ConstraintName returns the name of the constraint violated by err, empty if unknown
+func ConstraintName(err error) string {
+	return ClassifyError(err).Constraint
+}
+
+// IsUniqueViolation reports whether err is a duplicate key error
+func IsUniqueViolation(err error) bool {
+	return ClassifyError(err).Class == ErrorClassUniqueViolation
+}
+
+// IsForeignKeyViolation reports whether err is a foreign key constraint error
+func IsForeignKeyViolation(err error) bool {
+	return ClassifyError(err).Class == ErrorClassForeignKeyViolation
+}
+
+// IsNotNullViolation reports whether err is an error inserting NULL into a NOT NULL column
+func IsNotNullViolation(err error) bool {
+	return ClassifyError(err).Class == ErrorClassNotNullViolation
+}
+
+// IsCheckViolation reports whether err is a check constraint error
+func IsCheckViolation(err error) bool {
+	return ClassifyError(err).Class == ErrorClassCheckViolation
+}
+
+// IsDeadlock reports whether err is the error of a deadlock victim
+func IsDeadlock(err error) bool {
+	return ClassifyError(err).Class == ErrorClassDeadlock
+}
+
+// IsLockTimeout reports whether err is an error waiting for or acquiring a lock
+func IsLockTimeout(err error) bool {
+	return ClassifyError(err).Class == ErrorClassLockTimeout
+}
+
+// IsSerializationFailure reports whether err is a serialization failure of a transaction
+func IsSerializationFailure(err error) bool {
+	return ClassifyError(err).Class == ErrorClassSerializationFailure
+}
+
+// IsConnectionError reports whether err is the error of a broken or refused connection
+func IsConnectionError(err error) bool {
+	return ClassifyError(err).Class == ErrorClassConnection
+}
+
+// isUnavailabilityError reports whether err indicates that the database is
+// unavailable rather than a problem with the query or the caller: a connection
+// error or a timeout, but not a cancellation
+func isUnavailabilityError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	if IsConnectionError(err) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// errorField returns the field name of the struct err, or of the struct pointed to by err
+func errorField(err error, name string) (reflect.Value, bool) {
+	value := reflect.ValueOf(err)
+	if value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return reflect.Value{}, false
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+	field := value.FieldByName(name)
+	return field, field.IsValid()
+}
+
+// errorStringField returns the string field name of err, if any
+func errorStringField(err error, name string) (string, bool) {
+	field, ok := errorField(err, name)
+	if !ok || field.Kind() != reflect.String {
+		return "", false
+	}
+	return field.String(), true
+}
+
+// quotedName matches the names quoted in error messages
+const quotedName = `['"` + "`" + `]([^'"` + "`" + `]+)['"` + "`" + `]`
+
+var (
+	mysqlDuplicateKeyRegexp = regexp.MustCompile(`for key ` + quotedName)
+	mysqlConstraintRegexp   = regexp.MustCompile(`(?i)constraint ` + quotedName)
+)
+
+// classifyMySQLError classifies the *MySQLError of github.com/go-sql-driver/mysql
+func classifyMySQLError(err error) (ErrorClassification, bool) {
+	number, ok := errorField(err, "Number")
+	if !ok || number.Kind() != reflect.Uint16 {
+		return ErrorClassification{}, false
+	}
+	message, _ := errorStringField(err, "Message")
+
+	switch number.Uint() {
+	case 1062, 1586:
+		return ErrorClassification{Class: ErrorClassUniqueViolation, Constraint: submatch(mysqlDuplicateKeyRegexp, message)}, true
+	case 1216, 1217, 1451, 1452:
+		return ErrorClassification{Class: ErrorClassForeignKeyViolation, Constraint: submatch(mysqlConstraintRegexp, message)}, true
+	case 1048:
+		return ErrorClassification{Class: ErrorClassNotNullViolation}, true
+	case 3819:
+		return ErrorClassification{Class: ErrorClassCheckViolation, Constraint: submatch(mysqlConstraintRegexp, message)}, true
+	case 1213:
+		return ErrorClassification{Class: ErrorClassDeadlock}, true
+	case 1205, 3572:
+		return ErrorClassification{Class: ErrorClassLockTimeout}, true
+	case 1053, 2006, 2013:
+		return ErrorClassification{Class: ErrorClassConnection}, true
+	}
+	return ErrorClassification{}, false
+}
+
+var (
+	sqlServerConstraintRegexp  = regexp.MustCompile(`constraint ` + quotedName)
+	sqlServerUniqueIndexRegexp = regexp.MustCompile(`unique index ` + quotedName)
+)
+
+// classifySQLServerError classifies the errors of github.com/microsoft/go-mssqldb
+// and github.com/denisenkom/go-mssqldb, recognized by their SQLErrorNumber method
+func classifySQLServerError(err error) (ErrorClassification, bool) {
+	numbered, ok := err.(interface{ SQLErrorNumber() int32 })
+	if !ok {
+		return ErrorClassification{}, false
+	}
+	message := err.Error()
+	if m, ok := err.(interface{ SQLErrorMessage() string }); ok {
+		message = m.SQLErrorMessage()
+	}
+
+	switch numbered.SQLErrorNumber() {
+	case 2627:
+		return ErrorClassification{Class: ErrorClassUniqueViolation, Constraint: submatch(sqlServerConstraintRegexp, message)}, true
+	case 2601:
+		return ErrorClassification{Class: ErrorClassUniqueViolation, Constraint: submatch(sqlServerUniqueIndexRegexp, message)}, true
+	case 547:
+		class := ErrorClassCheckViolation
+		if strings.Contains(message, "FOREIGN KEY") || strings.Contains(message, "REFERENCE") {
+			class = ErrorClassForeignKeyViolation
+		}
+		return ErrorClassification{Class: class, Constraint: submatch(sqlServerConstraintRegexp, message)}, true
+	case 515:
+		return ErrorClassification{Class: ErrorClassNotNullViolation}, true
+	case 1205:
+		return ErrorClassification{Class: ErrorClassDeadlock}, true
+	case 1222:
+		return ErrorClassification{Class: ErrorClassLockTimeout}, true
+	case 3960:
+		return ErrorClassification{Class: ErrorClassSerializationFailure}, true
+	}
+	return ErrorClassification{}, false
+}
+
+var sqliteConstraintRegexp = regexp.MustCompile(`constraint failed: (\S+)`)
+
+// classifyMattnSQLiteError classifies the Error of github.com/mattn/go-sqlite3
+func classifyMattnSQLiteError(err error) (ErrorClassification, bool) {
+	code, ok := errorField(err, "ExtendedCode")
+	if !ok || code.Kind() != reflect.Int {
+		return ErrorClassification{}, false
+	}
+	return classifySQLiteCode(code.Int(), err.Error())
+}
+
+// classifyModerncSQLiteError classifies the *Error of modernc.org/sqlite,
+// whose Code method returns the extended code
+func classifyModerncSQLiteError(err error) (ErrorClassification, bool) {
+	coded, ok := err.(interface{ Code() int })
+	if !ok {
+		return ErrorClassification{}, false
+	}
+	return classifySQLiteCode(int64(coded.Code()), err.Error())
+}
+
+// classifySQLiteCode classifies a SQLite error by its extended code
+func classifySQLiteCode(code int64, message string) (ErrorClassification, bool) {
+	constraint := submatch(sqliteConstraintRegexp, message)
+
+	switch code {
+	case 1555, 2067: // SQLITE_CONSTRAINT_PRIMARYKEY, SQLITE_CONSTRAINT_UNIQUE
+		return ErrorClassification{Class: ErrorClassUniqueViolation, Constraint: constraint}, true
+	case 787: // SQLITE_CONSTRAINT_FOREIGNKEY
+		return ErrorClassification{Class: ErrorClassForeignKeyViolation, Constraint: constraint}, true
+	case 1299: // SQLITE_CONSTRAINT_NOTNULL
+		return ErrorClassification{Class: ErrorClassNotNullViolation, Constraint: constraint}, true
+	case 275: // SQLITE_CONSTRAINT_CHECK
+		return ErrorClassification{Class: ErrorClassCheckViolation, Constraint: constraint}, true
+	case 517: // SQLITE_BUSY_SNAPSHOT
+		return ErrorClassification{Class: ErrorClassSerializationFailure}, true
+	}
+	switch code & 0xff {
+	case 5, 6: // SQLITE_BUSY, SQLITE_LOCKED
+		return ErrorClassification{Class: ErrorClassLockTimeout}, true
+	}
+	return ErrorClassification{}, false
+}
+
+// classifyPgError classifies the *PgError of github.com/jackc/pgx
+func classifyPgError(err error) (ErrorClassification, bool) {
+	state, _ := errorStringField(err, "Code")
+	constraint, _ := errorStringField(err, "ConstraintName")
+	return classifySQLState(state, constraint)
+}
+
+// classifyPqError classifies the *Error of github.com/lib/pq
+func classifyPqError(err error) (ErrorClassification, bool) {
+	state, _ := errorStringField(err, "Code")
+	constraint, _ := errorStringField(err, "Constraint")
+	return classifySQLState(state, constraint)
+}
+
+// classifySQLStateError classifies the errors of other drivers by the SQLSTATE
+// reported by their SQLState method
+func classifySQLStateError(err error) (ErrorClassification, bool) {
+	stated, ok := err.(interface{ SQLState() string })
+	if !ok {
+		return ErrorClassification{}, false
+	}
+	return classifySQLState(stated.SQLState(), "")
+}
+
+// classifySQLState classifies an error by its SQLSTATE
+func classifySQLState(state string, constraint string) (ErrorClassification, bool) {
+	if len(state) != 5 {
+		return ErrorClassification{}, false
+	}
+
+	switch {
+	case state == "23505":
+		return ErrorClassification{Class: ErrorClassUniqueViolation, Constraint: constraint}, true
+	case state == "23503":
+		return ErrorClassification{Class: ErrorClassForeignKeyViolation, Constraint: constraint}, true
+	case state == "23502":
+		return ErrorClassification{Class: ErrorClassNotNullViolation, Constraint: constraint}, true
+	case state == "23514":
+		return ErrorClassification{Class: ErrorClassCheckViolation, Constraint: constraint}, true
+	case state == "40P01":
+		return ErrorClassification{Class: ErrorClassDeadlock}, true
+	case state == "40001":
+		return ErrorClassification{Class: ErrorClassSerializationFailure}, true
+	case state == "55P03":
+		return ErrorClassification{Class: ErrorClassLockTimeout}, true
+	case strings.HasPrefix(state, "08"), state == "57P01", state == "57P02", state == "57P03":
+		return ErrorClassification{Class: ErrorClassConnection}, true
+	}
+	return ErrorClassification{}, false
+}
+
+// classifyConnectionError classifies the driver independent errors of broken or refused connections
+func classifyConnectionError(err error) (ErrorClassification, bool) {
+	switch err {
+	case driver.ErrBadConn, sql.ErrConnDone, io.ErrUnexpectedEOF,
+		syscall.ECONNREFUSED, syscall.ECONNRESET, syscall.ECONNABORTED, syscall.EPIPE:
+		return ErrorClassification{Class: ErrorClassConnection}, true
+	}
+	if _, ok := err.(*net.OpError); ok {
+		return ErrorClassification{Class: ErrorClassConnection}, true
+	}
+	if _, ok := err.(*net.DNSError); ok {
+		return ErrorClassification{Class: ErrorClassConnection}, true
+	}
+	// the ErrInvalidConn of github.com/go-sql-driver/mysql
+	if err.Error() == "invalid connection" {
+		return ErrorClassification{Class: ErrorClassConnection}, true
+	}
+	return ErrorClassification{}, false
+}
+
+// submatch returns the first submatch of re in s, empty if re does not match
+func submatch(re *regexp.Regexp, s string) string {
+	if m := re.FindStringSubmatch(s); m != nil {
+		return m[1]
+	}
+	return ""
+}
@@ -0,0 +1,274 @@
+package libsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// the error types below mimic the errors of the common drivers, and are
+// classified like them, see init
+
+func init() {
+	pkgPath := reflect.TypeOf(testMySQLError{}).PkgPath()
+	for name, classify := range map[string]ErrorClassifier{
+		"testMySQLError":         classifyMySQLError,
+		"testPgError":            classifyPgError,
+		"testPqError":            classifyPqError,
+		"testSQLiteError":        classifyMattnSQLiteError,
+		"testModerncSQLiteError": classifyModerncSQLiteError,
+	} {
+		driverErrorClassifiers[driverErrorType{pkgPath: pkgPath, name: name}] = classify
+	}
+}
+
+type testMySQLError struct {
+	Number   uint16
+	SQLState [5]byte
+	Message  string
+}
+
+func (e *testMySQLError) Error() string {
+	return fmt.Sprintf("Error %d: %s", e.Number, e.Message)
+}
+
+type testPgError struct {
+	Code           string
+	Message        string
+	ConstraintName string
+}
+
+func (e *testPgError) Error() string    { return e.Message }
+func (e *testPgError) SQLState() string { return e.Code }
+
+type testPqErrorCode string
+
+type testPqError struct {
+	Code       testPqErrorCode
+	Message    string
+	Constraint string
+}
+
+func (e *testPqError) Error() string { return e.Message }
+
+type testSQLiteError struct {
+	Code         int
+	ExtendedCode int
+	err          string
+}
+
+func (e testSQLiteError) Error() string { return e.err }
+
+type testModerncSQLiteError struct {
+	code int
+	msg  string
+}
+
+func (e *testModerncSQLiteError) Error() string { return e.msg }
+func (e *testModerncSQLiteError) Code() int     { return e.code }
+
+// testSQLStateError is an error of another driver reporting its SQLSTATE
+type testSQLStateError string
+
+func (e testSQLStateError) Error() string    { return "a-test-error" }
+func (e testSQLStateError) SQLState() string { return string(e) }
+
+// testLookalikeError has the fields of driver errors without being one
+type testLookalikeError struct {
+	Number       uint16
+	Code         string
+	ExtendedCode int
+}
+
+func (e *testLookalikeError) Error() string { return "a-test-error" }
+
+// testJoinedErrors mimics the errors of errors.Join
+type testJoinedErrors []error
+
+func (e testJoinedErrors) Error() string   { return fmt.Sprint([]error(e)) }
+func (e testJoinedErrors) Unwrap() []error { return e }
+
+type testMSSQLError struct {
+	Number  int32
+	Message string
+}
+
+func (e testMSSQLError) Error() string           { return "mssql: " + e.Message }
+func (e testMSSQLError) SQLErrorNumber() int32   { return e.Number }
+func (e testMSSQLError) SQLErrorMessage() string { return e.Message }
+
+func Test_ClassifyError(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		err  error
+		exp  ErrorClassification
+	}{
+		{
+			name: "mysql duplicate entry",
+			err:  &testMySQLError{Number: 1062, Message: "Duplicate entry 'a' for key 'users.uk_name'"},
+			exp:  ErrorClassification{Class: ErrorClassUniqueViolation, Constraint: "users.uk_name"},
+		},
+		{
+			name: "mysql foreign key",
+			err: &testMySQLError{Number: 1452, Message: "Cannot add or update a child row: a foreign key constraint fails " +
+				"(`db`.`orders`, CONSTRAINT `fk_user` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`))"},
+			exp: ErrorClassification{Class: ErrorClassForeignKeyViolation, Constraint: "fk_user"},
+		},
+		{
+			name: "mysql deadlock",
+			err:  &testMySQLError{Number: 1213},
+			exp:  ErrorClassification{Class: ErrorClassDeadlock},
+		},
+		{
+			name: "mysql lock wait timeout",
+			err:  &testMySQLError{Number: 1205},
+			exp:  ErrorClassification{Class: ErrorClassLockTimeout},
+		},
+		{
+			name: "pgx unique violation",
+			err:  &testPgError{Code: "23505", ConstraintName: "users_name_key"},
+			exp:  ErrorClassification{Class: ErrorClassUniqueViolation, Constraint: "users_name_key"},
+		},
+		{
+			name: "pq foreign key violation",
+			err:  &testPqError{Code: "23503", Constraint: "orders_user_id_fkey"},
+			exp:  ErrorClassification{Class: ErrorClassForeignKeyViolation, Constraint: "orders_user_id_fkey"},
+		},
+		{
+			name: "postgres serialization failure",
+			err:  &testPgError{Code: "40001"},
+			exp:  ErrorClassification{Class: ErrorClassSerializationFailure},
+		},
+		{
+			name: "postgres deadlock",
+			err:  &testPqError{Code: "40P01"},
+			exp:  ErrorClassification{Class: ErrorClassDeadlock},
+		},
+		{
+			name: "postgres connection failure",
+			err:  &testPgError{Code: "08006"},
+			exp:  ErrorClassification{Class: ErrorClassConnection},
+		},
+		{
+			name: "sqlite unique",
+			err:  testSQLiteError{Code: 19, ExtendedCode: 2067, err: "UNIQUE constraint failed: users.name"},
+			exp:  ErrorClassification{Class: ErrorClassUniqueViolation, Constraint: "users.name"},
+		},
+		{
+			name: "sqlite busy",
+			err:  testSQLiteError{Code: 5, ExtendedCode: 5, err: "database is locked"},
+			exp:  ErrorClassification{Class: ErrorClassLockTimeout},
+		},
+		{
+			name: "modernc sqlite foreign key",
+			err:  &testModerncSQLiteError{code: 787, msg: "FOREIGN KEY constraint failed"},
+			exp:  ErrorClassification{Class: ErrorClassForeignKeyViolation},
+		},
+		{
+			name: "other driver sqlstate",
+			err:  testSQLStateError("23505"),
+			exp:  ErrorClassification{Class: ErrorClassUniqueViolation},
+		},
+		{
+			name: "lookalike of driver errors",
+			err:  &testLookalikeError{Number: 1062, Code: "23505", ExtendedCode: 2067},
+			exp:  ErrorClassification{},
+		},
+		{
+			name: "sql server unique constraint",
+			err:  testMSSQLError{Number: 2627, Message: "Violation of UNIQUE KEY constraint 'UQ_users_name'. Cannot insert duplicate key in object 'dbo.users'."},
+			exp:  ErrorClassification{Class: ErrorClassUniqueViolation, Constraint: "UQ_users_name"},
+		},
+		{
+			name: "sql server foreign key",
+			err:  testMSSQLError{Number: 547, Message: `The INSERT statement conflicted with the FOREIGN KEY constraint "FK_orders_users".`},
+			exp:  ErrorClassification{Class: ErrorClassForeignKeyViolation, Constraint: "FK_orders_users"},
+		},
+		{
+			name: "sql server check",
+			err:  testMSSQLError{Number: 547, Message: `The INSERT statement conflicted with the CHECK constraint "CK_positive".`},
+			exp:  ErrorClassification{Class: ErrorClassCheckViolation, Constraint: "CK_positive"},
+		},
+		{
+			name: "bad connection",
+			err:  driver.ErrBadConn,
+			exp:  ErrorClassification{Class: ErrorClassConnection},
+		},
+		{
+			name: "network error",
+			err:  &net.OpError{Op: "dial", Err: errors.New("connection refused")},
+			exp:  ErrorClassification{Class: ErrorClassConnection},
+		},
+		{
+			name: "wrapped in QueryError",
+			err:  &QueryError{Op: OperationUpdate, Err: fmt.Errorf("insert: %w", &testMySQLError{Number: 1062})},
+			exp:  ErrorClassification{Class: ErrorClassUniqueViolation},
+		},
+		{
+			name: "joined",
+			err:  testJoinedErrors{errors.New("a-test-error"), &testPgError{Code: "23502"}},
+			exp:  ErrorClassification{Class: ErrorClassNotNullViolation},
+		},
+		{
+			name: "unknown",
+			err:  errors.New("a-test-error"),
+			exp:  ErrorClassification{},
+		},
+		{
+			name: "nil",
+			exp:  ErrorClassification{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, ClassifyError(tc.err))
+		})
+	}
+}
+
+func Test_ErrorClass_predicates(t *testing.T) {
+	require.True(t, IsUniqueViolation(&testMySQLError{Number: 1062}))
+	require.True(t, IsForeignKeyViolation(&testMySQLError{Number: 1451}))
+	require.True(t, IsNotNullViolation(&testPgError{Code: "23502"}))
+	require.True(t, IsCheckViolation(&testPgError{Code: "23514"}))
+	require.True(t, IsDeadlock(testMSSQLError{Number: 1205}))
+	require.True(t, IsLockTimeout(&testPgError{Code: "55P03"}))
+	require.True(t, IsSerializationFailure(&testPgError{Code: "40001"}))
+	require.True(t, IsConnectionError(&QueryError{Err: driver.ErrBadConn}))
+	require.False(t, IsUniqueViolation(&testMySQLError{Number: 1213}))
+	require.Equal(t, "uk_name", ConstraintName(&testMySQLError{Number: 1062, Message: "Duplicate entry 'a' for key 'uk_name'"}))
+	require.Equal(t, "serialization_failure", ErrorClassSerializationFailure.String())
+}
+
+func Test_RegisterErrorClassifier(t *testing.T) {
+	errCustom := errors.New("a-custom-driver-error")
+	errOverridden := &testMySQLError{Number: 1062, Message: "a-test-message"}
+	RegisterErrorClassifier(func(err error) (ErrorClassification, bool) {
+		switch err {
+		case errCustom:
+			return ErrorClassification{Class: ErrorClassDeadlock}, true
+		case errOverridden:
+			return ErrorClassification{Class: ErrorClassLockTimeout}, true
+		}
+		return ErrorClassification{}, false
+	})
+
+	require.True(t, IsDeadlock(fmt.Errorf("wrapped: %w", errCustom)))
+	require.True(t, IsLockTimeout(errOverridden))
+	require.True(t, IsUniqueViolation(&testMySQLError{Number: 1062}))
+}
+
+func Test_isUnavailabilityError(t *testing.T) {
+	require.False(t, isUnavailabilityError(nil))
+	require.False(t, isUnavailabilityError(ErrNoRows))
+	require.False(t, isUnavailabilityError(context.Canceled))
+	require.False(t, isUnavailabilityError(errors.New("a-test-error")))
+	require.True(t, isUnavailabilityError(driver.ErrBadConn))
+	require.True(t, isUnavailabilityError(&QueryError{Op: OperationScan, Err: context.DeadlineExceeded}))
+	require.True(t, isUnavailabilityError(&net.OpError{Op: "read", Err: errors.New("i/o timeout")}))
+}
@@ -6,4 +6,4 @@ require (
 	github.com/stretchr/testify v1.7.0
 )
 
-go 1.12
+go 1.16
@@ -0,0 +1,317 @@
+package libsql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// HealthState is the state of a Database tracked by a HealthChecker
+type HealthState int
+
+const (
+	// HealthUnknown is the state before the first conclusive check
+	HealthUnknown HealthState = iota
+	// HealthHealthy is the state of a responsive Database
+	HealthHealthy
+	// HealthDegraded is the state of a responsive Database with a saturated connection pool
+	HealthDegraded
+	// HealthUnhealthy is the state of a Database failing HealthConfig.FailureThreshold consecutive checks
+	HealthUnhealthy
+)
+
+var healthStateNames = map[HealthState]string{
+	HealthUnknown:   "unknown",
+	HealthHealthy:   "healthy",
+	HealthDegraded:  "degraded",
+	HealthUnhealthy: "unhealthy",
+}
+
+// String implements fmt.Stringer
+func (s HealthState) String() string {
+	if name, ok := healthStateNames[s]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (s HealthState) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// HealthStatus is the status of a Database tracked by a HealthChecker
+type HealthStatus struct {
+	State HealthState `json:"state"`
+	// Ready reports whether the Database can serve requests,
+	// i.e. it is healthy or degraded
+	Ready bool `json:"ready"`
+	// Live reports whether the Database has been healthy or degraded
+	// recently, see HealthConfig.LivenessTimeout
+	Live                bool `json:"live"`
+	ConsecutiveFailures int  `json:"consecutive_failures"`
+	// LastErrorClass is the class of the error of the last check, if it failed.
+	// It is left out of the JSON when the class is unknown
+	LastErrorClass ErrorClass `json:"last_error_class,omitempty"`
+	// LastError is the message of the error of the last check, if it failed.
+	// It may contain details of the database and is only served by the
+	// handlers with HealthConfig.ExposeErrors
+	LastError string        `json:"last_error,omitempty"`
+	LastCheck time.Time     `json:"last_check"`
+	Latency   time.Duration `json:"latency_ns"`
+	Pool      PoolStatus    `json:"pool"`
+}
+
+// PoolStatus summarizes the connection pool statistics of a Database
+type PoolStatus struct {
+	MaxOpenConnections int           `json:"max_open_connections"`
+	OpenConnections    int           `json:"open_connections"`
+	InUse              int           `json:"in_use"`
+	Idle               int           `json:"idle"`
+	WaitCount          int64         `json:"wait_count"`
+	WaitDuration       time.Duration `json:"wait_duration_ns"`
+	// Saturation is the ratio of connections in use to MaxOpenConnections,
+	// zero when the number of open connections is unlimited
+	Saturation float64 `json:"saturation"`
+}
+
+// HealthConfig configures a HealthChecker
+type HealthConfig struct {
+	// Interval is the interval between checks. Defaults to 10 seconds
+	Interval time.Duration
+
+	// Timeout is the timeout of a check. Defaults to 5 seconds
+	Timeout time.Duration
+
+	// Probe is an optional check performed after a successful ping,
+	// e.g. running a query on a critical table
+	Probe func(ctx context.Context, q Queryer) error
+
+	// FailureThreshold is the number of consecutive failed checks after
+	// which the Database is unhealthy. Defaults to 3
+	FailureThreshold int
+
+	// SaturationThreshold is the pool saturation from which the Database is degraded.
+	// Defaults to 0.9
+	SaturationThreshold float64
+
+	// LivenessTimeout is how long the Database may be neither healthy nor
+	// degraded before it is no longer live. Defaults to a minute
+	LivenessTimeout time.Duration
+
+	// ExposeErrors makes the handlers serve HealthStatus.LastError, the message
+	// of the error of the last check. Only enable it if the handlers are not public
+	ExposeErrors bool
+
+	// OnStateChange is called when the state changes, with the status after the change
+	OnStateChange func(from, to HealthState, status HealthStatus)
+
+	// Now returns the current time. Defaults to time.Now
+	Now func() time.Time
+}
+
+// NewHealthChecker returns a HealthChecker of db with the default configuration.
+// It is a shorthand for HealthConfig{}.New(db)
+func NewHealthChecker(db Database) *HealthChecker {
+	return HealthConfig{}.New(db)
+}
+
+// New returns a HealthChecker of db with the configuration
+func (c HealthConfig) New(db Database) *HealthChecker {
+	if c.Interval <= 0 {
+		c.Interval = 10 * time.Second
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = 5 * time.Second
+	}
+	if c.FailureThreshold <= 0 {
+		c.FailureThreshold = 3
+	}
+	if c.SaturationThreshold <= 0 {
+		c.SaturationThreshold = 0.9
+	}
+	if c.LivenessTimeout <= 0 {
+		c.LivenessTimeout = time.Minute
+	}
+	if c.Now == nil {
+		c.Now = time.Now
+	}
+	return &HealthChecker{cfg: c, db: db, created: c.Now()}
+}
+
+// HealthChecker periodically checks a Database, pinging it and running
+// HealthConfig.Probe, and tracks its health.
+//
+// The Database returned by HealthChecker.Database does not receive reads
+// as a replica of a cluster while it is unhealthy, see ClusterConfig.
+// HealthChecker is safe for concurrent use.
+type HealthChecker struct {
+	cfg     HealthConfig
+	db      Database
+	created time.Time
+
+	mu          sync.Mutex
+	status      HealthStatus
+	lastHealthy time.Time
+}
+
+// Run checks the Database every HealthConfig.Interval until ctx is done
+func (h *HealthChecker) Run(ctx context.Context) {
+	ticker := time.NewTicker(h.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		h.Check(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check checks the Database once and returns the resulting status
+func (h *HealthChecker) Check(ctx context.Context) HealthStatus {
+	start := h.cfg.Now()
+	err := h.check(ctx)
+	now := h.cfg.Now()
+	stats := h.db.Stats()
+
+	h.mu.Lock()
+	from := h.status.State
+	h.status.LastCheck = now
+	h.status.Latency = now.Sub(start)
+	h.status.Pool = poolStatus(stats)
+	if err != nil {
+		h.status.ConsecutiveFailures++
+		h.status.LastErrorClass = ClassifyError(err).Class
+		h.status.LastError = err.Error()
+		if h.status.ConsecutiveFailures >= h.cfg.FailureThreshold {
+			h.status.State = HealthUnhealthy
+		}
+	} else {
+		h.status.ConsecutiveFailures = 0
+		h.status.LastErrorClass = ErrorClassUnknown
+		h.status.LastError = ""
+		h.status.State = HealthHealthy
+		if h.status.Pool.Saturation >= h.cfg.SaturationThreshold {
+			h.status.State = HealthDegraded
+		}
+		h.lastHealthy = now
+	}
+	status := h.statusLocked(now)
+	h.mu.Unlock()
+
+	if status.State != from && h.cfg.OnStateChange != nil {
+		h.cfg.OnStateChange(from, status.State, status)
+	}
+	return status
+}
+
+func (h *HealthChecker) check(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, h.cfg.Timeout)
+	defer cancel()
+	if err := h.db.Ping(ctx); err != nil {
+		return err
+	}
+	if h.cfg.Probe != nil {
+		return h.cfg.Probe(ctx, h.db)
+	}
+	return nil
+}
+
+func poolStatus(stats sql.DBStats) PoolStatus {
+	status := PoolStatus{
+		MaxOpenConnections: stats.MaxOpenConnections,
+		OpenConnections:    stats.OpenConnections,
+		InUse:              stats.InUse,
+		Idle:               stats.Idle,
+		WaitCount:          stats.WaitCount,
+		WaitDuration:       stats.WaitDuration,
+	}
+	if stats.MaxOpenConnections > 0 {
+		status.Saturation = float64(stats.InUse) / float64(stats.MaxOpenConnections)
+	}
+	return status
+}
+
+// Status returns the current status
+func (h *HealthChecker) Status() HealthStatus {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.statusLocked(h.cfg.Now())
+}
+
+func (h *HealthChecker) statusLocked(now time.Time) HealthStatus {
+	status := h.status
+	status.Ready = status.State == HealthHealthy || status.State == HealthDegraded
+	lastLive := h.lastHealthy
+	if lastLive.IsZero() {
+		// not live only after having had the time to become healthy
+		lastLive = h.created
+	}
+	status.Live = status.Ready || now.Sub(lastLive) < h.cfg.LivenessTimeout
+	return status
+}
+
+// unhealthy reports whether the Database is unhealthy
+func (h *HealthChecker) unhealthy() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.status.State == HealthUnhealthy
+}
+
+// ReadinessHandler returns an http.Handler serving the status as JSON,
+// with status code 200 if the Database is ready and 503 otherwise.
+// The error message of the last check is left out unless HealthConfig.ExposeErrors
+func (h *HealthChecker) ReadinessHandler() http.Handler {
+	return h.handler(func(status HealthStatus) bool { return status.Ready })
+}
+
+// LivenessHandler returns an http.Handler serving the status as JSON,
+// with status code 200 if the Database is live and 503 otherwise.
+// The error message of the last check is left out unless HealthConfig.ExposeErrors
+func (h *HealthChecker) LivenessHandler() http.Handler {
+	return h.handler(func(status HealthStatus) bool { return status.Live })
+}
+
+func (h *HealthChecker) handler(ok func(HealthStatus) bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		status := h.Status()
+		if !h.cfg.ExposeErrors {
+			status.LastError = ""
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if ok(status) {
+			w.WriteHeader(http.StatusOK)
+		} else {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(status)
+	})
+}
+
+// Database returns the checked Database, aware of its health.
+// A cluster does not route reads to such a replica while it is unhealthy.
+func (h *HealthChecker) Database() Database {
+	return healthCheckedDatabase{Database: h.db, checker: h}
+}
+
+// healthReporter is implemented by Databases aware of their health
+type healthReporter interface {
+	unhealthy() bool
+}
+
+type healthCheckedDatabase struct {
+	Database
+
+	checker *HealthChecker
+}
+
+var _ healthReporter = healthCheckedDatabase{}
+
+func (d healthCheckedDatabase) unhealthy() bool {
+	return d.checker.unhealthy()
+}
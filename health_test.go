@@ -0,0 +1,177 @@
+package libsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/stretchr/testify/require"
+)
+
+type stateChange struct {
+	From, To HealthState
+}
+
+func Test_HealthChecker_StateTransitions(t *testing.T) {
+	ctx := context.Background()
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	expErr := errors.New("a-test-error")
+	pingErrs := []error{nil, expErr, expErr, nil, nil}
+	sqlDB.PingMock.Set(func(context.Context) error {
+		err := pingErrs[0]
+		pingErrs = pingErrs[1:]
+		return err
+	})
+	stats := []sql.DBStats{
+		{MaxOpenConnections: 10, InUse: 1},
+		{MaxOpenConnections: 10, InUse: 1},
+		{MaxOpenConnections: 10, InUse: 1},
+		{MaxOpenConnections: 10, InUse: 1},
+		{MaxOpenConnections: 10, InUse: 9},
+	}
+	sqlDB.StatsMock.Set(func() sql.DBStats {
+		s := stats[0]
+		stats = stats[1:]
+		return s
+	})
+
+	var changes []stateChange
+	checker := HealthConfig{
+		FailureThreshold: 2,
+		OnStateChange: func(from, to HealthState, status HealthStatus) {
+			require.Equal(t, to, status.State)
+			changes = append(changes, stateChange{From: from, To: to})
+		},
+		Now: newFakeClock().Now,
+	}.New(newDatabase(sqlDB, nil))
+
+	require.Equal(t, HealthUnknown, checker.Status().State)
+	require.False(t, checker.Status().Ready)
+
+	status := checker.Check(ctx)
+	require.Equal(t, HealthHealthy, status.State)
+	require.True(t, status.Ready)
+	require.Equal(t, 0.1, status.Pool.Saturation)
+
+	status = checker.Check(ctx)
+	require.Equal(t, HealthHealthy, status.State)
+	require.Equal(t, 1, status.ConsecutiveFailures)
+	require.Contains(t, status.LastError, "a-test-error")
+	require.Equal(t, ErrorClassUnknown, status.LastErrorClass)
+
+	status = checker.Check(ctx)
+	require.Equal(t, HealthUnhealthy, status.State)
+	require.False(t, status.Ready)
+	require.True(t, status.Live)
+
+	status = checker.Check(ctx)
+	require.Equal(t, HealthHealthy, status.State)
+	require.Zero(t, status.ConsecutiveFailures)
+	require.Empty(t, status.LastError)
+
+	status = checker.Check(ctx)
+	require.Equal(t, HealthDegraded, status.State)
+	require.True(t, status.Ready)
+
+	require.Equal(t, []stateChange{
+		{From: HealthUnknown, To: HealthHealthy},
+		{From: HealthHealthy, To: HealthUnhealthy},
+		{From: HealthUnhealthy, To: HealthHealthy},
+		{From: HealthHealthy, To: HealthDegraded},
+	}, changes)
+}
+
+func Test_HealthChecker_Probe(t *testing.T) {
+	ctx := context.Background()
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	sqlDB.PingMock.Return(nil)
+	sqlDB.StatsMock.Return(sql.DBStats{})
+
+	expErr := errors.New("a-test-error")
+	db := newDatabase(sqlDB, nil)
+	checker := HealthConfig{
+		FailureThreshold: 1,
+		Probe: func(_ context.Context, q Queryer) error {
+			require.Equal(t, db, q)
+			return expErr
+		},
+	}.New(db)
+
+	require.Equal(t, HealthUnhealthy, checker.Check(ctx).State)
+}
+
+func Test_HealthChecker_Handlers(t *testing.T) {
+	ctx := context.Background()
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	sqlDB.PingMock.Return(errors.Wrap(driver.ErrBadConn, "a-test-error"))
+	sqlDB.StatsMock.Return(sql.DBStats{})
+
+	clock := newFakeClock()
+	checker := HealthConfig{FailureThreshold: 1, LivenessTimeout: time.Minute, Now: clock.Now}.New(newDatabase(sqlDB, nil))
+
+	serve := func(handler http.Handler) (int, map[string]interface{}) {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/health", nil))
+		require.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+		var body map[string]interface{}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+		return recorder.Code, body
+	}
+
+	code, body := serve(checker.ReadinessHandler())
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, "unknown", body["state"])
+
+	code, _ = serve(checker.LivenessHandler())
+	require.Equal(t, http.StatusOK, code)
+
+	checker.Check(ctx)
+	clock.Advance(time.Minute)
+
+	code, body = serve(checker.LivenessHandler())
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, "unhealthy", body["state"])
+	require.Equal(t, "connection", body["last_error_class"])
+	require.NotContains(t, body, "last_error")
+
+	checker.cfg.ExposeErrors = true
+	_, body = serve(checker.LivenessHandler())
+	require.Contains(t, body["last_error"], "a-test-error: driver: bad connection")
+}
+
+func Test_HealthChecker_UnhealthyReplicaReceivesNoReads(t *testing.T) {
+	ctx := context.Background()
+
+	primary := NewDatabaseMock(t)
+	defer primary.MinimockFinish()
+
+	replica := NewDatabaseMock(t)
+	defer replica.MinimockFinish()
+
+	replica.PingMock.Return(errors.New("a-test-error"))
+	replica.StatsMock.Return(sql.DBStats{})
+	primary.ScanMock.Return(nil)
+
+	checker := HealthConfig{FailureThreshold: 1}.New(replica)
+	checker.Check(ctx)
+
+	db := ClusterConfig{}.New(primary, checker.Database())
+	require.NoError(t, db.Scan(ctx, Into(), "SELECT 1"))
+	require.Equal(t, uint64(1), primary.ScanAfterCounter())
+}
@@ -0,0 +1,234 @@
+package libsql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+var (
+	errNoResult    = errors.New("libsql: the update was short-circuited without a result")
+	errNoStatement = errors.New("libsql: the preparation was short-circuited without a statement")
+)
+
+// Interceptor intercepts operations performed through a Database, including
+// operations of its Transactions, Connections and Statements.
+//
+// An Interceptor proceeds with the operation by calling next, possibly with
+// a modified context, and returns the error returned by next.
+// It short-circuits the operation by returning without calling next.
+// The fields of op describing the outcome of the operation are set when next returns.
+type Interceptor func(ctx context.Context, op *Operation, next func(context.Context) error) error
+
+// WithInterceptors adds interceptors to a Database.
+// Interceptors are called in order, the first one being the outermost.
+func WithInterceptors(interceptors ...Interceptor) Option {
+	return func(c *config) {
+		c.interceptors = append(c.interceptors, interceptors...)
+	}
+}
+
+// OperationKind is the kind of an intercepted Operation
+type OperationKind int
+
+const (
+	// OperationScan is a Scan
+	OperationScan OperationKind = iota + 1
+	// OperationScanOne is a ScanOne
+	OperationScanOne
+	// OperationUpdate is an Update or any of its variants
+	OperationUpdate
+	// OperationPrepare is the preparation of a Statement
+	OperationPrepare
+	// OperationBegin is the beginning of a transaction
+	OperationBegin
+	// OperationCommit is the commit of a transaction
+	OperationCommit
+	// OperationRollback is the rollback of a transaction
+	OperationRollback
+	// OperationTransaction is a whole transaction, enclosing its begin,
+	// the operations performed in it and its commit or rollback
+	OperationTransaction
+	// OperationPing is a Ping. It is not intercepted and only reported by QueryErrors
+	OperationPing
+	// OperationConn is the acquisition of a Connection. It is not intercepted
+	// and only reported by QueryErrors
+	OperationConn
+)
+
+var operationKindNames = map[OperationKind]string{
+	OperationScan:        "scan",
+	OperationScanOne:     "scanOne",
+	OperationUpdate:      "update",
+	OperationPrepare:     "prepare",
+	OperationBegin:       "begin",
+	OperationCommit:      "commit",
+	OperationRollback:    "rollback",
+	OperationTransaction: "transaction",
+	OperationPing:        "ping",
+	OperationConn:        "conn",
+}
+
+// String implements fmt.Stringer
+func (k OperationKind) String() string {
+	if name, ok := operationKindNames[k]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// Operation describes an intercepted database operation
+type Operation struct {
+	// Kind is the kind of the operation
+	Kind OperationKind
+
+	// SQL is the SQL of the operation, empty for begin, commit and rollback.
+	// Interceptors may replace SQL before calling next, except for operations
+	// executing a prepared Statement
+	SQL string
+
+	// Args are the arguments of the operation.
+	// Interceptors may replace Args before calling next
+	Args []interface{}
+
+	// Prepared is set for operations executing a prepared Statement
+	Prepared bool
+
+	// InTransaction is set for operations performed in a transaction
+	InTransaction bool
+
+	// TransactionContext is the context the enclosing OperationTransaction
+	// was performed with, for operations performed in a transaction.
+	// It allows relating these operations to their transaction since
+	// they are performed with the contexts given to the Transaction
+	TransactionContext context.Context
+
+	// Attempt is the number of the attempt of the operation, starting at 1.
+	// It is greater than 1 for reads retried according to a RetryPolicy
+	Attempt int
+
+	// Duration is how long the operation took
+	Duration time.Duration
+
+	// RowsScanned is the number of rows scanned by scans and by UpdateReturning
+	RowsScanned int64
+
+	// Result is the result of an update.
+	// Interceptors short-circuiting an update may set it
+	Result sql.Result
+}
+
+// intercepting reports whether any interceptors are configured
+func (c *config) intercepting() bool {
+	return c != nil && len(c.interceptors) > 0
+}
+
+// operation returns a new Operation
+func (c *config) operation(kind OperationKind, sql string, args []interface{}) *Operation {
+	op := &Operation{
+		Kind:    kind,
+		SQL:     sql,
+		Args:    args,
+		Attempt: 1,
+	}
+	if c != nil {
+		op.InTransaction = c.inTransaction
+		op.TransactionContext = c.txContext
+	}
+	return op
+}
+
+// intercept performs op by calling invoke through the configured interceptors
+// Errors of operations other than transactions are wrapped in a QueryError.
+func (c *config) intercept(ctx context.Context, op *Operation, invoke func(context.Context) error) error {
+	start := time.Now()
+	if !c.intercepting() {
+		return queryError(op, start, invoke(ctx))
+	}
+
+	var call func(ctx context.Context, i int) error
+	call = func(ctx context.Context, i int) error {
+		if i == len(c.interceptors) {
+			start := time.Now()
+			err := invoke(ctx)
+			op.Duration = time.Since(start)
+			return err
+		}
+		return c.interceptors[i](ctx, op, func(ctx context.Context) error {
+			return call(ctx, i+1)
+		})
+	}
+	return queryError(op, start, call(ctx, 0))
+}
+
+// interceptScan performs a scan op through the configured interceptors, counting the rows scanned.
+// Reads are retried according to the configured RetryPolicy
+func (c *config) interceptScan(
+	ctx context.Context,
+	op *Operation,
+	scanner RowScanner,
+	scan func(context.Context, RowScanner) error,
+) error {
+	if c.retrying(op) {
+		return c.retries.do(ctx, op, scanner, func(scanner RowScanner) error {
+			return c.interceptScanAttempt(ctx, op, scanner, scan)
+		})
+	}
+	return c.interceptScanAttempt(ctx, op, scanner, scan)
+}
+
+// interceptScanAttempt performs an attempt of a scan op through the configured interceptors
+func (c *config) interceptScanAttempt(
+	ctx context.Context,
+	op *Operation,
+	scanner RowScanner,
+	scan func(context.Context, RowScanner) error,
+) error {
+	if !c.intercepting() {
+		start := time.Now()
+		return queryError(op, start, scan(ctx, scanner))
+	}
+	return c.intercept(ctx, op, func(ctx context.Context) error {
+		counter := &countingScanner{RowScanner: scanner}
+		err := scan(ctx, counter)
+		op.RowsScanned = counter.rowsScanned
+		return err
+	})
+}
+
+// interceptUpdate performs an update op through the configured interceptors
+func (c *config) interceptUpdate(
+	ctx context.Context,
+	op *Operation,
+	exec func(context.Context) (sql.Result, error),
+) (sql.Result, error) {
+	err := c.intercept(ctx, op, func(ctx context.Context) error {
+		result, err := exec(ctx)
+		op.Result = result
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if op.Result == nil {
+		// short-circuited by an interceptor
+		return noResult{}, nil
+	}
+	return op.Result, nil
+}
+
+// noResult is the result of an update short-circuited without a Result
+type noResult struct{}
+
+var _ sql.Result = (*noResult)(nil)
+
+// LastInsertId implements sql.Result.LastInsertId
+func (noResult) LastInsertId() (int64, error) {
+	return 0, errNoResult
+}
+
+// RowsAffected implements sql.Result.RowsAffected
+func (noResult) RowsAffected() (int64, error) {
+	return 0, nil
+}
@@ -0,0 +1,216 @@
+package libsql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/stretchr/testify/require"
+)
+
+type recordedOperation struct {
+	Kind          OperationKind
+	SQL           string
+	Args          []interface{}
+	Prepared      bool
+	InTransaction bool
+	RowsScanned   int64
+	Err           error
+}
+
+type operationRecorder struct {
+	ops []recordedOperation
+}
+
+func (r *operationRecorder) intercept(ctx context.Context, op *Operation, next func(context.Context) error) error {
+	err := next(ctx)
+	r.ops = append(r.ops, recordedOperation{
+		Kind:          op.Kind,
+		SQL:           op.SQL,
+		Args:          op.Args,
+		Prepared:      op.Prepared,
+		InTransaction: op.InTransaction,
+		RowsScanned:   op.RowsScanned,
+		Err:           err,
+	})
+	return err
+}
+
+func newRowsMock(t *testing.T, rows int) *SqlRowsMock {
+	sqlRows := NewSqlRowsMock(t)
+	next := 0
+	sqlRows.NextMock.Set(func() bool {
+		next++
+		return next <= rows
+	})
+	sqlRows.ScanMock.Return(nil)
+	sqlRows.ErrMock.Return(nil)
+	sqlRows.CloseMock.Return(nil)
+	return sqlRows
+}
+
+func Test_WithInterceptors_Scan(t *testing.T) {
+	ctx := context.Background()
+	const expQuery = "SELECT x FROM aTable WHERE y = ?"
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	sqlRows := newRowsMock(t, 2)
+	defer sqlRows.MinimockFinish()
+
+	sqlDB.QueryMock.Expect(ctx, expQuery, 1).Return(sqlRows, nil)
+
+	recorder := &operationRecorder{}
+	db := newDatabase(sqlDB, newConfig([]Option{WithInterceptors(recorder.intercept)}))
+
+	require.NoError(t, db.Scan(ctx, Into(), expQuery, 1))
+	require.Equal(t, []recordedOperation{{
+		Kind:        OperationScan,
+		SQL:         expQuery,
+		Args:        []interface{}{1},
+		RowsScanned: 2,
+	}}, recorder.ops)
+}
+
+func Test_WithInterceptors_Order(t *testing.T) {
+	ctx := context.Background()
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	sqlResult := NewSqlResultMock(t)
+	defer sqlResult.MinimockFinish()
+
+	type ctxKey struct{}
+	sqlDB.ExecMock.Set(func(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+		require.Equal(t, "outer", ctx.Value(ctxKey{}))
+		require.Equal(t, "UPDATE aTable SET x = 1 /* inner */", query)
+		return sqlResult, nil
+	})
+
+	var calls []string
+	outer := func(ctx context.Context, op *Operation, next func(context.Context) error) error {
+		calls = append(calls, "outer")
+		return next(context.WithValue(ctx, ctxKey{}, "outer"))
+	}
+	inner := func(ctx context.Context, op *Operation, next func(context.Context) error) error {
+		calls = append(calls, "inner")
+		op.SQL += " /* inner */"
+		return next(ctx)
+	}
+
+	db := newDatabase(sqlDB, newConfig([]Option{WithInterceptors(outer), WithInterceptors(inner)}))
+
+	actualResult, err := db.Update(ctx, "UPDATE aTable SET x = 1")
+	require.NoError(t, err)
+	require.Equal(t, sqlResult, actualResult)
+	require.Equal(t, []string{"outer", "inner"}, calls)
+}
+
+func Test_WithInterceptors_ShortCircuit(t *testing.T) {
+	ctx := context.Background()
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	expErr := errors.New("a-test-error")
+	failing := func(ctx context.Context, op *Operation, next func(context.Context) error) error {
+		if op.Kind == OperationScanOne {
+			return expErr
+		}
+		return nil
+	}
+
+	db := newDatabase(sqlDB, newConfig([]Option{WithInterceptors(failing)}))
+
+	require.ErrorIs(t, db.ScanOne(ctx, Into(), "SELECT 1"), expErr)
+
+	rowsAffected, err := db.UpdateAndGetRowsAffected(ctx, "UPDATE aTable SET x = 1")
+	require.NoError(t, err)
+	require.Zero(t, rowsAffected)
+
+	_, err = db.UpdateAndGetLastInsertID(ctx, "UPDATE aTable SET x = 1")
+	require.Equal(t, errNoResult, err)
+
+	err = db.Prepared(ctx, "SELECT 1", nil)
+	require.Equal(t, errNoStatement, err)
+}
+
+func Test_WithInterceptors_Transaction(t *testing.T) {
+	ctx := context.Background()
+	const expQuery = "UPDATE aTable SET x = ?"
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	sqlTx := NewSqlTxMock(t)
+	defer sqlTx.MinimockFinish()
+
+	sqlStmt := NewSqlStmtMock(t)
+	defer sqlStmt.MinimockFinish()
+
+	sqlResult := NewSqlResultMock(t)
+	defer sqlResult.MinimockFinish()
+
+	sqlDB.BeginMock.Return(sqlTx, nil)
+	sqlTx.PrepareMock.Expect(ctx, expQuery).Return(sqlStmt, nil)
+	sqlTx.CommitMock.Return(nil)
+	sqlTx.RollbackMock.Return(sql.ErrTxDone)
+	sqlStmt.ExecMock.Return(sqlResult, nil)
+	sqlStmt.CloseMock.Return(nil)
+
+	recorder := &operationRecorder{}
+	db := newDatabase(sqlDB, newConfig([]Option{WithInterceptors(recorder.intercept)}))
+
+	err := db.Transaction(ctx, func(tx Transaction) error {
+		return tx.Prepared(ctx, expQuery, func(stmt Statement) error {
+			_, err := stmt.Update(ctx, 42)
+			return err
+		})
+	})
+	require.NoError(t, err)
+	require.Equal(t, []recordedOperation{
+		{Kind: OperationBegin},
+		{Kind: OperationPrepare, SQL: expQuery, InTransaction: true},
+		{Kind: OperationUpdate, SQL: expQuery, Args: []interface{}{42}, Prepared: true, InTransaction: true},
+		{Kind: OperationCommit, InTransaction: true},
+		{Kind: OperationTransaction},
+	}, recorder.ops)
+}
+
+func Test_WithInterceptors_TransactionRollback(t *testing.T) {
+	ctx := context.Background()
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	sqlTx := NewSqlTxMock(t)
+	defer sqlTx.MinimockFinish()
+
+	sqlDB.BeginMock.Return(sqlTx, nil)
+	sqlTx.RollbackMock.Return(nil)
+
+	recorder := &operationRecorder{}
+	db := newDatabase(sqlDB, newConfig([]Option{WithInterceptors(recorder.intercept)}))
+
+	expErr := errors.New("a-test-error")
+	err := db.Transaction(ctx, func(tx Transaction) error {
+		return expErr
+	})
+	require.Equal(t, expErr, err)
+	require.Equal(t, []recordedOperation{
+		{Kind: OperationBegin},
+		{Kind: OperationRollback, InTransaction: true},
+		{Kind: OperationTransaction, Err: expErr},
+	}, recorder.ops)
+}
+
+func Test_OperationKind_String(t *testing.T) {
+	require.Equal(t, "scanOne", OperationScanOne.String())
+	require.Equal(t, "rollback", OperationRollback.String())
+	require.Equal(t, "ping", OperationPing.String())
+	require.Equal(t, "unknown", OperationKind(0).String())
+}
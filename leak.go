@@ -0,0 +1,264 @@
+package libsql
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// HandleKind is the kind of a handle tracked by a LeakDetector
+type HandleKind int
+
+const (
+	// HandlePreparedStatement is a PreparedStatement returned by Database.PrepareStatement
+	HandlePreparedStatement HandleKind = iota + 1
+	// HandleScan is a scan in progress
+	HandleScan
+)
+
+// String implements fmt.Stringer
+func (k HandleKind) String() string {
+	switch k {
+	case HandlePreparedStatement:
+		return "preparedStatement"
+	case HandleScan:
+		return "scan"
+	}
+	return "unknown"
+}
+
+// LeakReason is the reason a handle is reported as leaked
+type LeakReason int
+
+const (
+	// LeakOpenAtClose is a handle still open when its Database is closed
+	LeakOpenAtClose LeakReason = iota + 1
+	// LeakGarbageCollected is a PreparedStatement garbage collected without being closed
+	LeakGarbageCollected
+)
+
+// String implements fmt.Stringer
+func (r LeakReason) String() string {
+	switch r {
+	case LeakOpenAtClose:
+		return "open at close"
+	case LeakGarbageCollected:
+		return "garbage collected"
+	}
+	return "unknown"
+}
+
+// OpenHandle describes a handle tracked by a LeakDetector
+type OpenHandle struct {
+	ID   uint64
+	Kind HandleKind
+	SQL  string
+	// Opened is when the handle was opened
+	Opened time.Time
+	// Stack is the stack trace of the goroutine that opened the handle
+	Stack string
+}
+
+// Leak is a leaked handle reported by a LeakDetector
+type Leak struct {
+	OpenHandle
+	Reason LeakReason
+}
+
+// LeakDetector tracks the PreparedStatements and the scans in progress of
+// Databases, with the stack traces they were created from, and reports
+// PreparedStatements that are garbage collected without being closed and
+// handles that are still open when their Database is closed.
+//
+// Capturing stack traces is costly: a LeakDetector is meant for debugging.
+// A LeakDetector may be shared between Databases.
+// LeakDetector is safe for concurrent use.
+type LeakDetector struct {
+	report func(Leak)
+	now    func() time.Time
+
+	mu      sync.Mutex
+	nextID  uint64
+	handles map[uint64]*trackedHandle
+}
+
+type trackedHandle struct {
+	OpenHandle
+	owner *leakTracker
+}
+
+// NewLeakDetector returns a LeakDetector calling report for each leak.
+// A nil report logs leaks with the standard logger.
+func NewLeakDetector(report func(Leak)) *LeakDetector {
+	if report == nil {
+		report = logLeak
+	}
+	return &LeakDetector{
+		report:  report,
+		now:     time.Now,
+		handles: make(map[uint64]*trackedHandle),
+	}
+}
+
+func logLeak(leak Leak) {
+	log.Printf("libsql: leaked %s (%s) of %q opened at %s:\n%s",
+		leak.Kind, leak.Reason, NormalizeSQL(leak.SQL), leak.Opened.Format(time.RFC3339Nano), leak.Stack)
+}
+
+// WithLeakDetector makes a Database track its handles with detector
+func WithLeakDetector(detector *LeakDetector) Option {
+	return func(c *config) {
+		tracker := &leakTracker{detector: detector}
+		c.leaks = tracker
+		c.interceptors = append(c.interceptors, tracker.interceptScan)
+	}
+}
+
+// Snapshot returns the currently open handles, oldest first
+func (d *LeakDetector) Snapshot() []OpenHandle {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	handles := make([]OpenHandle, 0, len(d.handles))
+	for _, h := range d.handles {
+		handles = append(handles, h.OpenHandle)
+	}
+	sort.Slice(handles, func(i, j int) bool {
+		return handles[i].ID < handles[j].ID
+	})
+	return handles
+}
+
+// open tracks a new handle, returning its ID
+func (d *LeakDetector) open(owner *leakTracker, kind HandleKind, sql string) uint64 {
+	stack := captureStack(3)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.nextID++
+	d.handles[d.nextID] = &trackedHandle{
+		OpenHandle: OpenHandle{
+			ID:     d.nextID,
+			Kind:   kind,
+			SQL:    sql,
+			Opened: d.now(),
+			Stack:  stack,
+		},
+		owner: owner,
+	}
+	return d.nextID
+}
+
+// close stops tracking the handle with id, reporting whether it was tracked
+func (d *LeakDetector) close(id uint64) (OpenHandle, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	h, ok := d.handles[id]
+	if !ok {
+		return OpenHandle{}, false
+	}
+	delete(d.handles, id)
+	return h.OpenHandle, true
+}
+
+// closeOwnedBy stops tracking the handles of owner, returning them oldest first
+func (d *LeakDetector) closeOwnedBy(owner *leakTracker) []OpenHandle {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var handles []OpenHandle
+	for id, h := range d.handles {
+		if h.owner == owner {
+			handles = append(handles, h.OpenHandle)
+			delete(d.handles, id)
+		}
+	}
+	sort.Slice(handles, func(i, j int) bool {
+		return handles[i].ID < handles[j].ID
+	})
+	return handles
+}
+
+// leakTracker tracks the handles of a single Database with a LeakDetector
+type leakTracker struct {
+	detector *LeakDetector
+}
+
+func (t *leakTracker) interceptScan(ctx context.Context, op *Operation, next func(context.Context) error) error {
+	if op.Kind != OperationScan && op.Kind != OperationScanOne {
+		return next(ctx)
+	}
+	id := t.detector.open(t, HandleScan, op.SQL)
+	defer t.detector.close(id)
+	return next(ctx)
+}
+
+// trackStatement tracks ps, reporting it if it is garbage collected without being
+// closed, and then closing its underlying statement
+func (t *leakTracker) trackStatement(ps *preparedStatementImpl, sql string) {
+	id := t.detector.open(t, HandlePreparedStatement, sql)
+	ps.Closer = leakTrackingCloser{Closer: ps.Closer, release: func() {
+		t.detector.close(id)
+	}}
+	runtime.SetFinalizer(ps, func(ps *preparedStatementImpl) {
+		if h, ok := t.detector.close(id); ok {
+			t.detector.report(Leak{OpenHandle: h, Reason: LeakGarbageCollected})
+			ignoreClose(ps.Closer)
+		}
+	})
+}
+
+// reportOpen reports the handles still open, which are leaked
+func (t *leakTracker) reportOpen() {
+	for _, h := range t.detector.closeOwnedBy(t) {
+		t.detector.report(Leak{OpenHandle: h, Reason: LeakOpenAtClose})
+	}
+}
+
+// leakTrackingCloser stops the tracking of a handle when it is closed
+type leakTrackingCloser struct {
+	io.Closer
+
+	release func()
+}
+
+// Close implements io.Closer
+func (c leakTrackingCloser) Close() error {
+	c.release()
+	return c.Closer.Close()
+}
+
+// reportLeaks reports the handles still open, if leaks are tracked
+func (c *config) reportLeaks() {
+	if c != nil && c.leaks != nil {
+		c.leaks.reportOpen()
+	}
+}
+
+// trackStatement tracks ps, if leaks are tracked
+func (c *config) trackStatement(ps *preparedStatementImpl, sql string) {
+	if c != nil && c.leaks != nil {
+		c.leaks.trackStatement(ps, sql)
+	}
+}
+
+// captureStack returns the stack trace of the calling goroutine, skipping skip frames
+func captureStack(skip int) string {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(skip+1, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	var b strings.Builder
+	for {
+		frame, more := frames.Next()
+		fmt.Fprintf(&b, "%s\n\t%s:%d\n", frame.Function, frame.File, frame.Line)
+		if !more {
+			return b.String()
+		}
+	}
+}
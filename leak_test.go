@@ -0,0 +1,156 @@
+package libsql
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LeakDetector_TracksScans(t *testing.T) {
+	ctx := context.Background()
+	const expQuery = "SELECT x FROM aTable"
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	sqlRows := newRowsMock(t, 1)
+	defer sqlRows.MinimockFinish()
+
+	sqlDB.QueryMock.Return(sqlRows, nil)
+
+	detector := NewLeakDetector(nil)
+	var inProgress []OpenHandle
+	snapshot := func(ctx context.Context, op *Operation, next func(context.Context) error) error {
+		inProgress = detector.Snapshot()
+		return next(ctx)
+	}
+	db := newDatabase(sqlDB, newConfig([]Option{WithLeakDetector(detector), WithInterceptors(snapshot)}))
+
+	require.NoError(t, db.Scan(ctx, Into(), expQuery))
+	require.Len(t, inProgress, 1)
+	require.Equal(t, HandleScan, inProgress[0].Kind)
+	require.Equal(t, expQuery, inProgress[0].SQL)
+	require.Contains(t, inProgress[0].Stack, "Test_LeakDetector_TracksScans")
+	require.Empty(t, detector.Snapshot())
+}
+
+func Test_LeakDetector_ReportsStatementsOpenAtClose(t *testing.T) {
+	ctx := context.Background()
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	closedStmt := NewSqlStmtMock(t)
+	defer closedStmt.MinimockFinish()
+
+	leakedStmt := NewSqlStmtMock(t)
+	defer leakedStmt.MinimockFinish()
+
+	sqlDB.PrepareMock.When(ctx, "SELECT 1").Then(closedStmt, nil)
+	sqlDB.PrepareMock.When(ctx, "SELECT 2").Then(leakedStmt, nil)
+	sqlDB.CloseMock.Return(nil)
+	closedStmt.CloseMock.Return(nil)
+
+	var leaks []Leak
+	detector := NewLeakDetector(func(leak Leak) {
+		leaks = append(leaks, leak)
+	})
+	db := newDatabase(sqlDB, newConfig([]Option{WithLeakDetector(detector)}))
+
+	closed, err := db.PrepareStatement(ctx, "SELECT 1")
+	require.NoError(t, err)
+	leaked, err := db.PrepareStatement(ctx, "SELECT 2")
+	require.NoError(t, err)
+	require.Len(t, detector.Snapshot(), 2)
+
+	require.NoError(t, closed.Close())
+	handles := detector.Snapshot()
+	require.Len(t, handles, 1)
+	require.Equal(t, HandlePreparedStatement, handles[0].Kind)
+	require.Equal(t, "SELECT 2", handles[0].SQL)
+
+	require.NoError(t, db.Close())
+	require.Len(t, leaks, 1)
+	require.Equal(t, LeakOpenAtClose, leaks[0].Reason)
+	require.Equal(t, handles[0], leaks[0].OpenHandle)
+	require.Empty(t, detector.Snapshot())
+	runtime.KeepAlive(leaked)
+}
+
+func Test_LeakDetector_ReportsGarbageCollectedStatements(t *testing.T) {
+	ctx := context.Background()
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	sqlStmt := NewSqlStmtMock(t)
+	defer sqlStmt.MinimockFinish()
+
+	sqlDB.PrepareMock.Return(sqlStmt, nil)
+	sqlStmt.CloseMock.Return(nil)
+
+	leaks := make(chan Leak, 2)
+	detector := NewLeakDetector(func(leak Leak) {
+		leaks <- leak
+	})
+	db := newDatabase(sqlDB, newConfig([]Option{WithLeakDetector(detector)}))
+
+	closed, err := db.PrepareStatement(ctx, "SELECT 2")
+	require.NoError(t, err)
+	require.NoError(t, closed.Close())
+	require.Equal(t, uint64(1), sqlStmt.CloseAfterCounter())
+
+	_, err = db.PrepareStatement(ctx, "SELECT 1")
+	require.NoError(t, err)
+
+	for i := 0; i < 50; i++ {
+		runtime.GC()
+		select {
+		case leak := <-leaks:
+			require.Equal(t, LeakGarbageCollected, leak.Reason)
+			require.Equal(t, "SELECT 1", leak.SQL)
+			require.Empty(t, detector.Snapshot())
+			require.Eventually(t, func() bool {
+				return sqlStmt.CloseAfterCounter() == 2
+			}, time.Second, time.Millisecond)
+			return
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	require.Fail(t, "the leaked statement was not reported")
+}
+
+func Test_LeakDetector_IsSharedBetweenDatabases(t *testing.T) {
+	ctx := context.Background()
+
+	sqlDB1 := NewSqlDBMock(t)
+	defer sqlDB1.MinimockFinish()
+
+	sqlDB2 := NewSqlDBMock(t)
+	defer sqlDB2.MinimockFinish()
+
+	sqlStmt := NewSqlStmtMock(t)
+	defer sqlStmt.MinimockFinish()
+
+	sqlDB1.CloseMock.Return(nil)
+	sqlDB2.PrepareMock.Return(sqlStmt, nil)
+	sqlStmt.CloseMock.Return(nil)
+
+	var leaks []Leak
+	detector := NewLeakDetector(func(leak Leak) {
+		leaks = append(leaks, leak)
+	})
+	db1 := newDatabase(sqlDB1, newConfig([]Option{WithLeakDetector(detector)}))
+	db2 := newDatabase(sqlDB2, newConfig([]Option{WithLeakDetector(detector)}))
+
+	ps, err := db2.PrepareStatement(ctx, "SELECT 1")
+	require.NoError(t, err)
+
+	require.NoError(t, db1.Close())
+	require.Empty(t, leaks)
+	require.Len(t, detector.Snapshot(), 1)
+	require.NoError(t, ps.Close())
+}
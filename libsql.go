@@ -8,11 +8,25 @@ import (
 )
 
 // Wrap returns a Database wrapping a given *sql.DB.
-func Wrap(db *sql.DB) Database {
-	return newDatabase(newSQLDB(db))
+func Wrap(db *sql.DB, opts ...Option) Database {
+	cfg := newConfig(opts)
+	sqlDB := newSQLDB(db)
+	cfg.configurePool(sqlDB)
+	return newDatabase(cfg.wrapSQLDB(sqlDB), cfg)
+}
+
+// Open opens a database with sql.Open and wraps it.
+// Refer to sql.Open's godoc for details.
+func Open(driverName, dataSourceName string, opts ...Option) (Database, error) {
+	db, err := sql.Open(driverName, dataSourceName)
+	if err != nil {
+		return nil, err
+	}
+	return Wrap(db, opts...), nil
 }
 
 //go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i Database -o libsqltest/ -s _mock.go
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i Database -s _mock_test.go
 
 // Database provides fluent database API.
 type Database interface {
@@ -25,6 +39,15 @@ type Database interface {
 	// Transaction is committed if work returns nil, and rolled back otherwise.
 	Transaction(ctx context.Context, work func(Transaction) error) error
 
+	// Conn reserves a single connection from the pool and runs work on it.
+	//
+	// Queries performed through the Connection all use the same underlying
+	// connection, so session-scoped state such as temporary tables, session
+	// variables, named locks or LAST_INSERT_ID() is retained between them.
+	// The connection is always returned to the pool when this method returns.
+	// Refer to sql.Conn's godoc for details.
+	Conn(ctx context.Context, work func(Connection) error) error
+
 	// PrepareStatement prepares a statement for later queries.
 	//
 	// In addition to preparing a statement on a single connection, the returned
@@ -45,6 +68,24 @@ type Database interface {
 	// The caller must call Close on the returned PreparedStatement when it is
 	// no longer needed.
 	PrepareStatement(ctx context.Context, sql string) (PreparedStatement, error)
+
+	// Ping verifies that the database is still alive, establishing a connection if necessary.
+	Ping(ctx context.Context) error
+
+	// Stats returns the statistics of the connection pool.
+	Stats() sql.DBStats
+}
+
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i Connection -o libsqltest/ -s _mock.go
+
+// Connection represents a single connection reserved from the Database's pool
+type Connection interface {
+	Queryer
+	Preparer
+
+	// Transaction performs work in transaction on the connection.
+	// Transaction is committed if work returns nil, and rolled back otherwise.
+	Transaction(ctx context.Context, work func(Transaction) error) error
 }
 
 //go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i Transaction -o libsqltest/ -s _mock.go
@@ -55,8 +96,47 @@ type Transaction interface {
 	Preparer
 }
 
-// ErrNoRows is returned by ScanOne when a query returns no rows
-var ErrNoRows = errors.New("no rows, expected 1")
+// ErrNoRows is returned by ScanOne when a query returns no rows, as a NoRowsError
+// wrapped in a QueryError by Databases. Check for it with errors.Is, which also
+// reports that it matches sql.ErrNoRows.
+//
+// The returned errors are not ErrNoRows itself, so comparisons such as
+// err == libsql.ErrNoRows no longer match and must be replaced with
+// errors.Is(err, libsql.ErrNoRows)
+var ErrNoRows error = &NoRowsError{}
+
+// NoRowsError is the error of ScanOne when a query returns no rows.
+// errors.Is reports that it matches both ErrNoRows and sql.ErrNoRows.
+type NoRowsError struct {
+	// Fingerprint is the NormalizeSQL fingerprint of the query, empty if unknown
+	Fingerprint string
+}
+
+// Error implements error
+func (e *NoRowsError) Error() string {
+	return "no rows, expected 1"
+}
+
+// Is reports whether target is ErrNoRows or sql.ErrNoRows
+func (e *NoRowsError) Is(target error) bool {
+	return target == ErrNoRows || target == sql.ErrNoRows
+}
+
+// noRowsOf returns a NoRowsError with the fingerprint of query if err is ErrNoRows, err otherwise
+func noRowsOf(err error, query string) error {
+	if err == ErrNoRows {
+		return &NoRowsError{Fingerprint: NormalizeSQL(query)}
+	}
+	return err
+}
+
+// found returns whether err of ScanOne is not a no rows error, and err unless it is
+func found(err error) (bool, error) {
+	if errors.Is(err, ErrNoRows) {
+		return false, nil
+	}
+	return err == nil, err
+}
 
 //go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i Queryer -o libsqltest/ -s _mock.go
 
@@ -69,6 +149,10 @@ type Queryer interface {
 	// Returns ErrNoRows if no rows were returned. Remaining rows are discarded
 	ScanOne(ctx context.Context, scanner RowScanner, sql string, args ...interface{}) error
 
+	// ScanOptional executes sql and scans the first result row with RowScanner, like ScanOne.
+	// Returns false instead of ErrNoRows if no rows were returned
+	ScanOptional(ctx context.Context, scanner RowScanner, sql string, args ...interface{}) (bool, error)
+
 	// Update executes sql insert, update, or delete
 	Update(ctx context.Context, sql string, args ...interface{}) (sql.Result, error)
 
@@ -79,6 +163,11 @@ type Queryer interface {
 	// UpdateAndGetLastInsertID sql insert, update, or delete and returns last generated row id.
 	// Shorthand for Update(...) followed by UpdateResult.LastInsertId
 	UpdateAndGetLastInsertID(ctx context.Context, sql string, args ...interface{}) (int64, error)
+
+	// UpdateReturning executes sql insert, update, or delete returning rows,
+	// e.g. using a RETURNING or OUTPUT clause, and scans the returned rows with RowScanner.
+	// Returns the number of rows scanned as the affected row count
+	UpdateReturning(ctx context.Context, scanner RowScanner, sql string, args ...interface{}) (int64, error)
 }
 
 //go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i Preparer -o libsqltest/ -s _mock.go
@@ -101,6 +190,10 @@ type Statement interface {
 	// Returns ErrNoRows if no rows were returned. Remaining rows are discarded
 	ScanOne(ctx context.Context, scanner RowScanner, args ...interface{}) error
 
+	// ScanOptional executes the prepared statement and scans the first result row with RowScanner, like ScanOne.
+	// Returns false instead of ErrNoRows if no rows were returned
+	ScanOptional(ctx context.Context, scanner RowScanner, args ...interface{}) (bool, error)
+
 	// Update executes the prepared insert, update, or delete
 	Update(ctx context.Context, args ...interface{}) (sql.Result, error)
 
@@ -111,6 +204,11 @@ type Statement interface {
 	// UpdateAndGetLastInsertID the prepared insert, update, or delete and returns last generated row id.
 	// Shorthand for Update(...) followed by UpdateResult.LastInsertId
 	UpdateAndGetLastInsertID(ctx context.Context, args ...interface{}) (int64, error)
+
+	// UpdateReturning executes the prepared insert, update, or delete returning rows,
+	// e.g. using a RETURNING or OUTPUT clause, and scans the returned rows with RowScanner.
+	// Returns the number of rows scanned as the affected row count
+	UpdateReturning(ctx context.Context, scanner RowScanner, args ...interface{}) (int64, error)
 }
 
 //go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i PreparedStatement -o libsqltest/ -s _mock.go
@@ -0,0 +1,232 @@
+// Package libsqllog logs the operations of libsql Databases with log/slog.
+//
+// It is a separate package so that only its users need Go 1.21 for log/slog.
+package libsqllog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"sync/atomic"
+	"time"
+
+	"oss.indeed.com/go/libsql"
+)
+
+// Attribute keys of the records logged by Config.Interceptor
+const (
+	LogKeyOperation     = "db.operation"
+	LogKeySQL           = "db.statement"
+	LogKeyArgCount      = "db.arg_count"
+	LogKeyArgTypes      = "db.arg_types"
+	LogKeyArgs          = "db.args"
+	LogKeyDuration      = "db.duration"
+	LogKeyRowsScanned   = "db.rows_scanned"
+	LogKeyPrepared      = "db.prepared"
+	LogKeyInTransaction = "db.in_transaction"
+	LogKeyErrorClass    = "error.class"
+	LogKeyErrorType     = "error.type"
+	LogKeyError         = "error"
+)
+
+// ArgRedactor reports whether the query argument at position must be redacted from logs.
+// Arguments wrapped with libsql.Sensitive are always redacted.
+type ArgRedactor func(position int, arg interface{}) bool
+
+// RedactAllArgs redacts all arguments
+func RedactAllArgs(int, interface{}) bool {
+	return true
+}
+
+// RedactArgPositions redacts the arguments at the given zero-based positions
+func RedactArgPositions(positions ...int) ArgRedactor {
+	redacted := make(map[int]bool, len(positions))
+	for _, p := range positions {
+		redacted[p] = true
+	}
+	return func(position int, _ interface{}) bool {
+		return redacted[position]
+	}
+}
+
+// RedactArgTypesOf redacts arguments of the same types as the given examples,
+// e.g. RedactArgTypesOf("", []byte(nil)) redacts strings and byte slices
+func RedactArgTypesOf(examples ...interface{}) ArgRedactor {
+	redacted := make(map[reflect.Type]bool, len(examples))
+	for _, e := range examples {
+		redacted[reflect.TypeOf(e)] = true
+	}
+	return func(_ int, arg interface{}) bool {
+		return redacted[reflect.TypeOf(arg)]
+	}
+}
+
+// Config configures logging of database operations with log/slog.
+//
+// By default no value that may be personal data is logged: the SQL is
+// normalized with libsql.NormalizeSQL, removing its literals, the arguments
+// are logged as their count and types, and errors as their class and type
+type Config struct {
+	// SuccessLevel is the level of successful operations. Defaults to slog.LevelDebug
+	SuccessLevel slog.Leveler
+
+	// SlowLevel is the level of successful operations taking at least SlowThreshold.
+	// Defaults to slog.LevelWarn
+	SlowLevel slog.Leveler
+
+	// ErrorLevel is the level of failed operations. Defaults to slog.LevelError.
+	// libsql.ErrNoRows is not considered a failure
+	ErrorLevel slog.Leveler
+
+	// SlowThreshold is the duration from which operations are slow. Zero disables slow operation logging
+	SlowThreshold time.Duration
+
+	// SampleEvery makes only every n-th successful operation that is not slow logged.
+	// Slow and failed operations are always logged. Zero or one logs all operations
+	SampleEvery uint64
+
+	// LogValues makes the SQL logged as performed, the arguments logged with
+	// their values except the redacted ones, and errors logged with their messages,
+	// which often contain values too.
+	// Only enable it where the logs may hold personal data
+	LogValues bool
+
+	// Redact decides which arguments are redacted from logs with LogValues.
+	// An argument is redacted if any ArgRedactor reports so
+	Redact []ArgRedactor
+}
+
+// Interceptor returns an Interceptor logging operations to logger
+func (c Config) Interceptor(logger *slog.Logger) libsql.Interceptor {
+	if c.SuccessLevel == nil {
+		c.SuccessLevel = slog.LevelDebug
+	}
+	if c.SlowLevel == nil {
+		c.SlowLevel = slog.LevelWarn
+	}
+	if c.ErrorLevel == nil {
+		c.ErrorLevel = slog.LevelError
+	}
+	l := &queryLogger{cfg: c, logger: logger}
+	return l.intercept
+}
+
+type queryLogger struct {
+	cfg    Config
+	logger *slog.Logger
+
+	successes uint64
+}
+
+func (l *queryLogger) intercept(ctx context.Context, op *libsql.Operation, next func(context.Context) error) error {
+	err := next(ctx)
+
+	level, msg, ok := l.outcome(op, err)
+	if ok && l.logger.Enabled(ctx, level) {
+		l.logger.LogAttrs(ctx, level, msg, l.attrs(op, err)...)
+	}
+
+	return err
+}
+
+// outcome returns the level and the message to log op with, if it is to be logged
+func (l *queryLogger) outcome(op *libsql.Operation, err error) (slog.Level, string, bool) {
+	if err != nil && !errors.Is(err, libsql.ErrNoRows) {
+		return l.cfg.ErrorLevel.Level(), "database operation failed", true
+	}
+	if l.cfg.SlowThreshold > 0 && op.Duration >= l.cfg.SlowThreshold {
+		return l.cfg.SlowLevel.Level(), "slow database operation", true
+	}
+	if l.cfg.SampleEvery > 1 && atomic.AddUint64(&l.successes, 1)%l.cfg.SampleEvery != 1 {
+		return 0, "", false
+	}
+	return l.cfg.SuccessLevel.Level(), "database operation", true
+}
+
+func (l *queryLogger) attrs(op *libsql.Operation, err error) []slog.Attr {
+	attrs := make([]slog.Attr, 0, 10)
+	attrs = append(attrs, slog.String(LogKeyOperation, op.Kind.String()))
+	if op.SQL != "" {
+		sql := op.SQL
+		if !l.cfg.LogValues {
+			sql = libsql.NormalizeSQL(sql)
+		}
+		attrs = append(attrs, slog.String(LogKeySQL, sql))
+	}
+	if len(op.Args) > 0 {
+		if l.cfg.LogValues {
+			attrs = append(attrs, slog.Any(LogKeyArgs, l.redact(op.Args)))
+		} else {
+			attrs = append(attrs, slog.Int(LogKeyArgCount, len(op.Args)), slog.Any(LogKeyArgTypes, argTypes(op.Args)))
+		}
+	}
+	attrs = append(attrs, slog.Duration(LogKeyDuration, op.Duration))
+	if op.Kind == libsql.OperationScan || op.Kind == libsql.OperationScanOne || op.RowsScanned > 0 {
+		attrs = append(attrs, slog.Int64(LogKeyRowsScanned, op.RowsScanned))
+	}
+	if op.Prepared {
+		attrs = append(attrs, slog.Bool(LogKeyPrepared, true))
+	}
+	if op.InTransaction {
+		attrs = append(attrs, slog.Bool(LogKeyInTransaction, true))
+	}
+	if err != nil {
+		attrs = append(attrs,
+			slog.String(LogKeyErrorClass, libsql.ClassifyError(err).Class.String()),
+			slog.String(LogKeyErrorType, causeType(err)))
+		if l.cfg.LogValues {
+			attrs = append(attrs, slog.String(LogKeyError, err.Error()))
+		}
+	}
+	return attrs
+}
+
+func (l *queryLogger) redact(args []interface{}) []interface{} {
+	redacted := make([]interface{}, len(args))
+	for i, arg := range args {
+		if l.isRedacted(i, arg) {
+			redacted[i] = libsql.RedactedArg
+		} else {
+			redacted[i] = arg
+		}
+	}
+	return redacted
+}
+
+func (l *queryLogger) isRedacted(position int, arg interface{}) bool {
+	if libsql.IsSensitive(arg) {
+		return true
+	}
+	for _, redact := range l.cfg.Redact {
+		if redact(position, arg) {
+			return true
+		}
+	}
+	return false
+}
+
+// argTypes returns the types of args, with the ones of Sensitive args redacted
+func argTypes(args []interface{}) []string {
+	types := make([]string, len(args))
+	for i, arg := range args {
+		if libsql.IsSensitive(arg) {
+			types[i] = libsql.RedactedArg
+		} else {
+			types[i] = fmt.Sprintf("%T", arg)
+		}
+	}
+	return types
+}
+
+// causeType returns the type of the innermost error wrapped by err
+func causeType(err error) string {
+	for {
+		cause := errors.Unwrap(err)
+		if cause == nil {
+			return fmt.Sprintf("%T", err)
+		}
+		err = cause
+	}
+}
@@ -0,0 +1,172 @@
+package libsqllog
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/stretchr/testify/require"
+
+	"oss.indeed.com/go/libsql"
+)
+
+type recordingHandler struct {
+	mu      sync.Mutex
+	records []slog.Record
+}
+
+func (h *recordingHandler) Enabled(context.Context, slog.Level) bool {
+	return true
+}
+
+func (h *recordingHandler) Handle(_ context.Context, r slog.Record) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.records = append(h.records, r)
+	return nil
+}
+
+func (h *recordingHandler) WithAttrs([]slog.Attr) slog.Handler {
+	return h
+}
+
+func (h *recordingHandler) WithGroup(string) slog.Handler {
+	return h
+}
+
+func (h *recordingHandler) attrs(i int) map[string]interface{} {
+	attrs := map[string]interface{}{}
+	h.records[i].Attrs(func(a slog.Attr) bool {
+		attrs[a.Key] = a.Value.Any()
+		return true
+	})
+	return attrs
+}
+
+func runLogged(cfg Config, op *libsql.Operation, err error) *recordingHandler {
+	handler := &recordingHandler{}
+	intercept := cfg.Interceptor(slog.New(handler))
+	_ = intercept(context.Background(), op, func(context.Context) error {
+		return err
+	})
+	return handler
+}
+
+func Test_Config_LogsSuccessWithoutValues(t *testing.T) {
+	handler := runLogged(Config{}, &libsql.Operation{
+		Kind:        libsql.OperationScan,
+		SQL:         "SELECT x FROM aTable WHERE y = ? AND z = 'literal'",
+		Args:        []interface{}{1, "bob@example.com", libsql.Sensitive("secret")},
+		Duration:    time.Millisecond,
+		RowsScanned: 3,
+	}, nil)
+
+	require.Len(t, handler.records, 1)
+	require.Equal(t, slog.LevelDebug, handler.records[0].Level)
+	require.Equal(t, map[string]interface{}{
+		LogKeyOperation:   "scan",
+		LogKeySQL:         "SELECT x FROM aTable WHERE y = ? AND z = ?",
+		LogKeyArgCount:    int64(3),
+		LogKeyArgTypes:    []string{"int", "string", libsql.RedactedArg},
+		LogKeyDuration:    time.Millisecond,
+		LogKeyRowsScanned: int64(3),
+	}, handler.attrs(0))
+}
+
+func Test_Config_LogsFailureWithoutValues(t *testing.T) {
+	handler := runLogged(Config{}, &libsql.Operation{
+		Kind:          libsql.OperationUpdate,
+		SQL:           "UPDATE aTable SET z = 'literal' WHERE y = ?",
+		Args:          []interface{}{1},
+		InTransaction: true,
+	}, &libsql.QueryError{Op: libsql.OperationUpdate, Err: errors.New(`duplicate key value (email)=(bob@example.com)`)})
+
+	require.Len(t, handler.records, 1)
+	require.Equal(t, slog.LevelError, handler.records[0].Level)
+	require.Equal(t, map[string]interface{}{
+		LogKeyOperation:     "update",
+		LogKeySQL:           "UPDATE aTable SET z = ? WHERE y = ?",
+		LogKeyArgCount:      int64(1),
+		LogKeyArgTypes:      []string{"int"},
+		LogKeyDuration:      time.Duration(0),
+		LogKeyInTransaction: true,
+		LogKeyErrorClass:    "unknown",
+		LogKeyErrorType:     "*errors.fundamental",
+	}, handler.attrs(0))
+}
+
+func Test_Config_LogValues(t *testing.T) {
+	handler := runLogged(Config{LogValues: true}, &libsql.Operation{
+		Kind: libsql.OperationUpdate,
+		SQL:  "UPDATE aTable SET z = 'literal' WHERE y = ?",
+		Args: []interface{}{1, libsql.Sensitive("secret")},
+	}, errors.New("a-test-error"))
+
+	require.Len(t, handler.records, 1)
+	require.Equal(t, map[string]interface{}{
+		LogKeyOperation:  "update",
+		LogKeySQL:        "UPDATE aTable SET z = 'literal' WHERE y = ?",
+		LogKeyArgs:       []interface{}{1, libsql.RedactedArg},
+		LogKeyDuration:   time.Duration(0),
+		LogKeyErrorClass: "unknown",
+		LogKeyErrorType:  "*errors.fundamental",
+		LogKeyError:      "a-test-error",
+	}, handler.attrs(0))
+}
+
+func Test_Config_NoRowsIsNotAFailure(t *testing.T) {
+	handler := runLogged(Config{}, &libsql.Operation{Kind: libsql.OperationScanOne, SQL: "SELECT 1"}, libsql.ErrNoRows)
+
+	require.Len(t, handler.records, 1)
+	require.Equal(t, slog.LevelDebug, handler.records[0].Level)
+}
+
+func Test_Config_LogsSlow(t *testing.T) {
+	cfg := Config{SlowThreshold: time.Second, SlowLevel: slog.LevelInfo}
+	handler := runLogged(cfg, &libsql.Operation{Kind: libsql.OperationScan, SQL: "SELECT 1", Duration: 2 * time.Second}, nil)
+
+	require.Len(t, handler.records, 1)
+	require.Equal(t, slog.LevelInfo, handler.records[0].Level)
+	require.Equal(t, "slow database operation", handler.records[0].Message)
+}
+
+func Test_Config_Sampling(t *testing.T) {
+	handler := &recordingHandler{}
+	intercept := Config{SampleEvery: 3}.Interceptor(slog.New(handler))
+
+	for i := 0; i < 7; i++ {
+		_ = intercept(context.Background(), &libsql.Operation{Kind: libsql.OperationScan}, func(context.Context) error {
+			return nil
+		})
+	}
+	_ = intercept(context.Background(), &libsql.Operation{Kind: libsql.OperationScan}, func(context.Context) error {
+		return errors.New("a-test-error")
+	})
+
+	require.Len(t, handler.records, 4)
+}
+
+func Test_Config_Redaction(t *testing.T) {
+	cfg := Config{
+		LogValues: true,
+		Redact: []ArgRedactor{
+			RedactArgPositions(0),
+			RedactArgTypesOf([]byte(nil)),
+		},
+	}
+	handler := runLogged(cfg, &libsql.Operation{
+		Kind: libsql.OperationScan,
+		Args: []interface{}{1, 2, []byte("bytes"), "string"},
+	}, nil)
+	require.Equal(t, []interface{}{libsql.RedactedArg, 2, libsql.RedactedArg, "string"}, handler.attrs(0)[LogKeyArgs])
+
+	handler = runLogged(Config{LogValues: true, Redact: []ArgRedactor{RedactAllArgs}}, &libsql.Operation{
+		Kind: libsql.OperationScan,
+		Args: []interface{}{1, "string"},
+	}, nil)
+	require.Equal(t, []interface{}{libsql.RedactedArg, libsql.RedactedArg}, handler.attrs(0)[LogKeyArgs])
+}
@@ -0,0 +1,2125 @@
+package libsqltest
+
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	mm_libsql "oss.indeed.com/go/libsql"
+)
+
+// ConnectionMock implements libsql.Connection
+type ConnectionMock struct {
+	t minimock.Tester
+
+	funcPrepared          func(ctx context.Context, sql string, work func(mm_libsql.Statement) error) (err error)
+	inspectFuncPrepared   func(ctx context.Context, sql string, work func(mm_libsql.Statement) error)
+	afterPreparedCounter  uint64
+	beforePreparedCounter uint64
+	PreparedMock          mConnectionMockPrepared
+
+	funcScan          func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (err error)
+	inspectFuncScan   func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{})
+	afterScanCounter  uint64
+	beforeScanCounter uint64
+	ScanMock          mConnectionMockScan
+
+	funcScanOne          func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (err error)
+	inspectFuncScanOne   func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{})
+	afterScanOneCounter  uint64
+	beforeScanOneCounter uint64
+	ScanOneMock          mConnectionMockScanOne
+
+	funcScanOptional          func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (b1 bool, err error)
+	inspectFuncScanOptional   func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{})
+	afterScanOptionalCounter  uint64
+	beforeScanOptionalCounter uint64
+	ScanOptionalMock          mConnectionMockScanOptional
+
+	funcTransaction          func(ctx context.Context, work func(mm_libsql.Transaction) error) (err error)
+	inspectFuncTransaction   func(ctx context.Context, work func(mm_libsql.Transaction) error)
+	afterTransactionCounter  uint64
+	beforeTransactionCounter uint64
+	TransactionMock          mConnectionMockTransaction
+
+	funcUpdate          func(ctx context.Context, sql string, args ...interface{}) (r1 sql.Result, err error)
+	inspectFuncUpdate   func(ctx context.Context, sql string, args ...interface{})
+	afterUpdateCounter  uint64
+	beforeUpdateCounter uint64
+	UpdateMock          mConnectionMockUpdate
+
+	funcUpdateAndGetLastInsertID          func(ctx context.Context, sql string, args ...interface{}) (i1 int64, err error)
+	inspectFuncUpdateAndGetLastInsertID   func(ctx context.Context, sql string, args ...interface{})
+	afterUpdateAndGetLastInsertIDCounter  uint64
+	beforeUpdateAndGetLastInsertIDCounter uint64
+	UpdateAndGetLastInsertIDMock          mConnectionMockUpdateAndGetLastInsertID
+
+	funcUpdateAndGetRowsAffected          func(ctx context.Context, sql string, args ...interface{}) (i1 int64, err error)
+	inspectFuncUpdateAndGetRowsAffected   func(ctx context.Context, sql string, args ...interface{})
+	afterUpdateAndGetRowsAffectedCounter  uint64
+	beforeUpdateAndGetRowsAffectedCounter uint64
+	UpdateAndGetRowsAffectedMock          mConnectionMockUpdateAndGetRowsAffected
+
+	funcUpdateReturning          func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (i1 int64, err error)
+	inspectFuncUpdateReturning   func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{})
+	afterUpdateReturningCounter  uint64
+	beforeUpdateReturningCounter uint64
+	UpdateReturningMock          mConnectionMockUpdateReturning
+}
+
+// NewConnectionMock returns a mock for libsql.Connection
+func NewConnectionMock(t minimock.Tester) *ConnectionMock {
+	m := &ConnectionMock{t: t}
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.PreparedMock = mConnectionMockPrepared{mock: m}
+	m.PreparedMock.callArgs = []*ConnectionMockPreparedParams{}
+
+	m.ScanMock = mConnectionMockScan{mock: m}
+	m.ScanMock.callArgs = []*ConnectionMockScanParams{}
+
+	m.ScanOneMock = mConnectionMockScanOne{mock: m}
+	m.ScanOneMock.callArgs = []*ConnectionMockScanOneParams{}
+
+	m.ScanOptionalMock = mConnectionMockScanOptional{mock: m}
+	m.ScanOptionalMock.callArgs = []*ConnectionMockScanOptionalParams{}
+
+	m.TransactionMock = mConnectionMockTransaction{mock: m}
+	m.TransactionMock.callArgs = []*ConnectionMockTransactionParams{}
+
+	m.UpdateMock = mConnectionMockUpdate{mock: m}
+	m.UpdateMock.callArgs = []*ConnectionMockUpdateParams{}
+
+	m.UpdateAndGetLastInsertIDMock = mConnectionMockUpdateAndGetLastInsertID{mock: m}
+	m.UpdateAndGetLastInsertIDMock.callArgs = []*ConnectionMockUpdateAndGetLastInsertIDParams{}
+
+	m.UpdateAndGetRowsAffectedMock = mConnectionMockUpdateAndGetRowsAffected{mock: m}
+	m.UpdateAndGetRowsAffectedMock.callArgs = []*ConnectionMockUpdateAndGetRowsAffectedParams{}
+
+	m.UpdateReturningMock = mConnectionMockUpdateReturning{mock: m}
+	m.UpdateReturningMock.callArgs = []*ConnectionMockUpdateReturningParams{}
+
+	return m
+}
+
+type mConnectionMockPrepared struct {
+	mock               *ConnectionMock
+	defaultExpectation *ConnectionMockPreparedExpectation
+	expectations       []*ConnectionMockPreparedExpectation
+
+	callArgs []*ConnectionMockPreparedParams
+	mutex    sync.RWMutex
+}
+
+// ConnectionMockPreparedExpectation specifies expectation struct of the Connection.Prepared
+type ConnectionMockPreparedExpectation struct {
+	mock    *ConnectionMock
+	params  *ConnectionMockPreparedParams
+	results *ConnectionMockPreparedResults
+	Counter uint64
+}
+
+// ConnectionMockPreparedParams contains parameters of the Connection.Prepared
+type ConnectionMockPreparedParams struct {
+	ctx  context.Context
+	sql  string
+	work func(mm_libsql.Statement) error
+}
+
+// ConnectionMockPreparedResults contains results of the Connection.Prepared
+type ConnectionMockPreparedResults struct {
+	err error
+}
+
+// Expect sets up expected params for Connection.Prepared
+func (mmPrepared *mConnectionMockPrepared) Expect(ctx context.Context, sql string, work func(mm_libsql.Statement) error) *mConnectionMockPrepared {
+	if mmPrepared.mock.funcPrepared != nil {
+		mmPrepared.mock.t.Fatalf("ConnectionMock.Prepared mock is already set by Set")
+	}
+
+	if mmPrepared.defaultExpectation == nil {
+		mmPrepared.defaultExpectation = &ConnectionMockPreparedExpectation{}
+	}
+
+	mmPrepared.defaultExpectation.params = &ConnectionMockPreparedParams{ctx, sql, work}
+	for _, e := range mmPrepared.expectations {
+		if minimock.Equal(e.params, mmPrepared.defaultExpectation.params) {
+			mmPrepared.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPrepared.defaultExpectation.params)
+		}
+	}
+
+	return mmPrepared
+}
+
+// Inspect accepts an inspector function that has same arguments as the Connection.Prepared
+func (mmPrepared *mConnectionMockPrepared) Inspect(f func(ctx context.Context, sql string, work func(mm_libsql.Statement) error)) *mConnectionMockPrepared {
+	if mmPrepared.mock.inspectFuncPrepared != nil {
+		mmPrepared.mock.t.Fatalf("Inspect function is already set for ConnectionMock.Prepared")
+	}
+
+	mmPrepared.mock.inspectFuncPrepared = f
+
+	return mmPrepared
+}
+
+// Return sets up results that will be returned by Connection.Prepared
+func (mmPrepared *mConnectionMockPrepared) Return(err error) *ConnectionMock {
+	if mmPrepared.mock.funcPrepared != nil {
+		mmPrepared.mock.t.Fatalf("ConnectionMock.Prepared mock is already set by Set")
+	}
+
+	if mmPrepared.defaultExpectation == nil {
+		mmPrepared.defaultExpectation = &ConnectionMockPreparedExpectation{mock: mmPrepared.mock}
+	}
+	mmPrepared.defaultExpectation.results = &ConnectionMockPreparedResults{err}
+	return mmPrepared.mock
+}
+
+//Set uses given function f to mock the Connection.Prepared method
+func (mmPrepared *mConnectionMockPrepared) Set(f func(ctx context.Context, sql string, work func(mm_libsql.Statement) error) (err error)) *ConnectionMock {
+	if mmPrepared.defaultExpectation != nil {
+		mmPrepared.mock.t.Fatalf("Default expectation is already set for the Connection.Prepared method")
+	}
+
+	if len(mmPrepared.expectations) > 0 {
+		mmPrepared.mock.t.Fatalf("Some expectations are already set for the Connection.Prepared method")
+	}
+
+	mmPrepared.mock.funcPrepared = f
+	return mmPrepared.mock
+}
+
+// When sets expectation for the Connection.Prepared which will trigger the result defined by the following
+// Then helper
+func (mmPrepared *mConnectionMockPrepared) When(ctx context.Context, sql string, work func(mm_libsql.Statement) error) *ConnectionMockPreparedExpectation {
+	if mmPrepared.mock.funcPrepared != nil {
+		mmPrepared.mock.t.Fatalf("ConnectionMock.Prepared mock is already set by Set")
+	}
+
+	expectation := &ConnectionMockPreparedExpectation{
+		mock:   mmPrepared.mock,
+		params: &ConnectionMockPreparedParams{ctx, sql, work},
+	}
+	mmPrepared.expectations = append(mmPrepared.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Connection.Prepared return parameters for the expectation previously defined by the When method
+func (e *ConnectionMockPreparedExpectation) Then(err error) *ConnectionMock {
+	e.results = &ConnectionMockPreparedResults{err}
+	return e.mock
+}
+
+// Prepared implements libsql.Connection
+func (mmPrepared *ConnectionMock) Prepared(ctx context.Context, sql string, work func(mm_libsql.Statement) error) (err error) {
+	mm_atomic.AddUint64(&mmPrepared.beforePreparedCounter, 1)
+	defer mm_atomic.AddUint64(&mmPrepared.afterPreparedCounter, 1)
+
+	if mmPrepared.inspectFuncPrepared != nil {
+		mmPrepared.inspectFuncPrepared(ctx, sql, work)
+	}
+
+	mm_params := &ConnectionMockPreparedParams{ctx, sql, work}
+
+	// Record call args
+	mmPrepared.PreparedMock.mutex.Lock()
+	mmPrepared.PreparedMock.callArgs = append(mmPrepared.PreparedMock.callArgs, mm_params)
+	mmPrepared.PreparedMock.mutex.Unlock()
+
+	for _, e := range mmPrepared.PreparedMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPrepared.PreparedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPrepared.PreparedMock.defaultExpectation.Counter, 1)
+		mm_want := mmPrepared.PreparedMock.defaultExpectation.params
+		mm_got := ConnectionMockPreparedParams{ctx, sql, work}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPrepared.t.Errorf("ConnectionMock.Prepared got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPrepared.PreparedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPrepared.t.Fatal("No results are set for the ConnectionMock.Prepared")
+		}
+		return (*mm_results).err
+	}
+	if mmPrepared.funcPrepared != nil {
+		return mmPrepared.funcPrepared(ctx, sql, work)
+	}
+	mmPrepared.t.Fatalf("Unexpected call to ConnectionMock.Prepared. %v %v %v", ctx, sql, work)
+	return
+}
+
+// PreparedAfterCounter returns a count of finished ConnectionMock.Prepared invocations
+func (mmPrepared *ConnectionMock) PreparedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPrepared.afterPreparedCounter)
+}
+
+// PreparedBeforeCounter returns a count of ConnectionMock.Prepared invocations
+func (mmPrepared *ConnectionMock) PreparedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPrepared.beforePreparedCounter)
+}
+
+// Calls returns a list of arguments used in each call to ConnectionMock.Prepared.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPrepared *mConnectionMockPrepared) Calls() []*ConnectionMockPreparedParams {
+	mmPrepared.mutex.RLock()
+
+	argCopy := make([]*ConnectionMockPreparedParams, len(mmPrepared.callArgs))
+	copy(argCopy, mmPrepared.callArgs)
+
+	mmPrepared.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPreparedDone returns true if the count of the Prepared invocations corresponds
+// the number of defined expectations
+func (m *ConnectionMock) MinimockPreparedDone() bool {
+	for _, e := range m.PreparedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PreparedMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPreparedCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPrepared != nil && mm_atomic.LoadUint64(&m.afterPreparedCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockPreparedInspect logs each unmet expectation
+func (m *ConnectionMock) MinimockPreparedInspect() {
+	for _, e := range m.PreparedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ConnectionMock.Prepared with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PreparedMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPreparedCounter) < 1 {
+		if m.PreparedMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ConnectionMock.Prepared")
+		} else {
+			m.t.Errorf("Expected call to ConnectionMock.Prepared with params: %#v", *m.PreparedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPrepared != nil && mm_atomic.LoadUint64(&m.afterPreparedCounter) < 1 {
+		m.t.Error("Expected call to ConnectionMock.Prepared")
+	}
+}
+
+type mConnectionMockScan struct {
+	mock               *ConnectionMock
+	defaultExpectation *ConnectionMockScanExpectation
+	expectations       []*ConnectionMockScanExpectation
+
+	callArgs []*ConnectionMockScanParams
+	mutex    sync.RWMutex
+}
+
+// ConnectionMockScanExpectation specifies expectation struct of the Connection.Scan
+type ConnectionMockScanExpectation struct {
+	mock    *ConnectionMock
+	params  *ConnectionMockScanParams
+	results *ConnectionMockScanResults
+	Counter uint64
+}
+
+// ConnectionMockScanParams contains parameters of the Connection.Scan
+type ConnectionMockScanParams struct {
+	ctx     context.Context
+	scanner mm_libsql.RowScanner
+	sql     string
+	args    []interface{}
+}
+
+// ConnectionMockScanResults contains results of the Connection.Scan
+type ConnectionMockScanResults struct {
+	err error
+}
+
+// Expect sets up expected params for Connection.Scan
+func (mmScan *mConnectionMockScan) Expect(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) *mConnectionMockScan {
+	if mmScan.mock.funcScan != nil {
+		mmScan.mock.t.Fatalf("ConnectionMock.Scan mock is already set by Set")
+	}
+
+	if mmScan.defaultExpectation == nil {
+		mmScan.defaultExpectation = &ConnectionMockScanExpectation{}
+	}
+
+	mmScan.defaultExpectation.params = &ConnectionMockScanParams{ctx, scanner, sql, args}
+	for _, e := range mmScan.expectations {
+		if minimock.Equal(e.params, mmScan.defaultExpectation.params) {
+			mmScan.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmScan.defaultExpectation.params)
+		}
+	}
+
+	return mmScan
+}
+
+// Inspect accepts an inspector function that has same arguments as the Connection.Scan
+func (mmScan *mConnectionMockScan) Inspect(f func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{})) *mConnectionMockScan {
+	if mmScan.mock.inspectFuncScan != nil {
+		mmScan.mock.t.Fatalf("Inspect function is already set for ConnectionMock.Scan")
+	}
+
+	mmScan.mock.inspectFuncScan = f
+
+	return mmScan
+}
+
+// Return sets up results that will be returned by Connection.Scan
+func (mmScan *mConnectionMockScan) Return(err error) *ConnectionMock {
+	if mmScan.mock.funcScan != nil {
+		mmScan.mock.t.Fatalf("ConnectionMock.Scan mock is already set by Set")
+	}
+
+	if mmScan.defaultExpectation == nil {
+		mmScan.defaultExpectation = &ConnectionMockScanExpectation{mock: mmScan.mock}
+	}
+	mmScan.defaultExpectation.results = &ConnectionMockScanResults{err}
+	return mmScan.mock
+}
+
+//Set uses given function f to mock the Connection.Scan method
+func (mmScan *mConnectionMockScan) Set(f func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (err error)) *ConnectionMock {
+	if mmScan.defaultExpectation != nil {
+		mmScan.mock.t.Fatalf("Default expectation is already set for the Connection.Scan method")
+	}
+
+	if len(mmScan.expectations) > 0 {
+		mmScan.mock.t.Fatalf("Some expectations are already set for the Connection.Scan method")
+	}
+
+	mmScan.mock.funcScan = f
+	return mmScan.mock
+}
+
+// When sets expectation for the Connection.Scan which will trigger the result defined by the following
+// Then helper
+func (mmScan *mConnectionMockScan) When(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) *ConnectionMockScanExpectation {
+	if mmScan.mock.funcScan != nil {
+		mmScan.mock.t.Fatalf("ConnectionMock.Scan mock is already set by Set")
+	}
+
+	expectation := &ConnectionMockScanExpectation{
+		mock:   mmScan.mock,
+		params: &ConnectionMockScanParams{ctx, scanner, sql, args},
+	}
+	mmScan.expectations = append(mmScan.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Connection.Scan return parameters for the expectation previously defined by the When method
+func (e *ConnectionMockScanExpectation) Then(err error) *ConnectionMock {
+	e.results = &ConnectionMockScanResults{err}
+	return e.mock
+}
+
+// Scan implements libsql.Connection
+func (mmScan *ConnectionMock) Scan(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (err error) {
+	mm_atomic.AddUint64(&mmScan.beforeScanCounter, 1)
+	defer mm_atomic.AddUint64(&mmScan.afterScanCounter, 1)
+
+	if mmScan.inspectFuncScan != nil {
+		mmScan.inspectFuncScan(ctx, scanner, sql, args...)
+	}
+
+	mm_params := &ConnectionMockScanParams{ctx, scanner, sql, args}
+
+	// Record call args
+	mmScan.ScanMock.mutex.Lock()
+	mmScan.ScanMock.callArgs = append(mmScan.ScanMock.callArgs, mm_params)
+	mmScan.ScanMock.mutex.Unlock()
+
+	for _, e := range mmScan.ScanMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmScan.ScanMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmScan.ScanMock.defaultExpectation.Counter, 1)
+		mm_want := mmScan.ScanMock.defaultExpectation.params
+		mm_got := ConnectionMockScanParams{ctx, scanner, sql, args}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmScan.t.Errorf("ConnectionMock.Scan got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmScan.ScanMock.defaultExpectation.results
+		if mm_results == nil {
+			mmScan.t.Fatal("No results are set for the ConnectionMock.Scan")
+		}
+		return (*mm_results).err
+	}
+	if mmScan.funcScan != nil {
+		return mmScan.funcScan(ctx, scanner, sql, args...)
+	}
+	mmScan.t.Fatalf("Unexpected call to ConnectionMock.Scan. %v %v %v %v", ctx, scanner, sql, args)
+	return
+}
+
+// ScanAfterCounter returns a count of finished ConnectionMock.Scan invocations
+func (mmScan *ConnectionMock) ScanAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScan.afterScanCounter)
+}
+
+// ScanBeforeCounter returns a count of ConnectionMock.Scan invocations
+func (mmScan *ConnectionMock) ScanBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScan.beforeScanCounter)
+}
+
+// Calls returns a list of arguments used in each call to ConnectionMock.Scan.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmScan *mConnectionMockScan) Calls() []*ConnectionMockScanParams {
+	mmScan.mutex.RLock()
+
+	argCopy := make([]*ConnectionMockScanParams, len(mmScan.callArgs))
+	copy(argCopy, mmScan.callArgs)
+
+	mmScan.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockScanDone returns true if the count of the Scan invocations corresponds
+// the number of defined expectations
+func (m *ConnectionMock) MinimockScanDone() bool {
+	for _, e := range m.ScanMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ScanMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterScanCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcScan != nil && mm_atomic.LoadUint64(&m.afterScanCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockScanInspect logs each unmet expectation
+func (m *ConnectionMock) MinimockScanInspect() {
+	for _, e := range m.ScanMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ConnectionMock.Scan with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ScanMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterScanCounter) < 1 {
+		if m.ScanMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ConnectionMock.Scan")
+		} else {
+			m.t.Errorf("Expected call to ConnectionMock.Scan with params: %#v", *m.ScanMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcScan != nil && mm_atomic.LoadUint64(&m.afterScanCounter) < 1 {
+		m.t.Error("Expected call to ConnectionMock.Scan")
+	}
+}
+
+type mConnectionMockScanOne struct {
+	mock               *ConnectionMock
+	defaultExpectation *ConnectionMockScanOneExpectation
+	expectations       []*ConnectionMockScanOneExpectation
+
+	callArgs []*ConnectionMockScanOneParams
+	mutex    sync.RWMutex
+}
+
+// ConnectionMockScanOneExpectation specifies expectation struct of the Connection.ScanOne
+type ConnectionMockScanOneExpectation struct {
+	mock    *ConnectionMock
+	params  *ConnectionMockScanOneParams
+	results *ConnectionMockScanOneResults
+	Counter uint64
+}
+
+// ConnectionMockScanOneParams contains parameters of the Connection.ScanOne
+type ConnectionMockScanOneParams struct {
+	ctx     context.Context
+	scanner mm_libsql.RowScanner
+	sql     string
+	args    []interface{}
+}
+
+// ConnectionMockScanOneResults contains results of the Connection.ScanOne
+type ConnectionMockScanOneResults struct {
+	err error
+}
+
+// Expect sets up expected params for Connection.ScanOne
+func (mmScanOne *mConnectionMockScanOne) Expect(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) *mConnectionMockScanOne {
+	if mmScanOne.mock.funcScanOne != nil {
+		mmScanOne.mock.t.Fatalf("ConnectionMock.ScanOne mock is already set by Set")
+	}
+
+	if mmScanOne.defaultExpectation == nil {
+		mmScanOne.defaultExpectation = &ConnectionMockScanOneExpectation{}
+	}
+
+	mmScanOne.defaultExpectation.params = &ConnectionMockScanOneParams{ctx, scanner, sql, args}
+	for _, e := range mmScanOne.expectations {
+		if minimock.Equal(e.params, mmScanOne.defaultExpectation.params) {
+			mmScanOne.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmScanOne.defaultExpectation.params)
+		}
+	}
+
+	return mmScanOne
+}
+
+// Inspect accepts an inspector function that has same arguments as the Connection.ScanOne
+func (mmScanOne *mConnectionMockScanOne) Inspect(f func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{})) *mConnectionMockScanOne {
+	if mmScanOne.mock.inspectFuncScanOne != nil {
+		mmScanOne.mock.t.Fatalf("Inspect function is already set for ConnectionMock.ScanOne")
+	}
+
+	mmScanOne.mock.inspectFuncScanOne = f
+
+	return mmScanOne
+}
+
+// Return sets up results that will be returned by Connection.ScanOne
+func (mmScanOne *mConnectionMockScanOne) Return(err error) *ConnectionMock {
+	if mmScanOne.mock.funcScanOne != nil {
+		mmScanOne.mock.t.Fatalf("ConnectionMock.ScanOne mock is already set by Set")
+	}
+
+	if mmScanOne.defaultExpectation == nil {
+		mmScanOne.defaultExpectation = &ConnectionMockScanOneExpectation{mock: mmScanOne.mock}
+	}
+	mmScanOne.defaultExpectation.results = &ConnectionMockScanOneResults{err}
+	return mmScanOne.mock
+}
+
+//Set uses given function f to mock the Connection.ScanOne method
+func (mmScanOne *mConnectionMockScanOne) Set(f func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (err error)) *ConnectionMock {
+	if mmScanOne.defaultExpectation != nil {
+		mmScanOne.mock.t.Fatalf("Default expectation is already set for the Connection.ScanOne method")
+	}
+
+	if len(mmScanOne.expectations) > 0 {
+		mmScanOne.mock.t.Fatalf("Some expectations are already set for the Connection.ScanOne method")
+	}
+
+	mmScanOne.mock.funcScanOne = f
+	return mmScanOne.mock
+}
+
+// When sets expectation for the Connection.ScanOne which will trigger the result defined by the following
+// Then helper
+func (mmScanOne *mConnectionMockScanOne) When(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) *ConnectionMockScanOneExpectation {
+	if mmScanOne.mock.funcScanOne != nil {
+		mmScanOne.mock.t.Fatalf("ConnectionMock.ScanOne mock is already set by Set")
+	}
+
+	expectation := &ConnectionMockScanOneExpectation{
+		mock:   mmScanOne.mock,
+		params: &ConnectionMockScanOneParams{ctx, scanner, sql, args},
+	}
+	mmScanOne.expectations = append(mmScanOne.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Connection.ScanOne return parameters for the expectation previously defined by the When method
+func (e *ConnectionMockScanOneExpectation) Then(err error) *ConnectionMock {
+	e.results = &ConnectionMockScanOneResults{err}
+	return e.mock
+}
+
+// ScanOne implements libsql.Connection
+func (mmScanOne *ConnectionMock) ScanOne(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (err error) {
+	mm_atomic.AddUint64(&mmScanOne.beforeScanOneCounter, 1)
+	defer mm_atomic.AddUint64(&mmScanOne.afterScanOneCounter, 1)
+
+	if mmScanOne.inspectFuncScanOne != nil {
+		mmScanOne.inspectFuncScanOne(ctx, scanner, sql, args...)
+	}
+
+	mm_params := &ConnectionMockScanOneParams{ctx, scanner, sql, args}
+
+	// Record call args
+	mmScanOne.ScanOneMock.mutex.Lock()
+	mmScanOne.ScanOneMock.callArgs = append(mmScanOne.ScanOneMock.callArgs, mm_params)
+	mmScanOne.ScanOneMock.mutex.Unlock()
+
+	for _, e := range mmScanOne.ScanOneMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmScanOne.ScanOneMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmScanOne.ScanOneMock.defaultExpectation.Counter, 1)
+		mm_want := mmScanOne.ScanOneMock.defaultExpectation.params
+		mm_got := ConnectionMockScanOneParams{ctx, scanner, sql, args}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmScanOne.t.Errorf("ConnectionMock.ScanOne got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmScanOne.ScanOneMock.defaultExpectation.results
+		if mm_results == nil {
+			mmScanOne.t.Fatal("No results are set for the ConnectionMock.ScanOne")
+		}
+		return (*mm_results).err
+	}
+	if mmScanOne.funcScanOne != nil {
+		return mmScanOne.funcScanOne(ctx, scanner, sql, args...)
+	}
+	mmScanOne.t.Fatalf("Unexpected call to ConnectionMock.ScanOne. %v %v %v %v", ctx, scanner, sql, args)
+	return
+}
+
+// ScanOneAfterCounter returns a count of finished ConnectionMock.ScanOne invocations
+func (mmScanOne *ConnectionMock) ScanOneAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScanOne.afterScanOneCounter)
+}
+
+// ScanOneBeforeCounter returns a count of ConnectionMock.ScanOne invocations
+func (mmScanOne *ConnectionMock) ScanOneBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScanOne.beforeScanOneCounter)
+}
+
+// Calls returns a list of arguments used in each call to ConnectionMock.ScanOne.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmScanOne *mConnectionMockScanOne) Calls() []*ConnectionMockScanOneParams {
+	mmScanOne.mutex.RLock()
+
+	argCopy := make([]*ConnectionMockScanOneParams, len(mmScanOne.callArgs))
+	copy(argCopy, mmScanOne.callArgs)
+
+	mmScanOne.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockScanOneDone returns true if the count of the ScanOne invocations corresponds
+// the number of defined expectations
+func (m *ConnectionMock) MinimockScanOneDone() bool {
+	for _, e := range m.ScanOneMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ScanOneMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterScanOneCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcScanOne != nil && mm_atomic.LoadUint64(&m.afterScanOneCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockScanOneInspect logs each unmet expectation
+func (m *ConnectionMock) MinimockScanOneInspect() {
+	for _, e := range m.ScanOneMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ConnectionMock.ScanOne with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ScanOneMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterScanOneCounter) < 1 {
+		if m.ScanOneMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ConnectionMock.ScanOne")
+		} else {
+			m.t.Errorf("Expected call to ConnectionMock.ScanOne with params: %#v", *m.ScanOneMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcScanOne != nil && mm_atomic.LoadUint64(&m.afterScanOneCounter) < 1 {
+		m.t.Error("Expected call to ConnectionMock.ScanOne")
+	}
+}
+
+type mConnectionMockScanOptional struct {
+	mock               *ConnectionMock
+	defaultExpectation *ConnectionMockScanOptionalExpectation
+	expectations       []*ConnectionMockScanOptionalExpectation
+
+	callArgs []*ConnectionMockScanOptionalParams
+	mutex    sync.RWMutex
+}
+
+// ConnectionMockScanOptionalExpectation specifies expectation struct of the Connection.ScanOptional
+type ConnectionMockScanOptionalExpectation struct {
+	mock    *ConnectionMock
+	params  *ConnectionMockScanOptionalParams
+	results *ConnectionMockScanOptionalResults
+	Counter uint64
+}
+
+// ConnectionMockScanOptionalParams contains parameters of the Connection.ScanOptional
+type ConnectionMockScanOptionalParams struct {
+	ctx     context.Context
+	scanner mm_libsql.RowScanner
+	sql     string
+	args    []interface{}
+}
+
+// ConnectionMockScanOptionalResults contains results of the Connection.ScanOptional
+type ConnectionMockScanOptionalResults struct {
+	b1  bool
+	err error
+}
+
+// Expect sets up expected params for Connection.ScanOptional
+func (mmScanOptional *mConnectionMockScanOptional) Expect(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) *mConnectionMockScanOptional {
+	if mmScanOptional.mock.funcScanOptional != nil {
+		mmScanOptional.mock.t.Fatalf("ConnectionMock.ScanOptional mock is already set by Set")
+	}
+
+	if mmScanOptional.defaultExpectation == nil {
+		mmScanOptional.defaultExpectation = &ConnectionMockScanOptionalExpectation{}
+	}
+
+	mmScanOptional.defaultExpectation.params = &ConnectionMockScanOptionalParams{ctx, scanner, sql, args}
+	for _, e := range mmScanOptional.expectations {
+		if minimock.Equal(e.params, mmScanOptional.defaultExpectation.params) {
+			mmScanOptional.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmScanOptional.defaultExpectation.params)
+		}
+	}
+
+	return mmScanOptional
+}
+
+// Inspect accepts an inspector function that has same arguments as the Connection.ScanOptional
+func (mmScanOptional *mConnectionMockScanOptional) Inspect(f func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{})) *mConnectionMockScanOptional {
+	if mmScanOptional.mock.inspectFuncScanOptional != nil {
+		mmScanOptional.mock.t.Fatalf("Inspect function is already set for ConnectionMock.ScanOptional")
+	}
+
+	mmScanOptional.mock.inspectFuncScanOptional = f
+
+	return mmScanOptional
+}
+
+// Return sets up results that will be returned by Connection.ScanOptional
+func (mmScanOptional *mConnectionMockScanOptional) Return(b1 bool, err error) *ConnectionMock {
+	if mmScanOptional.mock.funcScanOptional != nil {
+		mmScanOptional.mock.t.Fatalf("ConnectionMock.ScanOptional mock is already set by Set")
+	}
+
+	if mmScanOptional.defaultExpectation == nil {
+		mmScanOptional.defaultExpectation = &ConnectionMockScanOptionalExpectation{mock: mmScanOptional.mock}
+	}
+	mmScanOptional.defaultExpectation.results = &ConnectionMockScanOptionalResults{b1, err}
+	return mmScanOptional.mock
+}
+
+//Set uses given function f to mock the Connection.ScanOptional method
+func (mmScanOptional *mConnectionMockScanOptional) Set(f func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (b1 bool, err error)) *ConnectionMock {
+	if mmScanOptional.defaultExpectation != nil {
+		mmScanOptional.mock.t.Fatalf("Default expectation is already set for the Connection.ScanOptional method")
+	}
+
+	if len(mmScanOptional.expectations) > 0 {
+		mmScanOptional.mock.t.Fatalf("Some expectations are already set for the Connection.ScanOptional method")
+	}
+
+	mmScanOptional.mock.funcScanOptional = f
+	return mmScanOptional.mock
+}
+
+// When sets expectation for the Connection.ScanOptional which will trigger the result defined by the following
+// Then helper
+func (mmScanOptional *mConnectionMockScanOptional) When(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) *ConnectionMockScanOptionalExpectation {
+	if mmScanOptional.mock.funcScanOptional != nil {
+		mmScanOptional.mock.t.Fatalf("ConnectionMock.ScanOptional mock is already set by Set")
+	}
+
+	expectation := &ConnectionMockScanOptionalExpectation{
+		mock:   mmScanOptional.mock,
+		params: &ConnectionMockScanOptionalParams{ctx, scanner, sql, args},
+	}
+	mmScanOptional.expectations = append(mmScanOptional.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Connection.ScanOptional return parameters for the expectation previously defined by the When method
+func (e *ConnectionMockScanOptionalExpectation) Then(b1 bool, err error) *ConnectionMock {
+	e.results = &ConnectionMockScanOptionalResults{b1, err}
+	return e.mock
+}
+
+// ScanOptional implements libsql.Connection
+func (mmScanOptional *ConnectionMock) ScanOptional(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmScanOptional.beforeScanOptionalCounter, 1)
+	defer mm_atomic.AddUint64(&mmScanOptional.afterScanOptionalCounter, 1)
+
+	if mmScanOptional.inspectFuncScanOptional != nil {
+		mmScanOptional.inspectFuncScanOptional(ctx, scanner, sql, args...)
+	}
+
+	mm_params := &ConnectionMockScanOptionalParams{ctx, scanner, sql, args}
+
+	// Record call args
+	mmScanOptional.ScanOptionalMock.mutex.Lock()
+	mmScanOptional.ScanOptionalMock.callArgs = append(mmScanOptional.ScanOptionalMock.callArgs, mm_params)
+	mmScanOptional.ScanOptionalMock.mutex.Unlock()
+
+	for _, e := range mmScanOptional.ScanOptionalMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmScanOptional.ScanOptionalMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmScanOptional.ScanOptionalMock.defaultExpectation.Counter, 1)
+		mm_want := mmScanOptional.ScanOptionalMock.defaultExpectation.params
+		mm_got := ConnectionMockScanOptionalParams{ctx, scanner, sql, args}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmScanOptional.t.Errorf("ConnectionMock.ScanOptional got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmScanOptional.ScanOptionalMock.defaultExpectation.results
+		if mm_results == nil {
+			mmScanOptional.t.Fatal("No results are set for the ConnectionMock.ScanOptional")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmScanOptional.funcScanOptional != nil {
+		return mmScanOptional.funcScanOptional(ctx, scanner, sql, args...)
+	}
+	mmScanOptional.t.Fatalf("Unexpected call to ConnectionMock.ScanOptional. %v %v %v %v", ctx, scanner, sql, args)
+	return
+}
+
+// ScanOptionalAfterCounter returns a count of finished ConnectionMock.ScanOptional invocations
+func (mmScanOptional *ConnectionMock) ScanOptionalAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScanOptional.afterScanOptionalCounter)
+}
+
+// ScanOptionalBeforeCounter returns a count of ConnectionMock.ScanOptional invocations
+func (mmScanOptional *ConnectionMock) ScanOptionalBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScanOptional.beforeScanOptionalCounter)
+}
+
+// Calls returns a list of arguments used in each call to ConnectionMock.ScanOptional.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmScanOptional *mConnectionMockScanOptional) Calls() []*ConnectionMockScanOptionalParams {
+	mmScanOptional.mutex.RLock()
+
+	argCopy := make([]*ConnectionMockScanOptionalParams, len(mmScanOptional.callArgs))
+	copy(argCopy, mmScanOptional.callArgs)
+
+	mmScanOptional.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockScanOptionalDone returns true if the count of the ScanOptional invocations corresponds
+// the number of defined expectations
+func (m *ConnectionMock) MinimockScanOptionalDone() bool {
+	for _, e := range m.ScanOptionalMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ScanOptionalMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterScanOptionalCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcScanOptional != nil && mm_atomic.LoadUint64(&m.afterScanOptionalCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockScanOptionalInspect logs each unmet expectation
+func (m *ConnectionMock) MinimockScanOptionalInspect() {
+	for _, e := range m.ScanOptionalMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ConnectionMock.ScanOptional with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ScanOptionalMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterScanOptionalCounter) < 1 {
+		if m.ScanOptionalMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ConnectionMock.ScanOptional")
+		} else {
+			m.t.Errorf("Expected call to ConnectionMock.ScanOptional with params: %#v", *m.ScanOptionalMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcScanOptional != nil && mm_atomic.LoadUint64(&m.afterScanOptionalCounter) < 1 {
+		m.t.Error("Expected call to ConnectionMock.ScanOptional")
+	}
+}
+
+type mConnectionMockTransaction struct {
+	mock               *ConnectionMock
+	defaultExpectation *ConnectionMockTransactionExpectation
+	expectations       []*ConnectionMockTransactionExpectation
+
+	callArgs []*ConnectionMockTransactionParams
+	mutex    sync.RWMutex
+}
+
+// ConnectionMockTransactionExpectation specifies expectation struct of the Connection.Transaction
+type ConnectionMockTransactionExpectation struct {
+	mock    *ConnectionMock
+	params  *ConnectionMockTransactionParams
+	results *ConnectionMockTransactionResults
+	Counter uint64
+}
+
+// ConnectionMockTransactionParams contains parameters of the Connection.Transaction
+type ConnectionMockTransactionParams struct {
+	ctx  context.Context
+	work func(mm_libsql.Transaction) error
+}
+
+// ConnectionMockTransactionResults contains results of the Connection.Transaction
+type ConnectionMockTransactionResults struct {
+	err error
+}
+
+// Expect sets up expected params for Connection.Transaction
+func (mmTransaction *mConnectionMockTransaction) Expect(ctx context.Context, work func(mm_libsql.Transaction) error) *mConnectionMockTransaction {
+	if mmTransaction.mock.funcTransaction != nil {
+		mmTransaction.mock.t.Fatalf("ConnectionMock.Transaction mock is already set by Set")
+	}
+
+	if mmTransaction.defaultExpectation == nil {
+		mmTransaction.defaultExpectation = &ConnectionMockTransactionExpectation{}
+	}
+
+	mmTransaction.defaultExpectation.params = &ConnectionMockTransactionParams{ctx, work}
+	for _, e := range mmTransaction.expectations {
+		if minimock.Equal(e.params, mmTransaction.defaultExpectation.params) {
+			mmTransaction.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTransaction.defaultExpectation.params)
+		}
+	}
+
+	return mmTransaction
+}
+
+// Inspect accepts an inspector function that has same arguments as the Connection.Transaction
+func (mmTransaction *mConnectionMockTransaction) Inspect(f func(ctx context.Context, work func(mm_libsql.Transaction) error)) *mConnectionMockTransaction {
+	if mmTransaction.mock.inspectFuncTransaction != nil {
+		mmTransaction.mock.t.Fatalf("Inspect function is already set for ConnectionMock.Transaction")
+	}
+
+	mmTransaction.mock.inspectFuncTransaction = f
+
+	return mmTransaction
+}
+
+// Return sets up results that will be returned by Connection.Transaction
+func (mmTransaction *mConnectionMockTransaction) Return(err error) *ConnectionMock {
+	if mmTransaction.mock.funcTransaction != nil {
+		mmTransaction.mock.t.Fatalf("ConnectionMock.Transaction mock is already set by Set")
+	}
+
+	if mmTransaction.defaultExpectation == nil {
+		mmTransaction.defaultExpectation = &ConnectionMockTransactionExpectation{mock: mmTransaction.mock}
+	}
+	mmTransaction.defaultExpectation.results = &ConnectionMockTransactionResults{err}
+	return mmTransaction.mock
+}
+
+//Set uses given function f to mock the Connection.Transaction method
+func (mmTransaction *mConnectionMockTransaction) Set(f func(ctx context.Context, work func(mm_libsql.Transaction) error) (err error)) *ConnectionMock {
+	if mmTransaction.defaultExpectation != nil {
+		mmTransaction.mock.t.Fatalf("Default expectation is already set for the Connection.Transaction method")
+	}
+
+	if len(mmTransaction.expectations) > 0 {
+		mmTransaction.mock.t.Fatalf("Some expectations are already set for the Connection.Transaction method")
+	}
+
+	mmTransaction.mock.funcTransaction = f
+	return mmTransaction.mock
+}
+
+// When sets expectation for the Connection.Transaction which will trigger the result defined by the following
+// Then helper
+func (mmTransaction *mConnectionMockTransaction) When(ctx context.Context, work func(mm_libsql.Transaction) error) *ConnectionMockTransactionExpectation {
+	if mmTransaction.mock.funcTransaction != nil {
+		mmTransaction.mock.t.Fatalf("ConnectionMock.Transaction mock is already set by Set")
+	}
+
+	expectation := &ConnectionMockTransactionExpectation{
+		mock:   mmTransaction.mock,
+		params: &ConnectionMockTransactionParams{ctx, work},
+	}
+	mmTransaction.expectations = append(mmTransaction.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Connection.Transaction return parameters for the expectation previously defined by the When method
+func (e *ConnectionMockTransactionExpectation) Then(err error) *ConnectionMock {
+	e.results = &ConnectionMockTransactionResults{err}
+	return e.mock
+}
+
+// Transaction implements libsql.Connection
+func (mmTransaction *ConnectionMock) Transaction(ctx context.Context, work func(mm_libsql.Transaction) error) (err error) {
+	mm_atomic.AddUint64(&mmTransaction.beforeTransactionCounter, 1)
+	defer mm_atomic.AddUint64(&mmTransaction.afterTransactionCounter, 1)
+
+	if mmTransaction.inspectFuncTransaction != nil {
+		mmTransaction.inspectFuncTransaction(ctx, work)
+	}
+
+	mm_params := &ConnectionMockTransactionParams{ctx, work}
+
+	// Record call args
+	mmTransaction.TransactionMock.mutex.Lock()
+	mmTransaction.TransactionMock.callArgs = append(mmTransaction.TransactionMock.callArgs, mm_params)
+	mmTransaction.TransactionMock.mutex.Unlock()
+
+	for _, e := range mmTransaction.TransactionMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmTransaction.TransactionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTransaction.TransactionMock.defaultExpectation.Counter, 1)
+		mm_want := mmTransaction.TransactionMock.defaultExpectation.params
+		mm_got := ConnectionMockTransactionParams{ctx, work}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmTransaction.t.Errorf("ConnectionMock.Transaction got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmTransaction.TransactionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmTransaction.t.Fatal("No results are set for the ConnectionMock.Transaction")
+		}
+		return (*mm_results).err
+	}
+	if mmTransaction.funcTransaction != nil {
+		return mmTransaction.funcTransaction(ctx, work)
+	}
+	mmTransaction.t.Fatalf("Unexpected call to ConnectionMock.Transaction. %v %v", ctx, work)
+	return
+}
+
+// TransactionAfterCounter returns a count of finished ConnectionMock.Transaction invocations
+func (mmTransaction *ConnectionMock) TransactionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTransaction.afterTransactionCounter)
+}
+
+// TransactionBeforeCounter returns a count of ConnectionMock.Transaction invocations
+func (mmTransaction *ConnectionMock) TransactionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTransaction.beforeTransactionCounter)
+}
+
+// Calls returns a list of arguments used in each call to ConnectionMock.Transaction.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmTransaction *mConnectionMockTransaction) Calls() []*ConnectionMockTransactionParams {
+	mmTransaction.mutex.RLock()
+
+	argCopy := make([]*ConnectionMockTransactionParams, len(mmTransaction.callArgs))
+	copy(argCopy, mmTransaction.callArgs)
+
+	mmTransaction.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockTransactionDone returns true if the count of the Transaction invocations corresponds
+// the number of defined expectations
+func (m *ConnectionMock) MinimockTransactionDone() bool {
+	for _, e := range m.TransactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.TransactionMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterTransactionCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcTransaction != nil && mm_atomic.LoadUint64(&m.afterTransactionCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockTransactionInspect logs each unmet expectation
+func (m *ConnectionMock) MinimockTransactionInspect() {
+	for _, e := range m.TransactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ConnectionMock.Transaction with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.TransactionMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterTransactionCounter) < 1 {
+		if m.TransactionMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ConnectionMock.Transaction")
+		} else {
+			m.t.Errorf("Expected call to ConnectionMock.Transaction with params: %#v", *m.TransactionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcTransaction != nil && mm_atomic.LoadUint64(&m.afterTransactionCounter) < 1 {
+		m.t.Error("Expected call to ConnectionMock.Transaction")
+	}
+}
+
+type mConnectionMockUpdate struct {
+	mock               *ConnectionMock
+	defaultExpectation *ConnectionMockUpdateExpectation
+	expectations       []*ConnectionMockUpdateExpectation
+
+	callArgs []*ConnectionMockUpdateParams
+	mutex    sync.RWMutex
+}
+
+// ConnectionMockUpdateExpectation specifies expectation struct of the Connection.Update
+type ConnectionMockUpdateExpectation struct {
+	mock    *ConnectionMock
+	params  *ConnectionMockUpdateParams
+	results *ConnectionMockUpdateResults
+	Counter uint64
+}
+
+// ConnectionMockUpdateParams contains parameters of the Connection.Update
+type ConnectionMockUpdateParams struct {
+	ctx  context.Context
+	sql  string
+	args []interface{}
+}
+
+// ConnectionMockUpdateResults contains results of the Connection.Update
+type ConnectionMockUpdateResults struct {
+	r1  sql.Result
+	err error
+}
+
+// Expect sets up expected params for Connection.Update
+func (mmUpdate *mConnectionMockUpdate) Expect(ctx context.Context, sql string, args ...interface{}) *mConnectionMockUpdate {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("ConnectionMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &ConnectionMockUpdateExpectation{}
+	}
+
+	mmUpdate.defaultExpectation.params = &ConnectionMockUpdateParams{ctx, sql, args}
+	for _, e := range mmUpdate.expectations {
+		if minimock.Equal(e.params, mmUpdate.defaultExpectation.params) {
+			mmUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdate.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdate
+}
+
+// Inspect accepts an inspector function that has same arguments as the Connection.Update
+func (mmUpdate *mConnectionMockUpdate) Inspect(f func(ctx context.Context, sql string, args ...interface{})) *mConnectionMockUpdate {
+	if mmUpdate.mock.inspectFuncUpdate != nil {
+		mmUpdate.mock.t.Fatalf("Inspect function is already set for ConnectionMock.Update")
+	}
+
+	mmUpdate.mock.inspectFuncUpdate = f
+
+	return mmUpdate
+}
+
+// Return sets up results that will be returned by Connection.Update
+func (mmUpdate *mConnectionMockUpdate) Return(r1 sql.Result, err error) *ConnectionMock {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("ConnectionMock.Update mock is already set by Set")
+	}
+
+	if mmUpdate.defaultExpectation == nil {
+		mmUpdate.defaultExpectation = &ConnectionMockUpdateExpectation{mock: mmUpdate.mock}
+	}
+	mmUpdate.defaultExpectation.results = &ConnectionMockUpdateResults{r1, err}
+	return mmUpdate.mock
+}
+
+//Set uses given function f to mock the Connection.Update method
+func (mmUpdate *mConnectionMockUpdate) Set(f func(ctx context.Context, sql string, args ...interface{}) (r1 sql.Result, err error)) *ConnectionMock {
+	if mmUpdate.defaultExpectation != nil {
+		mmUpdate.mock.t.Fatalf("Default expectation is already set for the Connection.Update method")
+	}
+
+	if len(mmUpdate.expectations) > 0 {
+		mmUpdate.mock.t.Fatalf("Some expectations are already set for the Connection.Update method")
+	}
+
+	mmUpdate.mock.funcUpdate = f
+	return mmUpdate.mock
+}
+
+// When sets expectation for the Connection.Update which will trigger the result defined by the following
+// Then helper
+func (mmUpdate *mConnectionMockUpdate) When(ctx context.Context, sql string, args ...interface{}) *ConnectionMockUpdateExpectation {
+	if mmUpdate.mock.funcUpdate != nil {
+		mmUpdate.mock.t.Fatalf("ConnectionMock.Update mock is already set by Set")
+	}
+
+	expectation := &ConnectionMockUpdateExpectation{
+		mock:   mmUpdate.mock,
+		params: &ConnectionMockUpdateParams{ctx, sql, args},
+	}
+	mmUpdate.expectations = append(mmUpdate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Connection.Update return parameters for the expectation previously defined by the When method
+func (e *ConnectionMockUpdateExpectation) Then(r1 sql.Result, err error) *ConnectionMock {
+	e.results = &ConnectionMockUpdateResults{r1, err}
+	return e.mock
+}
+
+// Update implements libsql.Connection
+func (mmUpdate *ConnectionMock) Update(ctx context.Context, sql string, args ...interface{}) (r1 sql.Result, err error) {
+	mm_atomic.AddUint64(&mmUpdate.beforeUpdateCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdate.afterUpdateCounter, 1)
+
+	if mmUpdate.inspectFuncUpdate != nil {
+		mmUpdate.inspectFuncUpdate(ctx, sql, args...)
+	}
+
+	mm_params := &ConnectionMockUpdateParams{ctx, sql, args}
+
+	// Record call args
+	mmUpdate.UpdateMock.mutex.Lock()
+	mmUpdate.UpdateMock.callArgs = append(mmUpdate.UpdateMock.callArgs, mm_params)
+	mmUpdate.UpdateMock.mutex.Unlock()
+
+	for _, e := range mmUpdate.UpdateMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.r1, e.results.err
+		}
+	}
+
+	if mmUpdate.UpdateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdate.UpdateMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdate.UpdateMock.defaultExpectation.params
+		mm_got := ConnectionMockUpdateParams{ctx, sql, args}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdate.t.Errorf("ConnectionMock.Update got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdate.UpdateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdate.t.Fatal("No results are set for the ConnectionMock.Update")
+		}
+		return (*mm_results).r1, (*mm_results).err
+	}
+	if mmUpdate.funcUpdate != nil {
+		return mmUpdate.funcUpdate(ctx, sql, args...)
+	}
+	mmUpdate.t.Fatalf("Unexpected call to ConnectionMock.Update. %v %v %v", ctx, sql, args)
+	return
+}
+
+// UpdateAfterCounter returns a count of finished ConnectionMock.Update invocations
+func (mmUpdate *ConnectionMock) UpdateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.afterUpdateCounter)
+}
+
+// UpdateBeforeCounter returns a count of ConnectionMock.Update invocations
+func (mmUpdate *ConnectionMock) UpdateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdate.beforeUpdateCounter)
+}
+
+// Calls returns a list of arguments used in each call to ConnectionMock.Update.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdate *mConnectionMockUpdate) Calls() []*ConnectionMockUpdateParams {
+	mmUpdate.mutex.RLock()
+
+	argCopy := make([]*ConnectionMockUpdateParams, len(mmUpdate.callArgs))
+	copy(argCopy, mmUpdate.callArgs)
+
+	mmUpdate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateDone returns true if the count of the Update invocations corresponds
+// the number of defined expectations
+func (m *ConnectionMock) MinimockUpdateDone() bool {
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdate != nil && mm_atomic.LoadUint64(&m.afterUpdateCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockUpdateInspect logs each unmet expectation
+func (m *ConnectionMock) MinimockUpdateInspect() {
+	for _, e := range m.UpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ConnectionMock.Update with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateCounter) < 1 {
+		if m.UpdateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ConnectionMock.Update")
+		} else {
+			m.t.Errorf("Expected call to ConnectionMock.Update with params: %#v", *m.UpdateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdate != nil && mm_atomic.LoadUint64(&m.afterUpdateCounter) < 1 {
+		m.t.Error("Expected call to ConnectionMock.Update")
+	}
+}
+
+type mConnectionMockUpdateAndGetLastInsertID struct {
+	mock               *ConnectionMock
+	defaultExpectation *ConnectionMockUpdateAndGetLastInsertIDExpectation
+	expectations       []*ConnectionMockUpdateAndGetLastInsertIDExpectation
+
+	callArgs []*ConnectionMockUpdateAndGetLastInsertIDParams
+	mutex    sync.RWMutex
+}
+
+// ConnectionMockUpdateAndGetLastInsertIDExpectation specifies expectation struct of the Connection.UpdateAndGetLastInsertID
+type ConnectionMockUpdateAndGetLastInsertIDExpectation struct {
+	mock    *ConnectionMock
+	params  *ConnectionMockUpdateAndGetLastInsertIDParams
+	results *ConnectionMockUpdateAndGetLastInsertIDResults
+	Counter uint64
+}
+
+// ConnectionMockUpdateAndGetLastInsertIDParams contains parameters of the Connection.UpdateAndGetLastInsertID
+type ConnectionMockUpdateAndGetLastInsertIDParams struct {
+	ctx  context.Context
+	sql  string
+	args []interface{}
+}
+
+// ConnectionMockUpdateAndGetLastInsertIDResults contains results of the Connection.UpdateAndGetLastInsertID
+type ConnectionMockUpdateAndGetLastInsertIDResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for Connection.UpdateAndGetLastInsertID
+func (mmUpdateAndGetLastInsertID *mConnectionMockUpdateAndGetLastInsertID) Expect(ctx context.Context, sql string, args ...interface{}) *mConnectionMockUpdateAndGetLastInsertID {
+	if mmUpdateAndGetLastInsertID.mock.funcUpdateAndGetLastInsertID != nil {
+		mmUpdateAndGetLastInsertID.mock.t.Fatalf("ConnectionMock.UpdateAndGetLastInsertID mock is already set by Set")
+	}
+
+	if mmUpdateAndGetLastInsertID.defaultExpectation == nil {
+		mmUpdateAndGetLastInsertID.defaultExpectation = &ConnectionMockUpdateAndGetLastInsertIDExpectation{}
+	}
+
+	mmUpdateAndGetLastInsertID.defaultExpectation.params = &ConnectionMockUpdateAndGetLastInsertIDParams{ctx, sql, args}
+	for _, e := range mmUpdateAndGetLastInsertID.expectations {
+		if minimock.Equal(e.params, mmUpdateAndGetLastInsertID.defaultExpectation.params) {
+			mmUpdateAndGetLastInsertID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateAndGetLastInsertID.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateAndGetLastInsertID
+}
+
+// Inspect accepts an inspector function that has same arguments as the Connection.UpdateAndGetLastInsertID
+func (mmUpdateAndGetLastInsertID *mConnectionMockUpdateAndGetLastInsertID) Inspect(f func(ctx context.Context, sql string, args ...interface{})) *mConnectionMockUpdateAndGetLastInsertID {
+	if mmUpdateAndGetLastInsertID.mock.inspectFuncUpdateAndGetLastInsertID != nil {
+		mmUpdateAndGetLastInsertID.mock.t.Fatalf("Inspect function is already set for ConnectionMock.UpdateAndGetLastInsertID")
+	}
+
+	mmUpdateAndGetLastInsertID.mock.inspectFuncUpdateAndGetLastInsertID = f
+
+	return mmUpdateAndGetLastInsertID
+}
+
+// Return sets up results that will be returned by Connection.UpdateAndGetLastInsertID
+func (mmUpdateAndGetLastInsertID *mConnectionMockUpdateAndGetLastInsertID) Return(i1 int64, err error) *ConnectionMock {
+	if mmUpdateAndGetLastInsertID.mock.funcUpdateAndGetLastInsertID != nil {
+		mmUpdateAndGetLastInsertID.mock.t.Fatalf("ConnectionMock.UpdateAndGetLastInsertID mock is already set by Set")
+	}
+
+	if mmUpdateAndGetLastInsertID.defaultExpectation == nil {
+		mmUpdateAndGetLastInsertID.defaultExpectation = &ConnectionMockUpdateAndGetLastInsertIDExpectation{mock: mmUpdateAndGetLastInsertID.mock}
+	}
+	mmUpdateAndGetLastInsertID.defaultExpectation.results = &ConnectionMockUpdateAndGetLastInsertIDResults{i1, err}
+	return mmUpdateAndGetLastInsertID.mock
+}
+
+//Set uses given function f to mock the Connection.UpdateAndGetLastInsertID method
+func (mmUpdateAndGetLastInsertID *mConnectionMockUpdateAndGetLastInsertID) Set(f func(ctx context.Context, sql string, args ...interface{}) (i1 int64, err error)) *ConnectionMock {
+	if mmUpdateAndGetLastInsertID.defaultExpectation != nil {
+		mmUpdateAndGetLastInsertID.mock.t.Fatalf("Default expectation is already set for the Connection.UpdateAndGetLastInsertID method")
+	}
+
+	if len(mmUpdateAndGetLastInsertID.expectations) > 0 {
+		mmUpdateAndGetLastInsertID.mock.t.Fatalf("Some expectations are already set for the Connection.UpdateAndGetLastInsertID method")
+	}
+
+	mmUpdateAndGetLastInsertID.mock.funcUpdateAndGetLastInsertID = f
+	return mmUpdateAndGetLastInsertID.mock
+}
+
+// When sets expectation for the Connection.UpdateAndGetLastInsertID which will trigger the result defined by the following
+// Then helper
+func (mmUpdateAndGetLastInsertID *mConnectionMockUpdateAndGetLastInsertID) When(ctx context.Context, sql string, args ...interface{}) *ConnectionMockUpdateAndGetLastInsertIDExpectation {
+	if mmUpdateAndGetLastInsertID.mock.funcUpdateAndGetLastInsertID != nil {
+		mmUpdateAndGetLastInsertID.mock.t.Fatalf("ConnectionMock.UpdateAndGetLastInsertID mock is already set by Set")
+	}
+
+	expectation := &ConnectionMockUpdateAndGetLastInsertIDExpectation{
+		mock:   mmUpdateAndGetLastInsertID.mock,
+		params: &ConnectionMockUpdateAndGetLastInsertIDParams{ctx, sql, args},
+	}
+	mmUpdateAndGetLastInsertID.expectations = append(mmUpdateAndGetLastInsertID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Connection.UpdateAndGetLastInsertID return parameters for the expectation previously defined by the When method
+func (e *ConnectionMockUpdateAndGetLastInsertIDExpectation) Then(i1 int64, err error) *ConnectionMock {
+	e.results = &ConnectionMockUpdateAndGetLastInsertIDResults{i1, err}
+	return e.mock
+}
+
+// UpdateAndGetLastInsertID implements libsql.Connection
+func (mmUpdateAndGetLastInsertID *ConnectionMock) UpdateAndGetLastInsertID(ctx context.Context, sql string, args ...interface{}) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmUpdateAndGetLastInsertID.beforeUpdateAndGetLastInsertIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateAndGetLastInsertID.afterUpdateAndGetLastInsertIDCounter, 1)
+
+	if mmUpdateAndGetLastInsertID.inspectFuncUpdateAndGetLastInsertID != nil {
+		mmUpdateAndGetLastInsertID.inspectFuncUpdateAndGetLastInsertID(ctx, sql, args...)
+	}
+
+	mm_params := &ConnectionMockUpdateAndGetLastInsertIDParams{ctx, sql, args}
+
+	// Record call args
+	mmUpdateAndGetLastInsertID.UpdateAndGetLastInsertIDMock.mutex.Lock()
+	mmUpdateAndGetLastInsertID.UpdateAndGetLastInsertIDMock.callArgs = append(mmUpdateAndGetLastInsertID.UpdateAndGetLastInsertIDMock.callArgs, mm_params)
+	mmUpdateAndGetLastInsertID.UpdateAndGetLastInsertIDMock.mutex.Unlock()
+
+	for _, e := range mmUpdateAndGetLastInsertID.UpdateAndGetLastInsertIDMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmUpdateAndGetLastInsertID.UpdateAndGetLastInsertIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateAndGetLastInsertID.UpdateAndGetLastInsertIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateAndGetLastInsertID.UpdateAndGetLastInsertIDMock.defaultExpectation.params
+		mm_got := ConnectionMockUpdateAndGetLastInsertIDParams{ctx, sql, args}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateAndGetLastInsertID.t.Errorf("ConnectionMock.UpdateAndGetLastInsertID got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateAndGetLastInsertID.UpdateAndGetLastInsertIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateAndGetLastInsertID.t.Fatal("No results are set for the ConnectionMock.UpdateAndGetLastInsertID")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmUpdateAndGetLastInsertID.funcUpdateAndGetLastInsertID != nil {
+		return mmUpdateAndGetLastInsertID.funcUpdateAndGetLastInsertID(ctx, sql, args...)
+	}
+	mmUpdateAndGetLastInsertID.t.Fatalf("Unexpected call to ConnectionMock.UpdateAndGetLastInsertID. %v %v %v", ctx, sql, args)
+	return
+}
+
+// UpdateAndGetLastInsertIDAfterCounter returns a count of finished ConnectionMock.UpdateAndGetLastInsertID invocations
+func (mmUpdateAndGetLastInsertID *ConnectionMock) UpdateAndGetLastInsertIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateAndGetLastInsertID.afterUpdateAndGetLastInsertIDCounter)
+}
+
+// UpdateAndGetLastInsertIDBeforeCounter returns a count of ConnectionMock.UpdateAndGetLastInsertID invocations
+func (mmUpdateAndGetLastInsertID *ConnectionMock) UpdateAndGetLastInsertIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateAndGetLastInsertID.beforeUpdateAndGetLastInsertIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to ConnectionMock.UpdateAndGetLastInsertID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateAndGetLastInsertID *mConnectionMockUpdateAndGetLastInsertID) Calls() []*ConnectionMockUpdateAndGetLastInsertIDParams {
+	mmUpdateAndGetLastInsertID.mutex.RLock()
+
+	argCopy := make([]*ConnectionMockUpdateAndGetLastInsertIDParams, len(mmUpdateAndGetLastInsertID.callArgs))
+	copy(argCopy, mmUpdateAndGetLastInsertID.callArgs)
+
+	mmUpdateAndGetLastInsertID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateAndGetLastInsertIDDone returns true if the count of the UpdateAndGetLastInsertID invocations corresponds
+// the number of defined expectations
+func (m *ConnectionMock) MinimockUpdateAndGetLastInsertIDDone() bool {
+	for _, e := range m.UpdateAndGetLastInsertIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateAndGetLastInsertIDMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateAndGetLastInsertIDCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateAndGetLastInsertID != nil && mm_atomic.LoadUint64(&m.afterUpdateAndGetLastInsertIDCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockUpdateAndGetLastInsertIDInspect logs each unmet expectation
+func (m *ConnectionMock) MinimockUpdateAndGetLastInsertIDInspect() {
+	for _, e := range m.UpdateAndGetLastInsertIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ConnectionMock.UpdateAndGetLastInsertID with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateAndGetLastInsertIDMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateAndGetLastInsertIDCounter) < 1 {
+		if m.UpdateAndGetLastInsertIDMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ConnectionMock.UpdateAndGetLastInsertID")
+		} else {
+			m.t.Errorf("Expected call to ConnectionMock.UpdateAndGetLastInsertID with params: %#v", *m.UpdateAndGetLastInsertIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateAndGetLastInsertID != nil && mm_atomic.LoadUint64(&m.afterUpdateAndGetLastInsertIDCounter) < 1 {
+		m.t.Error("Expected call to ConnectionMock.UpdateAndGetLastInsertID")
+	}
+}
+
+type mConnectionMockUpdateAndGetRowsAffected struct {
+	mock               *ConnectionMock
+	defaultExpectation *ConnectionMockUpdateAndGetRowsAffectedExpectation
+	expectations       []*ConnectionMockUpdateAndGetRowsAffectedExpectation
+
+	callArgs []*ConnectionMockUpdateAndGetRowsAffectedParams
+	mutex    sync.RWMutex
+}
+
+// ConnectionMockUpdateAndGetRowsAffectedExpectation specifies expectation struct of the Connection.UpdateAndGetRowsAffected
+type ConnectionMockUpdateAndGetRowsAffectedExpectation struct {
+	mock    *ConnectionMock
+	params  *ConnectionMockUpdateAndGetRowsAffectedParams
+	results *ConnectionMockUpdateAndGetRowsAffectedResults
+	Counter uint64
+}
+
+// ConnectionMockUpdateAndGetRowsAffectedParams contains parameters of the Connection.UpdateAndGetRowsAffected
+type ConnectionMockUpdateAndGetRowsAffectedParams struct {
+	ctx  context.Context
+	sql  string
+	args []interface{}
+}
+
+// ConnectionMockUpdateAndGetRowsAffectedResults contains results of the Connection.UpdateAndGetRowsAffected
+type ConnectionMockUpdateAndGetRowsAffectedResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for Connection.UpdateAndGetRowsAffected
+func (mmUpdateAndGetRowsAffected *mConnectionMockUpdateAndGetRowsAffected) Expect(ctx context.Context, sql string, args ...interface{}) *mConnectionMockUpdateAndGetRowsAffected {
+	if mmUpdateAndGetRowsAffected.mock.funcUpdateAndGetRowsAffected != nil {
+		mmUpdateAndGetRowsAffected.mock.t.Fatalf("ConnectionMock.UpdateAndGetRowsAffected mock is already set by Set")
+	}
+
+	if mmUpdateAndGetRowsAffected.defaultExpectation == nil {
+		mmUpdateAndGetRowsAffected.defaultExpectation = &ConnectionMockUpdateAndGetRowsAffectedExpectation{}
+	}
+
+	mmUpdateAndGetRowsAffected.defaultExpectation.params = &ConnectionMockUpdateAndGetRowsAffectedParams{ctx, sql, args}
+	for _, e := range mmUpdateAndGetRowsAffected.expectations {
+		if minimock.Equal(e.params, mmUpdateAndGetRowsAffected.defaultExpectation.params) {
+			mmUpdateAndGetRowsAffected.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateAndGetRowsAffected.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateAndGetRowsAffected
+}
+
+// Inspect accepts an inspector function that has same arguments as the Connection.UpdateAndGetRowsAffected
+func (mmUpdateAndGetRowsAffected *mConnectionMockUpdateAndGetRowsAffected) Inspect(f func(ctx context.Context, sql string, args ...interface{})) *mConnectionMockUpdateAndGetRowsAffected {
+	if mmUpdateAndGetRowsAffected.mock.inspectFuncUpdateAndGetRowsAffected != nil {
+		mmUpdateAndGetRowsAffected.mock.t.Fatalf("Inspect function is already set for ConnectionMock.UpdateAndGetRowsAffected")
+	}
+
+	mmUpdateAndGetRowsAffected.mock.inspectFuncUpdateAndGetRowsAffected = f
+
+	return mmUpdateAndGetRowsAffected
+}
+
+// Return sets up results that will be returned by Connection.UpdateAndGetRowsAffected
+func (mmUpdateAndGetRowsAffected *mConnectionMockUpdateAndGetRowsAffected) Return(i1 int64, err error) *ConnectionMock {
+	if mmUpdateAndGetRowsAffected.mock.funcUpdateAndGetRowsAffected != nil {
+		mmUpdateAndGetRowsAffected.mock.t.Fatalf("ConnectionMock.UpdateAndGetRowsAffected mock is already set by Set")
+	}
+
+	if mmUpdateAndGetRowsAffected.defaultExpectation == nil {
+		mmUpdateAndGetRowsAffected.defaultExpectation = &ConnectionMockUpdateAndGetRowsAffectedExpectation{mock: mmUpdateAndGetRowsAffected.mock}
+	}
+	mmUpdateAndGetRowsAffected.defaultExpectation.results = &ConnectionMockUpdateAndGetRowsAffectedResults{i1, err}
+	return mmUpdateAndGetRowsAffected.mock
+}
+
+//Set uses given function f to mock the Connection.UpdateAndGetRowsAffected method
+func (mmUpdateAndGetRowsAffected *mConnectionMockUpdateAndGetRowsAffected) Set(f func(ctx context.Context, sql string, args ...interface{}) (i1 int64, err error)) *ConnectionMock {
+	if mmUpdateAndGetRowsAffected.defaultExpectation != nil {
+		mmUpdateAndGetRowsAffected.mock.t.Fatalf("Default expectation is already set for the Connection.UpdateAndGetRowsAffected method")
+	}
+
+	if len(mmUpdateAndGetRowsAffected.expectations) > 0 {
+		mmUpdateAndGetRowsAffected.mock.t.Fatalf("Some expectations are already set for the Connection.UpdateAndGetRowsAffected method")
+	}
+
+	mmUpdateAndGetRowsAffected.mock.funcUpdateAndGetRowsAffected = f
+	return mmUpdateAndGetRowsAffected.mock
+}
+
+// When sets expectation for the Connection.UpdateAndGetRowsAffected which will trigger the result defined by the following
+// Then helper
+func (mmUpdateAndGetRowsAffected *mConnectionMockUpdateAndGetRowsAffected) When(ctx context.Context, sql string, args ...interface{}) *ConnectionMockUpdateAndGetRowsAffectedExpectation {
+	if mmUpdateAndGetRowsAffected.mock.funcUpdateAndGetRowsAffected != nil {
+		mmUpdateAndGetRowsAffected.mock.t.Fatalf("ConnectionMock.UpdateAndGetRowsAffected mock is already set by Set")
+	}
+
+	expectation := &ConnectionMockUpdateAndGetRowsAffectedExpectation{
+		mock:   mmUpdateAndGetRowsAffected.mock,
+		params: &ConnectionMockUpdateAndGetRowsAffectedParams{ctx, sql, args},
+	}
+	mmUpdateAndGetRowsAffected.expectations = append(mmUpdateAndGetRowsAffected.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Connection.UpdateAndGetRowsAffected return parameters for the expectation previously defined by the When method
+func (e *ConnectionMockUpdateAndGetRowsAffectedExpectation) Then(i1 int64, err error) *ConnectionMock {
+	e.results = &ConnectionMockUpdateAndGetRowsAffectedResults{i1, err}
+	return e.mock
+}
+
+// UpdateAndGetRowsAffected implements libsql.Connection
+func (mmUpdateAndGetRowsAffected *ConnectionMock) UpdateAndGetRowsAffected(ctx context.Context, sql string, args ...interface{}) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmUpdateAndGetRowsAffected.beforeUpdateAndGetRowsAffectedCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateAndGetRowsAffected.afterUpdateAndGetRowsAffectedCounter, 1)
+
+	if mmUpdateAndGetRowsAffected.inspectFuncUpdateAndGetRowsAffected != nil {
+		mmUpdateAndGetRowsAffected.inspectFuncUpdateAndGetRowsAffected(ctx, sql, args...)
+	}
+
+	mm_params := &ConnectionMockUpdateAndGetRowsAffectedParams{ctx, sql, args}
+
+	// Record call args
+	mmUpdateAndGetRowsAffected.UpdateAndGetRowsAffectedMock.mutex.Lock()
+	mmUpdateAndGetRowsAffected.UpdateAndGetRowsAffectedMock.callArgs = append(mmUpdateAndGetRowsAffected.UpdateAndGetRowsAffectedMock.callArgs, mm_params)
+	mmUpdateAndGetRowsAffected.UpdateAndGetRowsAffectedMock.mutex.Unlock()
+
+	for _, e := range mmUpdateAndGetRowsAffected.UpdateAndGetRowsAffectedMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmUpdateAndGetRowsAffected.UpdateAndGetRowsAffectedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateAndGetRowsAffected.UpdateAndGetRowsAffectedMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateAndGetRowsAffected.UpdateAndGetRowsAffectedMock.defaultExpectation.params
+		mm_got := ConnectionMockUpdateAndGetRowsAffectedParams{ctx, sql, args}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateAndGetRowsAffected.t.Errorf("ConnectionMock.UpdateAndGetRowsAffected got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateAndGetRowsAffected.UpdateAndGetRowsAffectedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateAndGetRowsAffected.t.Fatal("No results are set for the ConnectionMock.UpdateAndGetRowsAffected")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmUpdateAndGetRowsAffected.funcUpdateAndGetRowsAffected != nil {
+		return mmUpdateAndGetRowsAffected.funcUpdateAndGetRowsAffected(ctx, sql, args...)
+	}
+	mmUpdateAndGetRowsAffected.t.Fatalf("Unexpected call to ConnectionMock.UpdateAndGetRowsAffected. %v %v %v", ctx, sql, args)
+	return
+}
+
+// UpdateAndGetRowsAffectedAfterCounter returns a count of finished ConnectionMock.UpdateAndGetRowsAffected invocations
+func (mmUpdateAndGetRowsAffected *ConnectionMock) UpdateAndGetRowsAffectedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateAndGetRowsAffected.afterUpdateAndGetRowsAffectedCounter)
+}
+
+// UpdateAndGetRowsAffectedBeforeCounter returns a count of ConnectionMock.UpdateAndGetRowsAffected invocations
+func (mmUpdateAndGetRowsAffected *ConnectionMock) UpdateAndGetRowsAffectedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateAndGetRowsAffected.beforeUpdateAndGetRowsAffectedCounter)
+}
+
+// Calls returns a list of arguments used in each call to ConnectionMock.UpdateAndGetRowsAffected.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateAndGetRowsAffected *mConnectionMockUpdateAndGetRowsAffected) Calls() []*ConnectionMockUpdateAndGetRowsAffectedParams {
+	mmUpdateAndGetRowsAffected.mutex.RLock()
+
+	argCopy := make([]*ConnectionMockUpdateAndGetRowsAffectedParams, len(mmUpdateAndGetRowsAffected.callArgs))
+	copy(argCopy, mmUpdateAndGetRowsAffected.callArgs)
+
+	mmUpdateAndGetRowsAffected.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateAndGetRowsAffectedDone returns true if the count of the UpdateAndGetRowsAffected invocations corresponds
+// the number of defined expectations
+func (m *ConnectionMock) MinimockUpdateAndGetRowsAffectedDone() bool {
+	for _, e := range m.UpdateAndGetRowsAffectedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateAndGetRowsAffectedMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateAndGetRowsAffectedCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateAndGetRowsAffected != nil && mm_atomic.LoadUint64(&m.afterUpdateAndGetRowsAffectedCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockUpdateAndGetRowsAffectedInspect logs each unmet expectation
+func (m *ConnectionMock) MinimockUpdateAndGetRowsAffectedInspect() {
+	for _, e := range m.UpdateAndGetRowsAffectedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ConnectionMock.UpdateAndGetRowsAffected with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateAndGetRowsAffectedMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateAndGetRowsAffectedCounter) < 1 {
+		if m.UpdateAndGetRowsAffectedMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ConnectionMock.UpdateAndGetRowsAffected")
+		} else {
+			m.t.Errorf("Expected call to ConnectionMock.UpdateAndGetRowsAffected with params: %#v", *m.UpdateAndGetRowsAffectedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateAndGetRowsAffected != nil && mm_atomic.LoadUint64(&m.afterUpdateAndGetRowsAffectedCounter) < 1 {
+		m.t.Error("Expected call to ConnectionMock.UpdateAndGetRowsAffected")
+	}
+}
+
+type mConnectionMockUpdateReturning struct {
+	mock               *ConnectionMock
+	defaultExpectation *ConnectionMockUpdateReturningExpectation
+	expectations       []*ConnectionMockUpdateReturningExpectation
+
+	callArgs []*ConnectionMockUpdateReturningParams
+	mutex    sync.RWMutex
+}
+
+// ConnectionMockUpdateReturningExpectation specifies expectation struct of the Connection.UpdateReturning
+type ConnectionMockUpdateReturningExpectation struct {
+	mock    *ConnectionMock
+	params  *ConnectionMockUpdateReturningParams
+	results *ConnectionMockUpdateReturningResults
+	Counter uint64
+}
+
+// ConnectionMockUpdateReturningParams contains parameters of the Connection.UpdateReturning
+type ConnectionMockUpdateReturningParams struct {
+	ctx     context.Context
+	scanner mm_libsql.RowScanner
+	sql     string
+	args    []interface{}
+}
+
+// ConnectionMockUpdateReturningResults contains results of the Connection.UpdateReturning
+type ConnectionMockUpdateReturningResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for Connection.UpdateReturning
+func (mmUpdateReturning *mConnectionMockUpdateReturning) Expect(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) *mConnectionMockUpdateReturning {
+	if mmUpdateReturning.mock.funcUpdateReturning != nil {
+		mmUpdateReturning.mock.t.Fatalf("ConnectionMock.UpdateReturning mock is already set by Set")
+	}
+
+	if mmUpdateReturning.defaultExpectation == nil {
+		mmUpdateReturning.defaultExpectation = &ConnectionMockUpdateReturningExpectation{}
+	}
+
+	mmUpdateReturning.defaultExpectation.params = &ConnectionMockUpdateReturningParams{ctx, scanner, sql, args}
+	for _, e := range mmUpdateReturning.expectations {
+		if minimock.Equal(e.params, mmUpdateReturning.defaultExpectation.params) {
+			mmUpdateReturning.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateReturning.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateReturning
+}
+
+// Inspect accepts an inspector function that has same arguments as the Connection.UpdateReturning
+func (mmUpdateReturning *mConnectionMockUpdateReturning) Inspect(f func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{})) *mConnectionMockUpdateReturning {
+	if mmUpdateReturning.mock.inspectFuncUpdateReturning != nil {
+		mmUpdateReturning.mock.t.Fatalf("Inspect function is already set for ConnectionMock.UpdateReturning")
+	}
+
+	mmUpdateReturning.mock.inspectFuncUpdateReturning = f
+
+	return mmUpdateReturning
+}
+
+// Return sets up results that will be returned by Connection.UpdateReturning
+func (mmUpdateReturning *mConnectionMockUpdateReturning) Return(i1 int64, err error) *ConnectionMock {
+	if mmUpdateReturning.mock.funcUpdateReturning != nil {
+		mmUpdateReturning.mock.t.Fatalf("ConnectionMock.UpdateReturning mock is already set by Set")
+	}
+
+	if mmUpdateReturning.defaultExpectation == nil {
+		mmUpdateReturning.defaultExpectation = &ConnectionMockUpdateReturningExpectation{mock: mmUpdateReturning.mock}
+	}
+	mmUpdateReturning.defaultExpectation.results = &ConnectionMockUpdateReturningResults{i1, err}
+	return mmUpdateReturning.mock
+}
+
+//Set uses given function f to mock the Connection.UpdateReturning method
+func (mmUpdateReturning *mConnectionMockUpdateReturning) Set(f func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (i1 int64, err error)) *ConnectionMock {
+	if mmUpdateReturning.defaultExpectation != nil {
+		mmUpdateReturning.mock.t.Fatalf("Default expectation is already set for the Connection.UpdateReturning method")
+	}
+
+	if len(mmUpdateReturning.expectations) > 0 {
+		mmUpdateReturning.mock.t.Fatalf("Some expectations are already set for the Connection.UpdateReturning method")
+	}
+
+	mmUpdateReturning.mock.funcUpdateReturning = f
+	return mmUpdateReturning.mock
+}
+
+// When sets expectation for the Connection.UpdateReturning which will trigger the result defined by the following
+// Then helper
+func (mmUpdateReturning *mConnectionMockUpdateReturning) When(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) *ConnectionMockUpdateReturningExpectation {
+	if mmUpdateReturning.mock.funcUpdateReturning != nil {
+		mmUpdateReturning.mock.t.Fatalf("ConnectionMock.UpdateReturning mock is already set by Set")
+	}
+
+	expectation := &ConnectionMockUpdateReturningExpectation{
+		mock:   mmUpdateReturning.mock,
+		params: &ConnectionMockUpdateReturningParams{ctx, scanner, sql, args},
+	}
+	mmUpdateReturning.expectations = append(mmUpdateReturning.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Connection.UpdateReturning return parameters for the expectation previously defined by the When method
+func (e *ConnectionMockUpdateReturningExpectation) Then(i1 int64, err error) *ConnectionMock {
+	e.results = &ConnectionMockUpdateReturningResults{i1, err}
+	return e.mock
+}
+
+// UpdateReturning implements libsql.Connection
+func (mmUpdateReturning *ConnectionMock) UpdateReturning(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmUpdateReturning.beforeUpdateReturningCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateReturning.afterUpdateReturningCounter, 1)
+
+	if mmUpdateReturning.inspectFuncUpdateReturning != nil {
+		mmUpdateReturning.inspectFuncUpdateReturning(ctx, scanner, sql, args...)
+	}
+
+	mm_params := &ConnectionMockUpdateReturningParams{ctx, scanner, sql, args}
+
+	// Record call args
+	mmUpdateReturning.UpdateReturningMock.mutex.Lock()
+	mmUpdateReturning.UpdateReturningMock.callArgs = append(mmUpdateReturning.UpdateReturningMock.callArgs, mm_params)
+	mmUpdateReturning.UpdateReturningMock.mutex.Unlock()
+
+	for _, e := range mmUpdateReturning.UpdateReturningMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmUpdateReturning.UpdateReturningMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateReturning.UpdateReturningMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateReturning.UpdateReturningMock.defaultExpectation.params
+		mm_got := ConnectionMockUpdateReturningParams{ctx, scanner, sql, args}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateReturning.t.Errorf("ConnectionMock.UpdateReturning got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateReturning.UpdateReturningMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateReturning.t.Fatal("No results are set for the ConnectionMock.UpdateReturning")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmUpdateReturning.funcUpdateReturning != nil {
+		return mmUpdateReturning.funcUpdateReturning(ctx, scanner, sql, args...)
+	}
+	mmUpdateReturning.t.Fatalf("Unexpected call to ConnectionMock.UpdateReturning. %v %v %v %v", ctx, scanner, sql, args)
+	return
+}
+
+// UpdateReturningAfterCounter returns a count of finished ConnectionMock.UpdateReturning invocations
+func (mmUpdateReturning *ConnectionMock) UpdateReturningAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateReturning.afterUpdateReturningCounter)
+}
+
+// UpdateReturningBeforeCounter returns a count of ConnectionMock.UpdateReturning invocations
+func (mmUpdateReturning *ConnectionMock) UpdateReturningBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateReturning.beforeUpdateReturningCounter)
+}
+
+// Calls returns a list of arguments used in each call to ConnectionMock.UpdateReturning.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateReturning *mConnectionMockUpdateReturning) Calls() []*ConnectionMockUpdateReturningParams {
+	mmUpdateReturning.mutex.RLock()
+
+	argCopy := make([]*ConnectionMockUpdateReturningParams, len(mmUpdateReturning.callArgs))
+	copy(argCopy, mmUpdateReturning.callArgs)
+
+	mmUpdateReturning.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateReturningDone returns true if the count of the UpdateReturning invocations corresponds
+// the number of defined expectations
+func (m *ConnectionMock) MinimockUpdateReturningDone() bool {
+	for _, e := range m.UpdateReturningMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateReturningMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateReturningCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateReturning != nil && mm_atomic.LoadUint64(&m.afterUpdateReturningCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockUpdateReturningInspect logs each unmet expectation
+func (m *ConnectionMock) MinimockUpdateReturningInspect() {
+	for _, e := range m.UpdateReturningMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ConnectionMock.UpdateReturning with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateReturningMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateReturningCounter) < 1 {
+		if m.UpdateReturningMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ConnectionMock.UpdateReturning")
+		} else {
+			m.t.Errorf("Expected call to ConnectionMock.UpdateReturning with params: %#v", *m.UpdateReturningMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateReturning != nil && mm_atomic.LoadUint64(&m.afterUpdateReturningCounter) < 1 {
+		m.t.Error("Expected call to ConnectionMock.UpdateReturning")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ConnectionMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockPreparedInspect()
+
+		m.MinimockScanInspect()
+
+		m.MinimockScanOneInspect()
+
+		m.MinimockScanOptionalInspect()
+
+		m.MinimockTransactionInspect()
+
+		m.MinimockUpdateInspect()
+
+		m.MinimockUpdateAndGetLastInsertIDInspect()
+
+		m.MinimockUpdateAndGetRowsAffectedInspect()
+
+		m.MinimockUpdateReturningInspect()
+		m.t.FailNow()
+	}
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ConnectionMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ConnectionMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockPreparedDone() &&
+		m.MinimockScanDone() &&
+		m.MinimockScanOneDone() &&
+		m.MinimockScanOptionalDone() &&
+		m.MinimockTransactionDone() &&
+		m.MinimockUpdateDone() &&
+		m.MinimockUpdateAndGetLastInsertIDDone() &&
+		m.MinimockUpdateAndGetRowsAffectedDone() &&
+		m.MinimockUpdateReturningDone()
+}
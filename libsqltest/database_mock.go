@@ -23,6 +23,18 @@ type DatabaseMock struct {
 	beforeCloseCounter uint64
 	CloseMock          mDatabaseMockClose
 
+	funcConn          func(ctx context.Context, work func(mm_libsql.Connection) error) (err error)
+	inspectFuncConn   func(ctx context.Context, work func(mm_libsql.Connection) error)
+	afterConnCounter  uint64
+	beforeConnCounter uint64
+	ConnMock          mDatabaseMockConn
+
+	funcPing          func(ctx context.Context) (err error)
+	inspectFuncPing   func(ctx context.Context)
+	afterPingCounter  uint64
+	beforePingCounter uint64
+	PingMock          mDatabaseMockPing
+
 	funcPrepareStatement          func(ctx context.Context, sql string) (p1 mm_libsql.PreparedStatement, err error)
 	inspectFuncPrepareStatement   func(ctx context.Context, sql string)
 	afterPrepareStatementCounter  uint64
@@ -47,6 +59,18 @@ type DatabaseMock struct {
 	beforeScanOneCounter uint64
 	ScanOneMock          mDatabaseMockScanOne
 
+	funcScanOptional          func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (b1 bool, err error)
+	inspectFuncScanOptional   func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{})
+	afterScanOptionalCounter  uint64
+	beforeScanOptionalCounter uint64
+	ScanOptionalMock          mDatabaseMockScanOptional
+
+	funcStats          func() (d1 sql.DBStats)
+	inspectFuncStats   func()
+	afterStatsCounter  uint64
+	beforeStatsCounter uint64
+	StatsMock          mDatabaseMockStats
+
 	funcTransaction          func(ctx context.Context, work func(mm_libsql.Transaction) error) (err error)
 	inspectFuncTransaction   func(ctx context.Context, work func(mm_libsql.Transaction) error)
 	afterTransactionCounter  uint64
@@ -70,6 +94,12 @@ type DatabaseMock struct {
 	afterUpdateAndGetRowsAffectedCounter  uint64
 	beforeUpdateAndGetRowsAffectedCounter uint64
 	UpdateAndGetRowsAffectedMock          mDatabaseMockUpdateAndGetRowsAffected
+
+	funcUpdateReturning          func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (i1 int64, err error)
+	inspectFuncUpdateReturning   func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{})
+	afterUpdateReturningCounter  uint64
+	beforeUpdateReturningCounter uint64
+	UpdateReturningMock          mDatabaseMockUpdateReturning
 }
 
 // NewDatabaseMock returns a mock for libsql.Database
@@ -81,6 +111,12 @@ func NewDatabaseMock(t minimock.Tester) *DatabaseMock {
 
 	m.CloseMock = mDatabaseMockClose{mock: m}
 
+	m.ConnMock = mDatabaseMockConn{mock: m}
+	m.ConnMock.callArgs = []*DatabaseMockConnParams{}
+
+	m.PingMock = mDatabaseMockPing{mock: m}
+	m.PingMock.callArgs = []*DatabaseMockPingParams{}
+
 	m.PrepareStatementMock = mDatabaseMockPrepareStatement{mock: m}
 	m.PrepareStatementMock.callArgs = []*DatabaseMockPrepareStatementParams{}
 
@@ -93,6 +129,11 @@ func NewDatabaseMock(t minimock.Tester) *DatabaseMock {
 	m.ScanOneMock = mDatabaseMockScanOne{mock: m}
 	m.ScanOneMock.callArgs = []*DatabaseMockScanOneParams{}
 
+	m.ScanOptionalMock = mDatabaseMockScanOptional{mock: m}
+	m.ScanOptionalMock.callArgs = []*DatabaseMockScanOptionalParams{}
+
+	m.StatsMock = mDatabaseMockStats{mock: m}
+
 	m.TransactionMock = mDatabaseMockTransaction{mock: m}
 	m.TransactionMock.callArgs = []*DatabaseMockTransactionParams{}
 
@@ -105,6 +146,9 @@ func NewDatabaseMock(t minimock.Tester) *DatabaseMock {
 	m.UpdateAndGetRowsAffectedMock = mDatabaseMockUpdateAndGetRowsAffected{mock: m}
 	m.UpdateAndGetRowsAffectedMock.callArgs = []*DatabaseMockUpdateAndGetRowsAffectedParams{}
 
+	m.UpdateReturningMock = mDatabaseMockUpdateReturning{mock: m}
+	m.UpdateReturningMock.callArgs = []*DatabaseMockUpdateReturningParams{}
+
 	return m
 }
 
@@ -251,6 +295,437 @@ func (m *DatabaseMock) MinimockCloseInspect() {
 	}
 }
 
+type mDatabaseMockConn struct {
+	mock               *DatabaseMock
+	defaultExpectation *DatabaseMockConnExpectation
+	expectations       []*DatabaseMockConnExpectation
+
+	callArgs []*DatabaseMockConnParams
+	mutex    sync.RWMutex
+}
+
+// DatabaseMockConnExpectation specifies expectation struct of the Database.Conn
+type DatabaseMockConnExpectation struct {
+	mock    *DatabaseMock
+	params  *DatabaseMockConnParams
+	results *DatabaseMockConnResults
+	Counter uint64
+}
+
+// DatabaseMockConnParams contains parameters of the Database.Conn
+type DatabaseMockConnParams struct {
+	ctx  context.Context
+	work func(mm_libsql.Connection) error
+}
+
+// DatabaseMockConnResults contains results of the Database.Conn
+type DatabaseMockConnResults struct {
+	err error
+}
+
+// Expect sets up expected params for Database.Conn
+func (mmConn *mDatabaseMockConn) Expect(ctx context.Context, work func(mm_libsql.Connection) error) *mDatabaseMockConn {
+	if mmConn.mock.funcConn != nil {
+		mmConn.mock.t.Fatalf("DatabaseMock.Conn mock is already set by Set")
+	}
+
+	if mmConn.defaultExpectation == nil {
+		mmConn.defaultExpectation = &DatabaseMockConnExpectation{}
+	}
+
+	mmConn.defaultExpectation.params = &DatabaseMockConnParams{ctx, work}
+	for _, e := range mmConn.expectations {
+		if minimock.Equal(e.params, mmConn.defaultExpectation.params) {
+			mmConn.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmConn.defaultExpectation.params)
+		}
+	}
+
+	return mmConn
+}
+
+// Inspect accepts an inspector function that has same arguments as the Database.Conn
+func (mmConn *mDatabaseMockConn) Inspect(f func(ctx context.Context, work func(mm_libsql.Connection) error)) *mDatabaseMockConn {
+	if mmConn.mock.inspectFuncConn != nil {
+		mmConn.mock.t.Fatalf("Inspect function is already set for DatabaseMock.Conn")
+	}
+
+	mmConn.mock.inspectFuncConn = f
+
+	return mmConn
+}
+
+// Return sets up results that will be returned by Database.Conn
+func (mmConn *mDatabaseMockConn) Return(err error) *DatabaseMock {
+	if mmConn.mock.funcConn != nil {
+		mmConn.mock.t.Fatalf("DatabaseMock.Conn mock is already set by Set")
+	}
+
+	if mmConn.defaultExpectation == nil {
+		mmConn.defaultExpectation = &DatabaseMockConnExpectation{mock: mmConn.mock}
+	}
+	mmConn.defaultExpectation.results = &DatabaseMockConnResults{err}
+	return mmConn.mock
+}
+
+//Set uses given function f to mock the Database.Conn method
+func (mmConn *mDatabaseMockConn) Set(f func(ctx context.Context, work func(mm_libsql.Connection) error) (err error)) *DatabaseMock {
+	if mmConn.defaultExpectation != nil {
+		mmConn.mock.t.Fatalf("Default expectation is already set for the Database.Conn method")
+	}
+
+	if len(mmConn.expectations) > 0 {
+		mmConn.mock.t.Fatalf("Some expectations are already set for the Database.Conn method")
+	}
+
+	mmConn.mock.funcConn = f
+	return mmConn.mock
+}
+
+// When sets expectation for the Database.Conn which will trigger the result defined by the following
+// Then helper
+func (mmConn *mDatabaseMockConn) When(ctx context.Context, work func(mm_libsql.Connection) error) *DatabaseMockConnExpectation {
+	if mmConn.mock.funcConn != nil {
+		mmConn.mock.t.Fatalf("DatabaseMock.Conn mock is already set by Set")
+	}
+
+	expectation := &DatabaseMockConnExpectation{
+		mock:   mmConn.mock,
+		params: &DatabaseMockConnParams{ctx, work},
+	}
+	mmConn.expectations = append(mmConn.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Database.Conn return parameters for the expectation previously defined by the When method
+func (e *DatabaseMockConnExpectation) Then(err error) *DatabaseMock {
+	e.results = &DatabaseMockConnResults{err}
+	return e.mock
+}
+
+// Conn implements libsql.Database
+func (mmConn *DatabaseMock) Conn(ctx context.Context, work func(mm_libsql.Connection) error) (err error) {
+	mm_atomic.AddUint64(&mmConn.beforeConnCounter, 1)
+	defer mm_atomic.AddUint64(&mmConn.afterConnCounter, 1)
+
+	if mmConn.inspectFuncConn != nil {
+		mmConn.inspectFuncConn(ctx, work)
+	}
+
+	mm_params := &DatabaseMockConnParams{ctx, work}
+
+	// Record call args
+	mmConn.ConnMock.mutex.Lock()
+	mmConn.ConnMock.callArgs = append(mmConn.ConnMock.callArgs, mm_params)
+	mmConn.ConnMock.mutex.Unlock()
+
+	for _, e := range mmConn.ConnMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmConn.ConnMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmConn.ConnMock.defaultExpectation.Counter, 1)
+		mm_want := mmConn.ConnMock.defaultExpectation.params
+		mm_got := DatabaseMockConnParams{ctx, work}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmConn.t.Errorf("DatabaseMock.Conn got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmConn.ConnMock.defaultExpectation.results
+		if mm_results == nil {
+			mmConn.t.Fatal("No results are set for the DatabaseMock.Conn")
+		}
+		return (*mm_results).err
+	}
+	if mmConn.funcConn != nil {
+		return mmConn.funcConn(ctx, work)
+	}
+	mmConn.t.Fatalf("Unexpected call to DatabaseMock.Conn. %v %v", ctx, work)
+	return
+}
+
+// ConnAfterCounter returns a count of finished DatabaseMock.Conn invocations
+func (mmConn *DatabaseMock) ConnAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConn.afterConnCounter)
+}
+
+// ConnBeforeCounter returns a count of DatabaseMock.Conn invocations
+func (mmConn *DatabaseMock) ConnBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConn.beforeConnCounter)
+}
+
+// Calls returns a list of arguments used in each call to DatabaseMock.Conn.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmConn *mDatabaseMockConn) Calls() []*DatabaseMockConnParams {
+	mmConn.mutex.RLock()
+
+	argCopy := make([]*DatabaseMockConnParams, len(mmConn.callArgs))
+	copy(argCopy, mmConn.callArgs)
+
+	mmConn.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockConnDone returns true if the count of the Conn invocations corresponds
+// the number of defined expectations
+func (m *DatabaseMock) MinimockConnDone() bool {
+	for _, e := range m.ConnMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ConnMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterConnCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcConn != nil && mm_atomic.LoadUint64(&m.afterConnCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockConnInspect logs each unmet expectation
+func (m *DatabaseMock) MinimockConnInspect() {
+	for _, e := range m.ConnMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DatabaseMock.Conn with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ConnMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterConnCounter) < 1 {
+		if m.ConnMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to DatabaseMock.Conn")
+		} else {
+			m.t.Errorf("Expected call to DatabaseMock.Conn with params: %#v", *m.ConnMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcConn != nil && mm_atomic.LoadUint64(&m.afterConnCounter) < 1 {
+		m.t.Error("Expected call to DatabaseMock.Conn")
+	}
+}
+
+type mDatabaseMockPing struct {
+	mock               *DatabaseMock
+	defaultExpectation *DatabaseMockPingExpectation
+	expectations       []*DatabaseMockPingExpectation
+
+	callArgs []*DatabaseMockPingParams
+	mutex    sync.RWMutex
+}
+
+// DatabaseMockPingExpectation specifies expectation struct of the Database.Ping
+type DatabaseMockPingExpectation struct {
+	mock    *DatabaseMock
+	params  *DatabaseMockPingParams
+	results *DatabaseMockPingResults
+	Counter uint64
+}
+
+// DatabaseMockPingParams contains parameters of the Database.Ping
+type DatabaseMockPingParams struct {
+	ctx context.Context
+}
+
+// DatabaseMockPingResults contains results of the Database.Ping
+type DatabaseMockPingResults struct {
+	err error
+}
+
+// Expect sets up expected params for Database.Ping
+func (mmPing *mDatabaseMockPing) Expect(ctx context.Context) *mDatabaseMockPing {
+	if mmPing.mock.funcPing != nil {
+		mmPing.mock.t.Fatalf("DatabaseMock.Ping mock is already set by Set")
+	}
+
+	if mmPing.defaultExpectation == nil {
+		mmPing.defaultExpectation = &DatabaseMockPingExpectation{}
+	}
+
+	mmPing.defaultExpectation.params = &DatabaseMockPingParams{ctx}
+	for _, e := range mmPing.expectations {
+		if minimock.Equal(e.params, mmPing.defaultExpectation.params) {
+			mmPing.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPing.defaultExpectation.params)
+		}
+	}
+
+	return mmPing
+}
+
+// Inspect accepts an inspector function that has same arguments as the Database.Ping
+func (mmPing *mDatabaseMockPing) Inspect(f func(ctx context.Context)) *mDatabaseMockPing {
+	if mmPing.mock.inspectFuncPing != nil {
+		mmPing.mock.t.Fatalf("Inspect function is already set for DatabaseMock.Ping")
+	}
+
+	mmPing.mock.inspectFuncPing = f
+
+	return mmPing
+}
+
+// Return sets up results that will be returned by Database.Ping
+func (mmPing *mDatabaseMockPing) Return(err error) *DatabaseMock {
+	if mmPing.mock.funcPing != nil {
+		mmPing.mock.t.Fatalf("DatabaseMock.Ping mock is already set by Set")
+	}
+
+	if mmPing.defaultExpectation == nil {
+		mmPing.defaultExpectation = &DatabaseMockPingExpectation{mock: mmPing.mock}
+	}
+	mmPing.defaultExpectation.results = &DatabaseMockPingResults{err}
+	return mmPing.mock
+}
+
+//Set uses given function f to mock the Database.Ping method
+func (mmPing *mDatabaseMockPing) Set(f func(ctx context.Context) (err error)) *DatabaseMock {
+	if mmPing.defaultExpectation != nil {
+		mmPing.mock.t.Fatalf("Default expectation is already set for the Database.Ping method")
+	}
+
+	if len(mmPing.expectations) > 0 {
+		mmPing.mock.t.Fatalf("Some expectations are already set for the Database.Ping method")
+	}
+
+	mmPing.mock.funcPing = f
+	return mmPing.mock
+}
+
+// When sets expectation for the Database.Ping which will trigger the result defined by the following
+// Then helper
+func (mmPing *mDatabaseMockPing) When(ctx context.Context) *DatabaseMockPingExpectation {
+	if mmPing.mock.funcPing != nil {
+		mmPing.mock.t.Fatalf("DatabaseMock.Ping mock is already set by Set")
+	}
+
+	expectation := &DatabaseMockPingExpectation{
+		mock:   mmPing.mock,
+		params: &DatabaseMockPingParams{ctx},
+	}
+	mmPing.expectations = append(mmPing.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Database.Ping return parameters for the expectation previously defined by the When method
+func (e *DatabaseMockPingExpectation) Then(err error) *DatabaseMock {
+	e.results = &DatabaseMockPingResults{err}
+	return e.mock
+}
+
+// Ping implements libsql.Database
+func (mmPing *DatabaseMock) Ping(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmPing.beforePingCounter, 1)
+	defer mm_atomic.AddUint64(&mmPing.afterPingCounter, 1)
+
+	if mmPing.inspectFuncPing != nil {
+		mmPing.inspectFuncPing(ctx)
+	}
+
+	mm_params := &DatabaseMockPingParams{ctx}
+
+	// Record call args
+	mmPing.PingMock.mutex.Lock()
+	mmPing.PingMock.callArgs = append(mmPing.PingMock.callArgs, mm_params)
+	mmPing.PingMock.mutex.Unlock()
+
+	for _, e := range mmPing.PingMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPing.PingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPing.PingMock.defaultExpectation.Counter, 1)
+		mm_want := mmPing.PingMock.defaultExpectation.params
+		mm_got := DatabaseMockPingParams{ctx}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPing.t.Errorf("DatabaseMock.Ping got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPing.PingMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPing.t.Fatal("No results are set for the DatabaseMock.Ping")
+		}
+		return (*mm_results).err
+	}
+	if mmPing.funcPing != nil {
+		return mmPing.funcPing(ctx)
+	}
+	mmPing.t.Fatalf("Unexpected call to DatabaseMock.Ping. %v", ctx)
+	return
+}
+
+// PingAfterCounter returns a count of finished DatabaseMock.Ping invocations
+func (mmPing *DatabaseMock) PingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPing.afterPingCounter)
+}
+
+// PingBeforeCounter returns a count of DatabaseMock.Ping invocations
+func (mmPing *DatabaseMock) PingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPing.beforePingCounter)
+}
+
+// Calls returns a list of arguments used in each call to DatabaseMock.Ping.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPing *mDatabaseMockPing) Calls() []*DatabaseMockPingParams {
+	mmPing.mutex.RLock()
+
+	argCopy := make([]*DatabaseMockPingParams, len(mmPing.callArgs))
+	copy(argCopy, mmPing.callArgs)
+
+	mmPing.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPingDone returns true if the count of the Ping invocations corresponds
+// the number of defined expectations
+func (m *DatabaseMock) MinimockPingDone() bool {
+	for _, e := range m.PingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PingMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPingCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPing != nil && mm_atomic.LoadUint64(&m.afterPingCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockPingInspect logs each unmet expectation
+func (m *DatabaseMock) MinimockPingInspect() {
+	for _, e := range m.PingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DatabaseMock.Ping with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PingMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPingCounter) < 1 {
+		if m.PingMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to DatabaseMock.Ping")
+		} else {
+			m.t.Errorf("Expected call to DatabaseMock.Ping with params: %#v", *m.PingMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPing != nil && mm_atomic.LoadUint64(&m.afterPingCounter) < 1 {
+		m.t.Error("Expected call to DatabaseMock.Ping")
+	}
+}
+
 type mDatabaseMockPrepareStatement struct {
 	mock               *DatabaseMock
 	defaultExpectation *DatabaseMockPrepareStatementExpectation
@@ -1121,45 +1596,407 @@ func (m *DatabaseMock) MinimockScanOneInspect() {
 	}
 }
 
-type mDatabaseMockTransaction struct {
+type mDatabaseMockScanOptional struct {
 	mock               *DatabaseMock
-	defaultExpectation *DatabaseMockTransactionExpectation
-	expectations       []*DatabaseMockTransactionExpectation
+	defaultExpectation *DatabaseMockScanOptionalExpectation
+	expectations       []*DatabaseMockScanOptionalExpectation
 
-	callArgs []*DatabaseMockTransactionParams
+	callArgs []*DatabaseMockScanOptionalParams
 	mutex    sync.RWMutex
 }
 
-// DatabaseMockTransactionExpectation specifies expectation struct of the Database.Transaction
-type DatabaseMockTransactionExpectation struct {
+// DatabaseMockScanOptionalExpectation specifies expectation struct of the Database.ScanOptional
+type DatabaseMockScanOptionalExpectation struct {
 	mock    *DatabaseMock
-	params  *DatabaseMockTransactionParams
-	results *DatabaseMockTransactionResults
+	params  *DatabaseMockScanOptionalParams
+	results *DatabaseMockScanOptionalResults
 	Counter uint64
 }
 
-// DatabaseMockTransactionParams contains parameters of the Database.Transaction
-type DatabaseMockTransactionParams struct {
-	ctx  context.Context
-	work func(mm_libsql.Transaction) error
+// DatabaseMockScanOptionalParams contains parameters of the Database.ScanOptional
+type DatabaseMockScanOptionalParams struct {
+	ctx     context.Context
+	scanner mm_libsql.RowScanner
+	sql     string
+	args    []interface{}
 }
 
-// DatabaseMockTransactionResults contains results of the Database.Transaction
-type DatabaseMockTransactionResults struct {
+// DatabaseMockScanOptionalResults contains results of the Database.ScanOptional
+type DatabaseMockScanOptionalResults struct {
+	b1  bool
 	err error
 }
 
-// Expect sets up expected params for Database.Transaction
-func (mmTransaction *mDatabaseMockTransaction) Expect(ctx context.Context, work func(mm_libsql.Transaction) error) *mDatabaseMockTransaction {
-	if mmTransaction.mock.funcTransaction != nil {
-		mmTransaction.mock.t.Fatalf("DatabaseMock.Transaction mock is already set by Set")
+// Expect sets up expected params for Database.ScanOptional
+func (mmScanOptional *mDatabaseMockScanOptional) Expect(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) *mDatabaseMockScanOptional {
+	if mmScanOptional.mock.funcScanOptional != nil {
+		mmScanOptional.mock.t.Fatalf("DatabaseMock.ScanOptional mock is already set by Set")
 	}
 
-	if mmTransaction.defaultExpectation == nil {
-		mmTransaction.defaultExpectation = &DatabaseMockTransactionExpectation{}
+	if mmScanOptional.defaultExpectation == nil {
+		mmScanOptional.defaultExpectation = &DatabaseMockScanOptionalExpectation{}
 	}
 
-	mmTransaction.defaultExpectation.params = &DatabaseMockTransactionParams{ctx, work}
+	mmScanOptional.defaultExpectation.params = &DatabaseMockScanOptionalParams{ctx, scanner, sql, args}
+	for _, e := range mmScanOptional.expectations {
+		if minimock.Equal(e.params, mmScanOptional.defaultExpectation.params) {
+			mmScanOptional.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmScanOptional.defaultExpectation.params)
+		}
+	}
+
+	return mmScanOptional
+}
+
+// Inspect accepts an inspector function that has same arguments as the Database.ScanOptional
+func (mmScanOptional *mDatabaseMockScanOptional) Inspect(f func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{})) *mDatabaseMockScanOptional {
+	if mmScanOptional.mock.inspectFuncScanOptional != nil {
+		mmScanOptional.mock.t.Fatalf("Inspect function is already set for DatabaseMock.ScanOptional")
+	}
+
+	mmScanOptional.mock.inspectFuncScanOptional = f
+
+	return mmScanOptional
+}
+
+// Return sets up results that will be returned by Database.ScanOptional
+func (mmScanOptional *mDatabaseMockScanOptional) Return(b1 bool, err error) *DatabaseMock {
+	if mmScanOptional.mock.funcScanOptional != nil {
+		mmScanOptional.mock.t.Fatalf("DatabaseMock.ScanOptional mock is already set by Set")
+	}
+
+	if mmScanOptional.defaultExpectation == nil {
+		mmScanOptional.defaultExpectation = &DatabaseMockScanOptionalExpectation{mock: mmScanOptional.mock}
+	}
+	mmScanOptional.defaultExpectation.results = &DatabaseMockScanOptionalResults{b1, err}
+	return mmScanOptional.mock
+}
+
+//Set uses given function f to mock the Database.ScanOptional method
+func (mmScanOptional *mDatabaseMockScanOptional) Set(f func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (b1 bool, err error)) *DatabaseMock {
+	if mmScanOptional.defaultExpectation != nil {
+		mmScanOptional.mock.t.Fatalf("Default expectation is already set for the Database.ScanOptional method")
+	}
+
+	if len(mmScanOptional.expectations) > 0 {
+		mmScanOptional.mock.t.Fatalf("Some expectations are already set for the Database.ScanOptional method")
+	}
+
+	mmScanOptional.mock.funcScanOptional = f
+	return mmScanOptional.mock
+}
+
+// When sets expectation for the Database.ScanOptional which will trigger the result defined by the following
+// Then helper
+func (mmScanOptional *mDatabaseMockScanOptional) When(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) *DatabaseMockScanOptionalExpectation {
+	if mmScanOptional.mock.funcScanOptional != nil {
+		mmScanOptional.mock.t.Fatalf("DatabaseMock.ScanOptional mock is already set by Set")
+	}
+
+	expectation := &DatabaseMockScanOptionalExpectation{
+		mock:   mmScanOptional.mock,
+		params: &DatabaseMockScanOptionalParams{ctx, scanner, sql, args},
+	}
+	mmScanOptional.expectations = append(mmScanOptional.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Database.ScanOptional return parameters for the expectation previously defined by the When method
+func (e *DatabaseMockScanOptionalExpectation) Then(b1 bool, err error) *DatabaseMock {
+	e.results = &DatabaseMockScanOptionalResults{b1, err}
+	return e.mock
+}
+
+// ScanOptional implements libsql.Database
+func (mmScanOptional *DatabaseMock) ScanOptional(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmScanOptional.beforeScanOptionalCounter, 1)
+	defer mm_atomic.AddUint64(&mmScanOptional.afterScanOptionalCounter, 1)
+
+	if mmScanOptional.inspectFuncScanOptional != nil {
+		mmScanOptional.inspectFuncScanOptional(ctx, scanner, sql, args...)
+	}
+
+	mm_params := &DatabaseMockScanOptionalParams{ctx, scanner, sql, args}
+
+	// Record call args
+	mmScanOptional.ScanOptionalMock.mutex.Lock()
+	mmScanOptional.ScanOptionalMock.callArgs = append(mmScanOptional.ScanOptionalMock.callArgs, mm_params)
+	mmScanOptional.ScanOptionalMock.mutex.Unlock()
+
+	for _, e := range mmScanOptional.ScanOptionalMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmScanOptional.ScanOptionalMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmScanOptional.ScanOptionalMock.defaultExpectation.Counter, 1)
+		mm_want := mmScanOptional.ScanOptionalMock.defaultExpectation.params
+		mm_got := DatabaseMockScanOptionalParams{ctx, scanner, sql, args}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmScanOptional.t.Errorf("DatabaseMock.ScanOptional got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmScanOptional.ScanOptionalMock.defaultExpectation.results
+		if mm_results == nil {
+			mmScanOptional.t.Fatal("No results are set for the DatabaseMock.ScanOptional")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmScanOptional.funcScanOptional != nil {
+		return mmScanOptional.funcScanOptional(ctx, scanner, sql, args...)
+	}
+	mmScanOptional.t.Fatalf("Unexpected call to DatabaseMock.ScanOptional. %v %v %v %v", ctx, scanner, sql, args)
+	return
+}
+
+// ScanOptionalAfterCounter returns a count of finished DatabaseMock.ScanOptional invocations
+func (mmScanOptional *DatabaseMock) ScanOptionalAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScanOptional.afterScanOptionalCounter)
+}
+
+// ScanOptionalBeforeCounter returns a count of DatabaseMock.ScanOptional invocations
+func (mmScanOptional *DatabaseMock) ScanOptionalBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScanOptional.beforeScanOptionalCounter)
+}
+
+// Calls returns a list of arguments used in each call to DatabaseMock.ScanOptional.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmScanOptional *mDatabaseMockScanOptional) Calls() []*DatabaseMockScanOptionalParams {
+	mmScanOptional.mutex.RLock()
+
+	argCopy := make([]*DatabaseMockScanOptionalParams, len(mmScanOptional.callArgs))
+	copy(argCopy, mmScanOptional.callArgs)
+
+	mmScanOptional.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockScanOptionalDone returns true if the count of the ScanOptional invocations corresponds
+// the number of defined expectations
+func (m *DatabaseMock) MinimockScanOptionalDone() bool {
+	for _, e := range m.ScanOptionalMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ScanOptionalMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterScanOptionalCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcScanOptional != nil && mm_atomic.LoadUint64(&m.afterScanOptionalCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockScanOptionalInspect logs each unmet expectation
+func (m *DatabaseMock) MinimockScanOptionalInspect() {
+	for _, e := range m.ScanOptionalMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DatabaseMock.ScanOptional with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ScanOptionalMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterScanOptionalCounter) < 1 {
+		if m.ScanOptionalMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to DatabaseMock.ScanOptional")
+		} else {
+			m.t.Errorf("Expected call to DatabaseMock.ScanOptional with params: %#v", *m.ScanOptionalMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcScanOptional != nil && mm_atomic.LoadUint64(&m.afterScanOptionalCounter) < 1 {
+		m.t.Error("Expected call to DatabaseMock.ScanOptional")
+	}
+}
+
+type mDatabaseMockStats struct {
+	mock               *DatabaseMock
+	defaultExpectation *DatabaseMockStatsExpectation
+	expectations       []*DatabaseMockStatsExpectation
+}
+
+// DatabaseMockStatsExpectation specifies expectation struct of the Database.Stats
+type DatabaseMockStatsExpectation struct {
+	mock *DatabaseMock
+
+	results *DatabaseMockStatsResults
+	Counter uint64
+}
+
+// DatabaseMockStatsResults contains results of the Database.Stats
+type DatabaseMockStatsResults struct {
+	d1 sql.DBStats
+}
+
+// Expect sets up expected params for Database.Stats
+func (mmStats *mDatabaseMockStats) Expect() *mDatabaseMockStats {
+	if mmStats.mock.funcStats != nil {
+		mmStats.mock.t.Fatalf("DatabaseMock.Stats mock is already set by Set")
+	}
+
+	if mmStats.defaultExpectation == nil {
+		mmStats.defaultExpectation = &DatabaseMockStatsExpectation{}
+	}
+
+	return mmStats
+}
+
+// Inspect accepts an inspector function that has same arguments as the Database.Stats
+func (mmStats *mDatabaseMockStats) Inspect(f func()) *mDatabaseMockStats {
+	if mmStats.mock.inspectFuncStats != nil {
+		mmStats.mock.t.Fatalf("Inspect function is already set for DatabaseMock.Stats")
+	}
+
+	mmStats.mock.inspectFuncStats = f
+
+	return mmStats
+}
+
+// Return sets up results that will be returned by Database.Stats
+func (mmStats *mDatabaseMockStats) Return(d1 sql.DBStats) *DatabaseMock {
+	if mmStats.mock.funcStats != nil {
+		mmStats.mock.t.Fatalf("DatabaseMock.Stats mock is already set by Set")
+	}
+
+	if mmStats.defaultExpectation == nil {
+		mmStats.defaultExpectation = &DatabaseMockStatsExpectation{mock: mmStats.mock}
+	}
+	mmStats.defaultExpectation.results = &DatabaseMockStatsResults{d1}
+	return mmStats.mock
+}
+
+//Set uses given function f to mock the Database.Stats method
+func (mmStats *mDatabaseMockStats) Set(f func() (d1 sql.DBStats)) *DatabaseMock {
+	if mmStats.defaultExpectation != nil {
+		mmStats.mock.t.Fatalf("Default expectation is already set for the Database.Stats method")
+	}
+
+	if len(mmStats.expectations) > 0 {
+		mmStats.mock.t.Fatalf("Some expectations are already set for the Database.Stats method")
+	}
+
+	mmStats.mock.funcStats = f
+	return mmStats.mock
+}
+
+// Stats implements libsql.Database
+func (mmStats *DatabaseMock) Stats() (d1 sql.DBStats) {
+	mm_atomic.AddUint64(&mmStats.beforeStatsCounter, 1)
+	defer mm_atomic.AddUint64(&mmStats.afterStatsCounter, 1)
+
+	if mmStats.inspectFuncStats != nil {
+		mmStats.inspectFuncStats()
+	}
+
+	if mmStats.StatsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmStats.StatsMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmStats.StatsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmStats.t.Fatal("No results are set for the DatabaseMock.Stats")
+		}
+		return (*mm_results).d1
+	}
+	if mmStats.funcStats != nil {
+		return mmStats.funcStats()
+	}
+	mmStats.t.Fatalf("Unexpected call to DatabaseMock.Stats.")
+	return
+}
+
+// StatsAfterCounter returns a count of finished DatabaseMock.Stats invocations
+func (mmStats *DatabaseMock) StatsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStats.afterStatsCounter)
+}
+
+// StatsBeforeCounter returns a count of DatabaseMock.Stats invocations
+func (mmStats *DatabaseMock) StatsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStats.beforeStatsCounter)
+}
+
+// MinimockStatsDone returns true if the count of the Stats invocations corresponds
+// the number of defined expectations
+func (m *DatabaseMock) MinimockStatsDone() bool {
+	for _, e := range m.StatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StatsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterStatsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStats != nil && mm_atomic.LoadUint64(&m.afterStatsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockStatsInspect logs each unmet expectation
+func (m *DatabaseMock) MinimockStatsInspect() {
+	for _, e := range m.StatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to DatabaseMock.Stats")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StatsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterStatsCounter) < 1 {
+		m.t.Error("Expected call to DatabaseMock.Stats")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStats != nil && mm_atomic.LoadUint64(&m.afterStatsCounter) < 1 {
+		m.t.Error("Expected call to DatabaseMock.Stats")
+	}
+}
+
+type mDatabaseMockTransaction struct {
+	mock               *DatabaseMock
+	defaultExpectation *DatabaseMockTransactionExpectation
+	expectations       []*DatabaseMockTransactionExpectation
+
+	callArgs []*DatabaseMockTransactionParams
+	mutex    sync.RWMutex
+}
+
+// DatabaseMockTransactionExpectation specifies expectation struct of the Database.Transaction
+type DatabaseMockTransactionExpectation struct {
+	mock    *DatabaseMock
+	params  *DatabaseMockTransactionParams
+	results *DatabaseMockTransactionResults
+	Counter uint64
+}
+
+// DatabaseMockTransactionParams contains parameters of the Database.Transaction
+type DatabaseMockTransactionParams struct {
+	ctx  context.Context
+	work func(mm_libsql.Transaction) error
+}
+
+// DatabaseMockTransactionResults contains results of the Database.Transaction
+type DatabaseMockTransactionResults struct {
+	err error
+}
+
+// Expect sets up expected params for Database.Transaction
+func (mmTransaction *mDatabaseMockTransaction) Expect(ctx context.Context, work func(mm_libsql.Transaction) error) *mDatabaseMockTransaction {
+	if mmTransaction.mock.funcTransaction != nil {
+		mmTransaction.mock.t.Fatalf("DatabaseMock.Transaction mock is already set by Set")
+	}
+
+	if mmTransaction.defaultExpectation == nil {
+		mmTransaction.defaultExpectation = &DatabaseMockTransactionExpectation{}
+	}
+
+	mmTransaction.defaultExpectation.params = &DatabaseMockTransactionParams{ctx, work}
 	for _, e := range mmTransaction.expectations {
 		if minimock.Equal(e.params, mmTransaction.defaultExpectation.params) {
 			mmTransaction.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTransaction.defaultExpectation.params)
@@ -1991,11 +2828,234 @@ func (m *DatabaseMock) MinimockUpdateAndGetRowsAffectedInspect() {
 	}
 }
 
+type mDatabaseMockUpdateReturning struct {
+	mock               *DatabaseMock
+	defaultExpectation *DatabaseMockUpdateReturningExpectation
+	expectations       []*DatabaseMockUpdateReturningExpectation
+
+	callArgs []*DatabaseMockUpdateReturningParams
+	mutex    sync.RWMutex
+}
+
+// DatabaseMockUpdateReturningExpectation specifies expectation struct of the Database.UpdateReturning
+type DatabaseMockUpdateReturningExpectation struct {
+	mock    *DatabaseMock
+	params  *DatabaseMockUpdateReturningParams
+	results *DatabaseMockUpdateReturningResults
+	Counter uint64
+}
+
+// DatabaseMockUpdateReturningParams contains parameters of the Database.UpdateReturning
+type DatabaseMockUpdateReturningParams struct {
+	ctx     context.Context
+	scanner mm_libsql.RowScanner
+	sql     string
+	args    []interface{}
+}
+
+// DatabaseMockUpdateReturningResults contains results of the Database.UpdateReturning
+type DatabaseMockUpdateReturningResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for Database.UpdateReturning
+func (mmUpdateReturning *mDatabaseMockUpdateReturning) Expect(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) *mDatabaseMockUpdateReturning {
+	if mmUpdateReturning.mock.funcUpdateReturning != nil {
+		mmUpdateReturning.mock.t.Fatalf("DatabaseMock.UpdateReturning mock is already set by Set")
+	}
+
+	if mmUpdateReturning.defaultExpectation == nil {
+		mmUpdateReturning.defaultExpectation = &DatabaseMockUpdateReturningExpectation{}
+	}
+
+	mmUpdateReturning.defaultExpectation.params = &DatabaseMockUpdateReturningParams{ctx, scanner, sql, args}
+	for _, e := range mmUpdateReturning.expectations {
+		if minimock.Equal(e.params, mmUpdateReturning.defaultExpectation.params) {
+			mmUpdateReturning.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateReturning.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateReturning
+}
+
+// Inspect accepts an inspector function that has same arguments as the Database.UpdateReturning
+func (mmUpdateReturning *mDatabaseMockUpdateReturning) Inspect(f func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{})) *mDatabaseMockUpdateReturning {
+	if mmUpdateReturning.mock.inspectFuncUpdateReturning != nil {
+		mmUpdateReturning.mock.t.Fatalf("Inspect function is already set for DatabaseMock.UpdateReturning")
+	}
+
+	mmUpdateReturning.mock.inspectFuncUpdateReturning = f
+
+	return mmUpdateReturning
+}
+
+// Return sets up results that will be returned by Database.UpdateReturning
+func (mmUpdateReturning *mDatabaseMockUpdateReturning) Return(i1 int64, err error) *DatabaseMock {
+	if mmUpdateReturning.mock.funcUpdateReturning != nil {
+		mmUpdateReturning.mock.t.Fatalf("DatabaseMock.UpdateReturning mock is already set by Set")
+	}
+
+	if mmUpdateReturning.defaultExpectation == nil {
+		mmUpdateReturning.defaultExpectation = &DatabaseMockUpdateReturningExpectation{mock: mmUpdateReturning.mock}
+	}
+	mmUpdateReturning.defaultExpectation.results = &DatabaseMockUpdateReturningResults{i1, err}
+	return mmUpdateReturning.mock
+}
+
+//Set uses given function f to mock the Database.UpdateReturning method
+func (mmUpdateReturning *mDatabaseMockUpdateReturning) Set(f func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (i1 int64, err error)) *DatabaseMock {
+	if mmUpdateReturning.defaultExpectation != nil {
+		mmUpdateReturning.mock.t.Fatalf("Default expectation is already set for the Database.UpdateReturning method")
+	}
+
+	if len(mmUpdateReturning.expectations) > 0 {
+		mmUpdateReturning.mock.t.Fatalf("Some expectations are already set for the Database.UpdateReturning method")
+	}
+
+	mmUpdateReturning.mock.funcUpdateReturning = f
+	return mmUpdateReturning.mock
+}
+
+// When sets expectation for the Database.UpdateReturning which will trigger the result defined by the following
+// Then helper
+func (mmUpdateReturning *mDatabaseMockUpdateReturning) When(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) *DatabaseMockUpdateReturningExpectation {
+	if mmUpdateReturning.mock.funcUpdateReturning != nil {
+		mmUpdateReturning.mock.t.Fatalf("DatabaseMock.UpdateReturning mock is already set by Set")
+	}
+
+	expectation := &DatabaseMockUpdateReturningExpectation{
+		mock:   mmUpdateReturning.mock,
+		params: &DatabaseMockUpdateReturningParams{ctx, scanner, sql, args},
+	}
+	mmUpdateReturning.expectations = append(mmUpdateReturning.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Database.UpdateReturning return parameters for the expectation previously defined by the When method
+func (e *DatabaseMockUpdateReturningExpectation) Then(i1 int64, err error) *DatabaseMock {
+	e.results = &DatabaseMockUpdateReturningResults{i1, err}
+	return e.mock
+}
+
+// UpdateReturning implements libsql.Database
+func (mmUpdateReturning *DatabaseMock) UpdateReturning(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmUpdateReturning.beforeUpdateReturningCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateReturning.afterUpdateReturningCounter, 1)
+
+	if mmUpdateReturning.inspectFuncUpdateReturning != nil {
+		mmUpdateReturning.inspectFuncUpdateReturning(ctx, scanner, sql, args...)
+	}
+
+	mm_params := &DatabaseMockUpdateReturningParams{ctx, scanner, sql, args}
+
+	// Record call args
+	mmUpdateReturning.UpdateReturningMock.mutex.Lock()
+	mmUpdateReturning.UpdateReturningMock.callArgs = append(mmUpdateReturning.UpdateReturningMock.callArgs, mm_params)
+	mmUpdateReturning.UpdateReturningMock.mutex.Unlock()
+
+	for _, e := range mmUpdateReturning.UpdateReturningMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmUpdateReturning.UpdateReturningMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateReturning.UpdateReturningMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateReturning.UpdateReturningMock.defaultExpectation.params
+		mm_got := DatabaseMockUpdateReturningParams{ctx, scanner, sql, args}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateReturning.t.Errorf("DatabaseMock.UpdateReturning got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateReturning.UpdateReturningMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateReturning.t.Fatal("No results are set for the DatabaseMock.UpdateReturning")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmUpdateReturning.funcUpdateReturning != nil {
+		return mmUpdateReturning.funcUpdateReturning(ctx, scanner, sql, args...)
+	}
+	mmUpdateReturning.t.Fatalf("Unexpected call to DatabaseMock.UpdateReturning. %v %v %v %v", ctx, scanner, sql, args)
+	return
+}
+
+// UpdateReturningAfterCounter returns a count of finished DatabaseMock.UpdateReturning invocations
+func (mmUpdateReturning *DatabaseMock) UpdateReturningAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateReturning.afterUpdateReturningCounter)
+}
+
+// UpdateReturningBeforeCounter returns a count of DatabaseMock.UpdateReturning invocations
+func (mmUpdateReturning *DatabaseMock) UpdateReturningBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateReturning.beforeUpdateReturningCounter)
+}
+
+// Calls returns a list of arguments used in each call to DatabaseMock.UpdateReturning.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateReturning *mDatabaseMockUpdateReturning) Calls() []*DatabaseMockUpdateReturningParams {
+	mmUpdateReturning.mutex.RLock()
+
+	argCopy := make([]*DatabaseMockUpdateReturningParams, len(mmUpdateReturning.callArgs))
+	copy(argCopy, mmUpdateReturning.callArgs)
+
+	mmUpdateReturning.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateReturningDone returns true if the count of the UpdateReturning invocations corresponds
+// the number of defined expectations
+func (m *DatabaseMock) MinimockUpdateReturningDone() bool {
+	for _, e := range m.UpdateReturningMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateReturningMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateReturningCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateReturning != nil && mm_atomic.LoadUint64(&m.afterUpdateReturningCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockUpdateReturningInspect logs each unmet expectation
+func (m *DatabaseMock) MinimockUpdateReturningInspect() {
+	for _, e := range m.UpdateReturningMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to DatabaseMock.UpdateReturning with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateReturningMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateReturningCounter) < 1 {
+		if m.UpdateReturningMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to DatabaseMock.UpdateReturning")
+		} else {
+			m.t.Errorf("Expected call to DatabaseMock.UpdateReturning with params: %#v", *m.UpdateReturningMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateReturning != nil && mm_atomic.LoadUint64(&m.afterUpdateReturningCounter) < 1 {
+		m.t.Error("Expected call to DatabaseMock.UpdateReturning")
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *DatabaseMock) MinimockFinish() {
 	if !m.minimockDone() {
 		m.MinimockCloseInspect()
 
+		m.MinimockConnInspect()
+
+		m.MinimockPingInspect()
+
 		m.MinimockPrepareStatementInspect()
 
 		m.MinimockPreparedInspect()
@@ -2004,6 +3064,10 @@ func (m *DatabaseMock) MinimockFinish() {
 
 		m.MinimockScanOneInspect()
 
+		m.MinimockScanOptionalInspect()
+
+		m.MinimockStatsInspect()
+
 		m.MinimockTransactionInspect()
 
 		m.MinimockUpdateInspect()
@@ -2011,6 +3075,8 @@ func (m *DatabaseMock) MinimockFinish() {
 		m.MinimockUpdateAndGetLastInsertIDInspect()
 
 		m.MinimockUpdateAndGetRowsAffectedInspect()
+
+		m.MinimockUpdateReturningInspect()
 		m.t.FailNow()
 	}
 }
@@ -2035,12 +3101,17 @@ func (m *DatabaseMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockCloseDone() &&
+		m.MinimockConnDone() &&
+		m.MinimockPingDone() &&
 		m.MinimockPrepareStatementDone() &&
 		m.MinimockPreparedDone() &&
 		m.MinimockScanDone() &&
 		m.MinimockScanOneDone() &&
+		m.MinimockScanOptionalDone() &&
+		m.MinimockStatsDone() &&
 		m.MinimockTransactionDone() &&
 		m.MinimockUpdateDone() &&
 		m.MinimockUpdateAndGetLastInsertIDDone() &&
-		m.MinimockUpdateAndGetRowsAffectedDone()
+		m.MinimockUpdateAndGetRowsAffectedDone() &&
+		m.MinimockUpdateReturningDone()
 }
@@ -0,0 +1,365 @@
+package libsqltest
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"oss.indeed.com/go/libsql"
+)
+
+// FakeDatabase is a libsql.Database running the work of transactions with
+// recording Transactions, committing them when the work succeeds and rolling
+// them back otherwise, like a Database wrapping an actual *sql.DB.
+//
+// The scans and updates are performed by the Queryer of the FakeDatabase,
+// e.g. a QueryerMock set with ScanReturns, with the SQL of Statements for the
+// ones of Prepared and PrepareStatement. They are logged along with the
+// transaction they were performed in, so tests can assert which ones were
+// performed together and whether they were committed.
+// FakeDatabase is safe for concurrent use.
+type FakeDatabase struct {
+	fakeQueryer
+
+	queryer libsql.Queryer
+
+	mu           sync.Mutex
+	statements   []FakeStatement
+	transactions []*FakeTransaction
+}
+
+var _ libsql.Database = (*FakeDatabase)(nil)
+
+// NewFakeDatabase returns a FakeDatabase performing its scans and updates with queryer.
+// With a nil queryer scans return no rows and updates affect no rows
+func NewFakeDatabase(queryer libsql.Queryer) *FakeDatabase {
+	if queryer == nil {
+		queryer = noRowsQueryer{}
+	}
+	f := &FakeDatabase{queryer: queryer}
+	f.fakeQueryer = fakeQueryer{db: f}
+	return f
+}
+
+// FakeStatement is a scan or update performed through a FakeDatabase
+type FakeStatement struct {
+	// Kind is OperationScan, OperationScanOne or OperationUpdate
+	Kind libsql.OperationKind
+	SQL  string
+	Args []interface{}
+	// Transaction is the Number of the transaction the statement was performed in,
+	// 0 if it was performed outside of transactions
+	Transaction int
+	// Err is the error of the statement
+	Err error
+}
+
+// TransactionOutcome is the outcome of a transaction of a FakeDatabase
+type TransactionOutcome int
+
+const (
+	// TransactionOpen is the outcome of a transaction whose work is running
+	TransactionOpen TransactionOutcome = iota
+	// TransactionCommitted is the outcome of a transaction whose work succeeded
+	TransactionCommitted
+	// TransactionRolledBack is the outcome of a transaction whose work failed or panicked
+	TransactionRolledBack
+)
+
+var transactionOutcomeNames = map[TransactionOutcome]string{
+	TransactionOpen:       "open",
+	TransactionCommitted:  "committed",
+	TransactionRolledBack: "rolled back",
+}
+
+// String implements fmt.Stringer
+func (o TransactionOutcome) String() string {
+	return transactionOutcomeNames[o]
+}
+
+// FakeTransaction is a transaction run by a FakeDatabase
+type FakeTransaction struct {
+	// Number is the position of the transaction among the ones of the FakeDatabase, starting from 1
+	Number  int
+	Outcome TransactionOutcome
+	// Statements are the statements performed in the transaction, in order
+	Statements []FakeStatement
+}
+
+// Statements returns all the statements performed through the FakeDatabase, in order
+func (f *FakeDatabase) Statements() []FakeStatement {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FakeStatement(nil), f.statements...)
+}
+
+// Transactions returns the transactions run by the FakeDatabase, in order
+func (f *FakeDatabase) Transactions() []FakeTransaction {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	transactions := make([]FakeTransaction, len(f.transactions))
+	for i, tx := range f.transactions {
+		transactions[i] = *tx
+		transactions[i].Statements = append([]FakeStatement(nil), tx.Statements...)
+	}
+	return transactions
+}
+
+// Transaction implements Database.Transaction
+func (f *FakeDatabase) Transaction(_ context.Context, work func(libsql.Transaction) error) error {
+	tx := f.begin()
+	defer f.finish(tx, TransactionRolledBack)
+	if err := work(fakeQueryer{db: f, tx: tx}); err != nil {
+		return err
+	}
+	f.finish(tx, TransactionCommitted)
+	return nil
+}
+
+// Conn implements Database.Conn, running work with the FakeDatabase itself
+func (f *FakeDatabase) Conn(_ context.Context, work func(libsql.Connection) error) error {
+	return work(f)
+}
+
+// PrepareStatement implements Database.PrepareStatement
+func (f *FakeDatabase) PrepareStatement(_ context.Context, sql string) (libsql.PreparedStatement, error) {
+	return fakePreparedStatement{fakeStatement{q: f.fakeQueryer, sql: sql}}, nil
+}
+
+// Ping implements Database.Ping
+func (f *FakeDatabase) Ping(context.Context) error {
+	return nil
+}
+
+// Stats implements Database.Stats
+func (f *FakeDatabase) Stats() sql.DBStats {
+	return sql.DBStats{}
+}
+
+// Close implements io.Closer
+func (f *FakeDatabase) Close() error {
+	return nil
+}
+
+func (f *FakeDatabase) begin() *FakeTransaction {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	tx := &FakeTransaction{Number: len(f.transactions) + 1}
+	f.transactions = append(f.transactions, tx)
+	return tx
+}
+
+// finish sets the outcome of tx unless it is already finished
+func (f *FakeDatabase) finish(tx *FakeTransaction, outcome TransactionOutcome) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if tx.Outcome == TransactionOpen {
+		tx.Outcome = outcome
+	}
+}
+
+// fakeQueryer performs the statements of a FakeDatabase, in the transaction tx if not nil
+type fakeQueryer struct {
+	db *FakeDatabase
+	tx *FakeTransaction
+}
+
+var _ libsql.Transaction = fakeQueryer{}
+
+// done returns sql.ErrTxDone if the statements are performed in a finished transaction
+func (q fakeQueryer) done() error {
+	if q.tx == nil {
+		return nil
+	}
+	q.db.mu.Lock()
+	defer q.db.mu.Unlock()
+	if q.tx.Outcome != TransactionOpen {
+		return sql.ErrTxDone
+	}
+	return nil
+}
+
+// perform performs a statement with the Queryer of the FakeDatabase and logs it
+func (q fakeQueryer) perform(kind libsql.OperationKind, sql string, args []interface{}, statement func(libsql.Queryer) error) error {
+	if err := q.done(); err != nil {
+		return err
+	}
+	err := statement(q.db.queryer)
+
+	s := FakeStatement{Kind: kind, SQL: sql, Args: args, Err: err}
+	q.db.mu.Lock()
+	defer q.db.mu.Unlock()
+	if q.tx != nil {
+		s.Transaction = q.tx.Number
+		q.tx.Statements = append(q.tx.Statements, s)
+	}
+	q.db.statements = append(q.db.statements, s)
+	return err
+}
+
+// Scan implements Queryer.Scan
+func (q fakeQueryer) Scan(ctx context.Context, scanner libsql.RowScanner, sql string, args ...interface{}) error {
+	return q.perform(libsql.OperationScan, sql, args, func(queryer libsql.Queryer) error {
+		return queryer.Scan(ctx, scanner, sql, args...)
+	})
+}
+
+// ScanOne implements Queryer.ScanOne
+func (q fakeQueryer) ScanOne(ctx context.Context, scanner libsql.RowScanner, sql string, args ...interface{}) error {
+	return q.perform(libsql.OperationScanOne, sql, args, func(queryer libsql.Queryer) error {
+		return queryer.ScanOne(ctx, scanner, sql, args...)
+	})
+}
+
+// ScanOptional implements Queryer.ScanOptional
+func (q fakeQueryer) ScanOptional(ctx context.Context, scanner libsql.RowScanner, sql string, args ...interface{}) (bool, error) {
+	var ok bool
+	err := q.perform(libsql.OperationScanOne, sql, args, func(queryer libsql.Queryer) error {
+		var err error
+		ok, err = queryer.ScanOptional(ctx, scanner, sql, args...)
+		return err
+	})
+	return ok, err
+}
+
+// Update implements Queryer.Update
+func (q fakeQueryer) Update(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	var result sql.Result
+	err := q.perform(libsql.OperationUpdate, query, args, func(queryer libsql.Queryer) error {
+		var err error
+		result, err = queryer.Update(ctx, query, args...)
+		return err
+	})
+	return result, err
+}
+
+// UpdateAndGetRowsAffected implements Queryer.UpdateAndGetRowsAffected
+func (q fakeQueryer) UpdateAndGetRowsAffected(ctx context.Context, sql string, args ...interface{}) (int64, error) {
+	var rowsAffected int64
+	err := q.perform(libsql.OperationUpdate, sql, args, func(queryer libsql.Queryer) error {
+		var err error
+		rowsAffected, err = queryer.UpdateAndGetRowsAffected(ctx, sql, args...)
+		return err
+	})
+	return rowsAffected, err
+}
+
+// UpdateAndGetLastInsertID implements Queryer.UpdateAndGetLastInsertID
+func (q fakeQueryer) UpdateAndGetLastInsertID(ctx context.Context, sql string, args ...interface{}) (int64, error) {
+	var lastInsertID int64
+	err := q.perform(libsql.OperationUpdate, sql, args, func(queryer libsql.Queryer) error {
+		var err error
+		lastInsertID, err = queryer.UpdateAndGetLastInsertID(ctx, sql, args...)
+		return err
+	})
+	return lastInsertID, err
+}
+
+// UpdateReturning implements Queryer.UpdateReturning
+func (q fakeQueryer) UpdateReturning(ctx context.Context, scanner libsql.RowScanner, sql string, args ...interface{}) (int64, error) {
+	var rowsAffected int64
+	err := q.perform(libsql.OperationUpdate, sql, args, func(queryer libsql.Queryer) error {
+		var err error
+		rowsAffected, err = queryer.UpdateReturning(ctx, scanner, sql, args...)
+		return err
+	})
+	return rowsAffected, err
+}
+
+// Prepared implements Preparer.Prepared
+func (q fakeQueryer) Prepared(_ context.Context, sql string, work func(libsql.Statement) error) error {
+	if err := q.done(); err != nil {
+		return err
+	}
+	return work(fakeStatement{q: q, sql: sql})
+}
+
+// fakeStatement performs the statements of a Statement of a FakeDatabase with its SQL
+type fakeStatement struct {
+	q   fakeQueryer
+	sql string
+}
+
+var _ libsql.Statement = fakeStatement{}
+
+// Scan implements Statement.Scan
+func (s fakeStatement) Scan(ctx context.Context, scanner libsql.RowScanner, args ...interface{}) error {
+	return s.q.Scan(ctx, scanner, s.sql, args...)
+}
+
+// ScanOne implements Statement.ScanOne
+func (s fakeStatement) ScanOne(ctx context.Context, scanner libsql.RowScanner, args ...interface{}) error {
+	return s.q.ScanOne(ctx, scanner, s.sql, args...)
+}
+
+// ScanOptional implements Statement.ScanOptional
+func (s fakeStatement) ScanOptional(ctx context.Context, scanner libsql.RowScanner, args ...interface{}) (bool, error) {
+	return s.q.ScanOptional(ctx, scanner, s.sql, args...)
+}
+
+// Update implements Statement.Update
+func (s fakeStatement) Update(ctx context.Context, args ...interface{}) (sql.Result, error) {
+	return s.q.Update(ctx, s.sql, args...)
+}
+
+// UpdateAndGetRowsAffected implements Statement.UpdateAndGetRowsAffected
+func (s fakeStatement) UpdateAndGetRowsAffected(ctx context.Context, args ...interface{}) (int64, error) {
+	return s.q.UpdateAndGetRowsAffected(ctx, s.sql, args...)
+}
+
+// UpdateAndGetLastInsertID implements Statement.UpdateAndGetLastInsertID
+func (s fakeStatement) UpdateAndGetLastInsertID(ctx context.Context, args ...interface{}) (int64, error) {
+	return s.q.UpdateAndGetLastInsertID(ctx, s.sql, args...)
+}
+
+// UpdateReturning implements Statement.UpdateReturning
+func (s fakeStatement) UpdateReturning(ctx context.Context, scanner libsql.RowScanner, args ...interface{}) (int64, error) {
+	return s.q.UpdateReturning(ctx, scanner, s.sql, args...)
+}
+
+type fakePreparedStatement struct {
+	fakeStatement
+}
+
+// Close implements io.Closer
+func (fakePreparedStatement) Close() error {
+	return nil
+}
+
+// noRowsQueryer is the Queryer of FakeDatabases created without one
+type noRowsQueryer struct{}
+
+// Scan implements Queryer.Scan
+func (noRowsQueryer) Scan(context.Context, libsql.RowScanner, string, ...interface{}) error {
+	return nil
+}
+
+// ScanOne implements Queryer.ScanOne
+func (noRowsQueryer) ScanOne(context.Context, libsql.RowScanner, string, ...interface{}) error {
+	return libsql.ErrNoRows
+}
+
+// ScanOptional implements Queryer.ScanOptional
+func (noRowsQueryer) ScanOptional(context.Context, libsql.RowScanner, string, ...interface{}) (bool, error) {
+	return false, nil
+}
+
+// Update implements Queryer.Update
+func (noRowsQueryer) Update(context.Context, string, ...interface{}) (sql.Result, error) {
+	return NewResult(0, 0), nil
+}
+
+// UpdateAndGetRowsAffected implements Queryer.UpdateAndGetRowsAffected
+func (noRowsQueryer) UpdateAndGetRowsAffected(context.Context, string, ...interface{}) (int64, error) {
+	return 0, nil
+}
+
+// UpdateAndGetLastInsertID implements Queryer.UpdateAndGetLastInsertID
+func (noRowsQueryer) UpdateAndGetLastInsertID(context.Context, string, ...interface{}) (int64, error) {
+	return 0, nil
+}
+
+// UpdateReturning implements Queryer.UpdateReturning
+func (noRowsQueryer) UpdateReturning(context.Context, libsql.RowScanner, string, ...interface{}) (int64, error) {
+	return 0, nil
+}
@@ -0,0 +1,122 @@
+package libsqltest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"oss.indeed.com/go/libsql"
+)
+
+func Test_FakeDatabase_Transactions(t *testing.T) {
+	ctx := context.Background()
+	db := NewFakeDatabase(nil)
+	expErr := errors.New("a-test-error")
+
+	var leaked libsql.Transaction
+	err := db.Transaction(ctx, func(tx libsql.Transaction) error {
+		leaked = tx
+		if _, err := tx.Update(ctx, "INSERT INTO elephants (name) VALUES (?)", "Dumbo"); err != nil {
+			return err
+		}
+		_, err := tx.Update(ctx, "UPDATE herds SET size = size + 1 WHERE id = ?", 1)
+		return err
+	})
+	require.NoError(t, err)
+
+	err = db.Transaction(ctx, func(tx libsql.Transaction) error {
+		_, err := tx.Update(ctx, "DELETE FROM elephants WHERE name = ?", "Babar")
+		require.NoError(t, err)
+		return expErr
+	})
+	require.Equal(t, expErr, err)
+
+	require.Panics(t, func() {
+		_ = db.Transaction(ctx, func(libsql.Transaction) error {
+			panic("a-test-panic")
+		})
+	})
+
+	_, err = db.Update(ctx, "UPDATE herds SET size = 0")
+	require.NoError(t, err)
+	_, err = leaked.Update(ctx, "UPDATE herds SET size = 0")
+	require.Equal(t, sql.ErrTxDone, err)
+
+	require.Equal(t, []FakeTransaction{
+		{Number: 1, Outcome: TransactionCommitted, Statements: []FakeStatement{
+			{Kind: libsql.OperationUpdate, SQL: "INSERT INTO elephants (name) VALUES (?)", Args: []interface{}{"Dumbo"}, Transaction: 1},
+			{Kind: libsql.OperationUpdate, SQL: "UPDATE herds SET size = size + 1 WHERE id = ?", Args: []interface{}{1}, Transaction: 1},
+		}},
+		{Number: 2, Outcome: TransactionRolledBack, Statements: []FakeStatement{
+			{Kind: libsql.OperationUpdate, SQL: "DELETE FROM elephants WHERE name = ?", Args: []interface{}{"Babar"}, Transaction: 2},
+		}},
+		{Number: 3, Outcome: TransactionRolledBack},
+	}, db.Transactions())
+
+	statements := db.Statements()
+	require.Len(t, statements, 4)
+	require.Equal(t, 0, statements[3].Transaction)
+	require.Equal(t, "rolled back", TransactionRolledBack.String())
+}
+
+func Test_FakeDatabase_NestedPrepared(t *testing.T) {
+	ctx := context.Background()
+
+	queryer := NewQueryerMock(t)
+	defer queryer.MinimockFinish()
+	queryer.ScanOneMock.Set(ScanOneReturns(t, 7).ForSQL("SELECT id FROM herds WHERE name = ?").Queryer())
+	queryer.UpdateAndGetRowsAffectedMock.Return(1, nil)
+
+	db := NewFakeDatabase(queryer)
+	err := db.Transaction(ctx, func(tx libsql.Transaction) error {
+		return tx.Prepared(ctx, "SELECT id FROM herds WHERE name = ?", func(herds libsql.Statement) error {
+			return tx.Prepared(ctx, "UPDATE elephants SET herd = ? WHERE name = ?", func(elephants libsql.Statement) error {
+				var herd int
+				if err := herds.ScanOne(ctx, libsql.Into(&herd), "Savanna"); err != nil {
+					return err
+				}
+				_, err := elephants.UpdateAndGetRowsAffected(ctx, herd, "Dumbo")
+				return err
+			})
+		})
+	})
+	require.NoError(t, err)
+
+	transactions := db.Transactions()
+	require.Len(t, transactions, 1)
+	require.Equal(t, TransactionCommitted, transactions[0].Outcome)
+	require.Equal(t, []FakeStatement{
+		{Kind: libsql.OperationScanOne, SQL: "SELECT id FROM herds WHERE name = ?", Args: []interface{}{"Savanna"}, Transaction: 1},
+		{Kind: libsql.OperationUpdate, SQL: "UPDATE elephants SET herd = ? WHERE name = ?", Args: []interface{}{7, "Dumbo"}, Transaction: 1},
+	}, transactions[0].Statements)
+}
+
+func Test_FakeDatabase_NoRows(t *testing.T) {
+	ctx := context.Background()
+	db := NewFakeDatabase(nil)
+
+	var name string
+	err := db.ScanOne(ctx, libsql.Into(&name), "SELECT name FROM elephants")
+	require.ErrorIs(t, err, libsql.ErrNoRows)
+
+	err = db.Conn(ctx, func(conn libsql.Connection) error {
+		ok, err := conn.ScanOptional(ctx, libsql.Into(&name), "SELECT name FROM elephants")
+		require.False(t, ok)
+		return err
+	})
+	require.NoError(t, err)
+
+	stmt, err := db.PrepareStatement(ctx, "SELECT name FROM elephants WHERE id = ?")
+	require.NoError(t, err)
+	require.NoError(t, stmt.Scan(ctx, libsql.Into(&name), 1))
+	require.NoError(t, stmt.Close())
+
+	require.Equal(t, []FakeStatement{
+		{Kind: libsql.OperationScanOne, SQL: "SELECT name FROM elephants", Err: libsql.ErrNoRows},
+		{Kind: libsql.OperationScanOne, SQL: "SELECT name FROM elephants"},
+		{Kind: libsql.OperationScan, SQL: "SELECT name FROM elephants WHERE id = ?", Args: []interface{}{1}},
+	}, db.Statements())
+}
@@ -0,0 +1,553 @@
+// Package libsqltest provides mocks and fakes for testing code using libsql.
+package libsqltest
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Tester is the subset of testing.TB used by the fakes of libsqltest
+type Tester interface {
+	Cleanup(func())
+	Errorf(format string, args ...interface{})
+	Fatalf(format string, args ...interface{})
+	Helper()
+}
+
+// FakeDB is an in-memory database/sql driver performing the operations
+// expected with its Expect methods, in order.
+//
+// The *sql.DB returned by DB goes through the code paths of database/sql,
+// including the conversion of arguments and of scanned values, so it can be
+// wrapped with libsql.Wrap to test code using a libsql.Database end to end.
+// Unexpected operations fail with an error describing the mismatch, which
+// also fails the test.
+// FakeDB is safe for concurrent use.
+type FakeDB struct {
+	t  Tester
+	db *sql.DB
+
+	mu           sync.Mutex
+	expectations []*Expectation
+	unexpected   []string
+}
+
+// NewFakeDB returns a FakeDB verifying that all its expectations were met,
+// and closing its *sql.DB, at the end of the test
+func NewFakeDB(t Tester) *FakeDB {
+	f := &FakeDB{t: t}
+	f.db = sql.OpenDB(fakeConnector{db: f})
+	t.Cleanup(func() {
+		t.Helper()
+		// the unexpected operations already failed the test
+		if err := f.unmetExpectations(); err != nil {
+			t.Errorf("%v", err)
+		}
+		_ = f.db.Close()
+	})
+	return f
+}
+
+// DB returns the *sql.DB performing the operations through the fake driver
+func (f *FakeDB) DB() *sql.DB {
+	return f.db
+}
+
+// ExpectQuery expects a query matching the sqlRegexp regular expression.
+// It returns no rows unless set with Expectation.WillReturnRows
+func (f *FakeDB) ExpectQuery(sqlRegexp string) *Expectation {
+	return f.expect(expectQuery, sqlRegexp)
+}
+
+// ExpectExec expects an insert, update or delete matching the sqlRegexp regular expression.
+// It affects no rows unless set with Expectation.WillReturnResult
+func (f *FakeDB) ExpectExec(sqlRegexp string) *Expectation {
+	return f.expect(expectExec, sqlRegexp)
+}
+
+// ExpectPrepare expects the preparation of a statement matching the sqlRegexp regular expression.
+// The queries and updates executing the statement are expected with ExpectQuery and ExpectExec
+func (f *FakeDB) ExpectPrepare(sqlRegexp string) *Expectation {
+	return f.expect(expectPrepare, sqlRegexp)
+}
+
+// ExpectBegin expects the beginning of a transaction
+func (f *FakeDB) ExpectBegin() *Expectation {
+	return f.expect(expectBegin, "")
+}
+
+// ExpectCommit expects the commit of a transaction
+func (f *FakeDB) ExpectCommit() *Expectation {
+	return f.expect(expectCommit, "")
+}
+
+// ExpectRollback expects the rollback of a transaction
+func (f *FakeDB) ExpectRollback() *Expectation {
+	return f.expect(expectRollback, "")
+}
+
+func (f *FakeDB) expect(kind expectationKind, sqlRegexp string) *Expectation {
+	e := &Expectation{kind: kind}
+	if sqlRegexp != "" {
+		e.sql = regexp.MustCompile(sqlRegexp)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.expectations = append(f.expectations, e)
+	return e
+}
+
+// ExpectationsWereMet returns an error listing the expected operations which
+// were not performed and the unexpected operations which were
+func (f *FakeDB) ExpectationsWereMet() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var problems []string
+	if len(f.unexpected) > 0 {
+		problems = append(problems, "libsqltest: unexpected operations were performed:\n\t"+strings.Join(f.unexpected, "\n\t"))
+	}
+	if unmet := f.unmetLocked(); len(unmet) > 0 {
+		problems = append(problems, "libsqltest: expected operations were not performed:\n\t"+strings.Join(unmet, "\n\t"))
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(problems, "\n"))
+}
+
+// unmetExpectations returns an error listing the expected operations which were not performed
+func (f *FakeDB) unmetExpectations() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	unmet := f.unmetLocked()
+	if len(unmet) == 0 {
+		return nil
+	}
+	return fmt.Errorf("libsqltest: expected operations were not performed:\n\t%s", strings.Join(unmet, "\n\t"))
+}
+
+func (f *FakeDB) unmetLocked() []string {
+	var unmet []string
+	for _, e := range f.expectations {
+		if !e.met {
+			unmet = append(unmet, e.String())
+		}
+	}
+	return unmet
+}
+
+// perform meets the next expectation with an operation, returning an error
+// and failing the test if it does not match
+func (f *FakeDB) perform(kind expectationKind, query string, args []driver.NamedValue) (*Expectation, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	performed := describeOperation(kind, query, args)
+	for _, e := range f.expectations {
+		if e.met && !e.repeated {
+			continue
+		}
+		if err := e.match(kind, query, args); err != nil {
+			return nil, f.unexpectedLocked(performed, err.Error())
+		}
+		e.met = true
+		return e, e.err
+	}
+	return nil, f.unexpectedLocked(performed, "all expected operations were performed")
+}
+
+// unexpectedLocked records the unexpected operation performed, failing the test
+func (f *FakeDB) unexpectedLocked(performed string, reason string) error {
+	f.unexpected = append(f.unexpected, performed)
+	err := fmt.Errorf("libsqltest: unexpected %s: %s", performed, reason)
+	if f.t != nil {
+		f.t.Helper()
+		f.t.Errorf("%v", err)
+	}
+	return err
+}
+
+type expectationKind string
+
+const (
+	expectQuery    expectationKind = "query"
+	expectExec     expectationKind = "exec"
+	expectPrepare  expectationKind = "prepare"
+	expectBegin    expectationKind = "begin"
+	expectCommit   expectationKind = "commit"
+	expectRollback expectationKind = "rollback"
+)
+
+// Expectation is an operation expected by a FakeDB
+type Expectation struct {
+	kind   expectationKind
+	sql    *regexp.Regexp
+	args   []interface{}
+	rows   *Rows
+	result driver.Result
+	err    error
+	met    bool
+	// repeated expectations may be met any number of times
+	repeated bool
+}
+
+// WithArgs expects the operation to be performed with args.
+// The args are compared after their conversion by database/sql, e.g. an int
+// matches the int64 it is converted to, unless they implement Argument.
+// Without WithArgs the operation may be performed with any arguments
+func (e *Expectation) WithArgs(args ...interface{}) *Expectation {
+	if args == nil {
+		args = []interface{}{}
+	}
+	e.args = args
+	return e
+}
+
+// WillReturnRows sets the rows returned by an expected query
+func (e *Expectation) WillReturnRows(rows *Rows) *Expectation {
+	e.rows = rows
+	return e
+}
+
+// WillReturnResult sets the result of an expected insert, update or delete
+func (e *Expectation) WillReturnResult(result driver.Result) *Expectation {
+	e.result = result
+	return e
+}
+
+// WillReturnError makes the expected operation fail with err
+func (e *Expectation) WillReturnError(err error) *Expectation {
+	e.err = err
+	return e
+}
+
+// String implements fmt.Stringer
+func (e *Expectation) String() string {
+	var b strings.Builder
+	b.WriteString(string(e.kind))
+	if e.sql != nil {
+		fmt.Fprintf(&b, " matching %q", e.sql.String())
+	}
+	if e.args != nil {
+		fmt.Fprintf(&b, " with args %v", e.args)
+	}
+	return b.String()
+}
+
+func (e *Expectation) match(kind expectationKind, query string, args []driver.NamedValue) error {
+	if e.kind != kind {
+		return fmt.Errorf("expected %s", e)
+	}
+	if e.sql != nil && !e.sql.MatchString(query) {
+		return fmt.Errorf("expected %s", e)
+	}
+	if e.args == nil {
+		return nil
+	}
+	if len(e.args) != len(args) {
+		return fmt.Errorf("expected %s: %d args instead of %d", e, len(e.args), len(args))
+	}
+	for i, expected := range e.args {
+		if !matchArg(expected, args[i].Value) {
+			return fmt.Errorf("expected %s: arg %d is %#v", e, i, args[i].Value)
+		}
+	}
+	return nil
+}
+
+// Argument matches the arguments of expected operations
+type Argument interface {
+	// Match reports whether the argument converted by database/sql matches
+	Match(driver.Value) bool
+}
+
+// AnyArg returns an Argument matching any argument
+func AnyArg() Argument {
+	return anyArg{}
+}
+
+type anyArg struct{}
+
+// Match implements Argument.Match
+func (anyArg) Match(driver.Value) bool {
+	return true
+}
+
+// String implements fmt.Stringer
+func (anyArg) String() string {
+	return "<any>"
+}
+
+func matchArg(expected interface{}, actual driver.Value) bool {
+	if argument, ok := expected.(Argument); ok {
+		return argument.Match(actual)
+	}
+	converted, err := driver.DefaultParameterConverter.ConvertValue(expected)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(converted, actual)
+}
+
+func describeOperation(kind expectationKind, query string, args []driver.NamedValue) string {
+	var b strings.Builder
+	b.WriteString(string(kind))
+	if query != "" {
+		fmt.Fprintf(&b, " %q", query)
+	}
+	if len(args) > 0 {
+		values := make([]interface{}, len(args))
+		for i, arg := range args {
+			values[i] = arg.Value
+		}
+		fmt.Fprintf(&b, " with args %v", values)
+	}
+	return b.String()
+}
+
+// NewResult returns the result of an insert, update or delete
+func NewResult(lastInsertID, rowsAffected int64) driver.Result {
+	return fakeResult{lastInsertID: lastInsertID, rowsAffected: rowsAffected}
+}
+
+type fakeResult struct {
+	lastInsertID int64
+	rowsAffected int64
+}
+
+// LastInsertId implements driver.Result.LastInsertId
+func (r fakeResult) LastInsertId() (int64, error) {
+	return r.lastInsertID, nil
+}
+
+// RowsAffected implements driver.Result.RowsAffected
+func (r fakeResult) RowsAffected() (int64, error) {
+	return r.rowsAffected, nil
+}
+
+// Rows are the rows returned by an expected query
+type Rows struct {
+	columns []string
+	values  [][]driver.Value
+	errs    map[int]error
+}
+
+// NewRows returns rows of columns
+func NewRows(columns ...string) *Rows {
+	return &Rows{columns: columns}
+}
+
+// AddRow adds a row of values, one per column
+func (r *Rows) AddRow(values ...driver.Value) *Rows {
+	if len(values) != len(r.columns) {
+		panic(fmt.Sprintf("libsqltest: %d values for %d columns", len(values), len(r.columns)))
+	}
+	r.values = append(r.values, values)
+	return r
+}
+
+// RowError makes the iteration of the rows fail with err instead of returning the row of index row
+func (r *Rows) RowError(row int, err error) *Rows {
+	if r.errs == nil {
+		r.errs = map[int]error{}
+	}
+	r.errs[row] = err
+	return r
+}
+
+type fakeConnector struct {
+	db *FakeDB
+}
+
+var _ driver.Connector = (*fakeConnector)(nil)
+
+// Connect implements driver.Connector.Connect
+func (c fakeConnector) Connect(context.Context) (driver.Conn, error) {
+	return &fakeConn{db: c.db}, nil
+}
+
+// Driver implements driver.Connector.Driver
+func (c fakeConnector) Driver() driver.Driver {
+	return fakeDriver{connector: c}
+}
+
+type fakeDriver struct {
+	connector fakeConnector
+}
+
+// Open implements driver.Driver.Open
+func (d fakeDriver) Open(string) (driver.Conn, error) {
+	return d.connector.Connect(context.Background())
+}
+
+type fakeConn struct {
+	db *FakeDB
+}
+
+var (
+	_ driver.Conn               = (*fakeConn)(nil)
+	_ driver.ConnBeginTx        = (*fakeConn)(nil)
+	_ driver.ConnPrepareContext = (*fakeConn)(nil)
+	_ driver.QueryerContext     = (*fakeConn)(nil)
+	_ driver.ExecerContext      = (*fakeConn)(nil)
+)
+
+// Prepare implements driver.Conn.Prepare
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+// PrepareContext implements driver.ConnPrepareContext.PrepareContext
+func (c *fakeConn) PrepareContext(_ context.Context, query string) (driver.Stmt, error) {
+	if _, err := c.db.perform(expectPrepare, query, nil); err != nil {
+		return nil, err
+	}
+	return &fakeStmt{conn: c, query: query}, nil
+}
+
+// Close implements driver.Conn.Close
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+// Begin implements driver.Conn.Begin
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+// BeginTx implements driver.ConnBeginTx.BeginTx
+func (c *fakeConn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	if _, err := c.db.perform(expectBegin, "", nil); err != nil {
+		return nil, err
+	}
+	return fakeTx{db: c.db}, nil
+}
+
+// QueryContext implements driver.QueryerContext.QueryContext
+func (c *fakeConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	e, err := c.db.perform(expectQuery, query, args)
+	if err != nil {
+		return nil, err
+	}
+	if e.rows == nil {
+		return &fakeRows{rows: NewRows()}, nil
+	}
+	return &fakeRows{rows: e.rows}, nil
+}
+
+// ExecContext implements driver.ExecerContext.ExecContext
+func (c *fakeConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	e, err := c.db.perform(expectExec, query, args)
+	if err != nil {
+		return nil, err
+	}
+	if e.result == nil {
+		return NewResult(0, 0), nil
+	}
+	return e.result, nil
+}
+
+type fakeStmt struct {
+	conn  *fakeConn
+	query string
+}
+
+var (
+	_ driver.Stmt             = (*fakeStmt)(nil)
+	_ driver.StmtQueryContext = (*fakeStmt)(nil)
+	_ driver.StmtExecContext  = (*fakeStmt)(nil)
+)
+
+// Close implements driver.Stmt.Close
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+// NumInput implements driver.Stmt.NumInput
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+// Exec implements driver.Stmt.Exec
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.ExecContext(context.Background(), namedValues(args))
+}
+
+// Query implements driver.Stmt.Query
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.QueryContext(context.Background(), namedValues(args))
+}
+
+// ExecContext implements driver.StmtExecContext.ExecContext
+func (s *fakeStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	return s.conn.ExecContext(ctx, s.query, args)
+}
+
+// QueryContext implements driver.StmtQueryContext.QueryContext
+func (s *fakeStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	return s.conn.QueryContext(ctx, s.query, args)
+}
+
+func namedValues(args []driver.Value) []driver.NamedValue {
+	named := make([]driver.NamedValue, len(args))
+	for i, arg := range args {
+		named[i] = driver.NamedValue{Ordinal: i + 1, Value: arg}
+	}
+	return named
+}
+
+type fakeTx struct {
+	db *FakeDB
+}
+
+var _ driver.Tx = (*fakeTx)(nil)
+
+// Commit implements driver.Tx.Commit
+func (t fakeTx) Commit() error {
+	_, err := t.db.perform(expectCommit, "", nil)
+	return err
+}
+
+// Rollback implements driver.Tx.Rollback
+func (t fakeTx) Rollback() error {
+	_, err := t.db.perform(expectRollback, "", nil)
+	return err
+}
+
+type fakeRows struct {
+	rows *Rows
+	next int
+}
+
+var _ driver.Rows = (*fakeRows)(nil)
+
+// Columns implements driver.Rows.Columns
+func (r *fakeRows) Columns() []string {
+	return r.rows.columns
+}
+
+// Close implements driver.Rows.Close
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+// Next implements driver.Rows.Next
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if err, ok := r.rows.errs[r.next]; ok {
+		return err
+	}
+	if r.next >= len(r.rows.values) {
+		return io.EOF
+	}
+	copy(dest, r.rows.values[r.next])
+	r.next++
+	return nil
+}
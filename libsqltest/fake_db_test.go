@@ -0,0 +1,222 @@
+package libsqltest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"oss.indeed.com/go/libsql"
+)
+
+// recordingTester is a Tester recording the errors reported by the fakes
+type recordingTester struct {
+	cleanups []func()
+	errors   []string
+}
+
+var _ Tester = (*recordingTester)(nil)
+
+// Cleanup implements Tester.Cleanup
+func (t *recordingTester) Cleanup(cleanup func()) {
+	t.cleanups = append(t.cleanups, cleanup)
+}
+
+// Errorf implements Tester.Errorf
+func (t *recordingTester) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+// Fatalf implements Tester.Fatalf, stopping the goroutine like testing.T does
+func (t *recordingTester) Fatalf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+	runtime.Goexit()
+}
+
+// Helper implements Tester.Helper
+func (t *recordingTester) Helper() {}
+
+// run runs f in a goroutine, which Fatalf stops, and waits for it
+func (t *recordingTester) run(f func()) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f()
+	}()
+	<-done
+}
+
+// finish runs the cleanups like at the end of a test
+func (t *recordingTester) finish() {
+	for i := len(t.cleanups) - 1; i >= 0; i-- {
+		t.cleanups[i]()
+	}
+}
+
+func Test_FakeDB_ScanAndUpdate(t *testing.T) {
+	ctx := context.Background()
+	fake := NewFakeDB(t)
+	created := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	fake.ExpectQuery(`^SELECT name, age, created FROM elephants WHERE age > \?$`).
+		WithArgs(10).
+		WillReturnRows(NewRows("name", "age", "created").
+			AddRow("Dumbo", int64(12), created).
+			AddRow([]byte("Babar"), "40", created))
+	fake.ExpectExec(`^UPDATE elephants`).
+		WithArgs("Dumbo", AnyArg()).
+		WillReturnResult(NewResult(0, 1))
+
+	db := libsql.Wrap(fake.DB())
+
+	type elephant struct {
+		name    string
+		age     int
+		created time.Time
+	}
+	var elephants []elephant
+	var e elephant
+	err := db.Scan(ctx, newFuncScanner([]interface{}{&e.name, &e.age, &e.created}, func() error {
+		elephants = append(elephants, e)
+		return nil
+	}), "SELECT name, age, created FROM elephants WHERE age > ?", 10)
+	require.NoError(t, err)
+	require.Equal(t, []elephant{{"Dumbo", 12, created}, {"Babar", 40, created}}, elephants)
+
+	rowsAffected, err := db.UpdateAndGetRowsAffected(ctx, "UPDATE elephants SET age = age + 1 WHERE name = ? AND age = ?", "Dumbo", 12)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rowsAffected)
+}
+
+func Test_FakeDB_Transaction(t *testing.T) {
+	ctx := context.Background()
+	fake := NewFakeDB(t)
+	expErr := errors.New("a-test-error")
+	fake.ExpectBegin()
+	fake.ExpectExec(`^INSERT`).WithArgs("Dumbo")
+	fake.ExpectCommit()
+	fake.ExpectBegin()
+	fake.ExpectExec(`^INSERT`).WillReturnError(expErr)
+	fake.ExpectRollback()
+
+	db := libsql.Wrap(fake.DB())
+	insert := func(tx libsql.Transaction) error {
+		_, err := tx.Update(ctx, "INSERT INTO elephants (name) VALUES (?)", "Dumbo")
+		return err
+	}
+	require.NoError(t, db.Transaction(ctx, insert))
+	require.ErrorIs(t, db.Transaction(ctx, insert), expErr)
+}
+
+func Test_FakeDB_Prepare(t *testing.T) {
+	ctx := context.Background()
+	fake := NewFakeDB(t)
+	fake.ExpectPrepare(`^SELECT name FROM elephants WHERE id = \?$`)
+	fake.ExpectQuery(`^SELECT name FROM elephants`).WithArgs(1).WillReturnRows(NewRows("name").AddRow("Dumbo"))
+	fake.ExpectQuery(`^SELECT name FROM elephants`).WithArgs(2)
+
+	db := libsql.Wrap(fake.DB())
+	err := db.Prepared(ctx, "SELECT name FROM elephants WHERE id = ?", func(stmt libsql.Statement) error {
+		var name string
+		if err := stmt.ScanOne(ctx, libsql.Into(&name), 1); err != nil {
+			return err
+		}
+		require.Equal(t, "Dumbo", name)
+		return stmt.ScanOne(ctx, libsql.Into(&name), 2)
+	})
+	require.ErrorIs(t, err, libsql.ErrNoRows)
+}
+
+func Test_FakeDB_RowError(t *testing.T) {
+	ctx := context.Background()
+	fake := NewFakeDB(t)
+	expErr := errors.New("a-test-error")
+	fake.ExpectQuery(`SELECT`).WillReturnRows(NewRows("name").AddRow("Dumbo").AddRow("Babar").RowError(1, expErr))
+
+	var names []string
+	var name string
+	err := libsql.Wrap(fake.DB()).Scan(ctx, newFuncScanner([]interface{}{&name}, func() error {
+		names = append(names, name)
+		return nil
+	}), "SELECT name FROM elephants")
+	require.ErrorIs(t, err, expErr)
+	require.Equal(t, []string{"Dumbo"}, names)
+}
+
+func Test_FakeDB_Mismatches(t *testing.T) {
+	ctx := context.Background()
+	tester := &recordingTester{}
+	fake := NewFakeDB(tester)
+	fake.ExpectExec(`^UPDATE`).WithArgs(1)
+	fake.ExpectQuery(`^SELECT`)
+
+	db := libsql.Wrap(fake.DB())
+
+	_, err := db.Update(ctx, "DELETE FROM elephants")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `libsqltest: unexpected exec "DELETE FROM elephants": expected exec matching "^UPDATE" with args [1]`)
+
+	_, err = db.Update(ctx, "UPDATE elephants SET age = ?", 2)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `libsqltest: unexpected exec "UPDATE elephants SET age = ?" with args [2]: expected exec matching "^UPDATE" with args [1]: arg 0 is 2`)
+
+	_, err = db.Update(ctx, "UPDATE elephants SET age = ?", 1)
+	require.NoError(t, err)
+
+	require.Equal(t, "libsqltest: unexpected operations were performed:\n"+
+		"\texec \"DELETE FROM elephants\"\n"+
+		"\texec \"UPDATE elephants SET age = ?\" with args [2]\n"+
+		"libsqltest: expected operations were not performed:\n"+
+		"\tquery matching \"^SELECT\"", fake.ExpectationsWereMet().Error())
+
+	tester.finish()
+	require.Equal(t, []string{
+		`libsqltest: unexpected exec "DELETE FROM elephants": expected exec matching "^UPDATE" with args [1]`,
+		`libsqltest: unexpected exec "UPDATE elephants SET age = ?" with args [2]: expected exec matching "^UPDATE" with args [1]: arg 0 is 2`,
+		"libsqltest: expected operations were not performed:\n\tquery matching \"^SELECT\"",
+	}, tester.errors)
+}
+
+func Test_FakeDB_ExtraOperations(t *testing.T) {
+	ctx := context.Background()
+	tester := &recordingTester{}
+	fake := NewFakeDB(tester)
+	fake.ExpectExec(`^UPDATE`)
+
+	db := libsql.Wrap(fake.DB())
+
+	_, err := db.Update(ctx, "UPDATE elephants SET age = 1")
+	require.NoError(t, err)
+	_, err = db.Update(ctx, "UPDATE elephants SET age = 2")
+	require.Error(t, err)
+
+	require.Equal(t, "libsqltest: unexpected operations were performed:\n\texec \"UPDATE elephants SET age = 2\"", fake.ExpectationsWereMet().Error())
+
+	tester.finish()
+	require.Equal(t, []string{
+		`libsqltest: unexpected exec "UPDATE elephants SET age = 2": all expected operations were performed`,
+	}, tester.errors)
+}
+
+// funcScanner is a RowScanner calling a function for each row scanned
+type funcScanner struct {
+	into    []interface{}
+	scanned func() error
+}
+
+func newFuncScanner(into []interface{}, scanned func() error) libsql.RowScanner {
+	return funcScanner{into: into, scanned: scanned}
+}
+
+// Into implements RowScanner.Into
+func (s funcScanner) Into() []interface{} {
+	return s.into
+}
+
+// RowScanned implements RowScanner.RowScanned
+func (s funcScanner) RowScanned() error {
+	return s.scanned()
+}
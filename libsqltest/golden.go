@@ -0,0 +1,813 @@
+package libsqltest
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// GoldenOperation is an operation recorded in a golden file
+type GoldenOperation struct {
+	// Kind is one of query, exec, begin, commit and rollback
+	Kind string `json:"kind"`
+	SQL  string `json:"sql,omitempty"`
+	// Args are the arguments converted by database/sql and the driver
+	Args []GoldenValue `json:"args,omitempty"`
+	// Error is the message of the error of the operation
+	Error string `json:"error,omitempty"`
+
+	// Columns are the columns of the rows returned by a query
+	Columns []string `json:"columns,omitempty"`
+	// Rows are the rows returned by a query, as far as they were read
+	Rows [][]GoldenValue `json:"rows,omitempty"`
+	// RowsError is the message of the error iterating the rows
+	RowsError string `json:"rows_error,omitempty"`
+
+	// LastInsertID is the last insert id of an exec, nil if it failed
+	LastInsertID *int64 `json:"last_insert_id,omitempty"`
+	// RowsAffected is the number of rows affected by an exec, nil if it failed
+	RowsAffected *int64 `json:"rows_affected,omitempty"`
+}
+
+// GoldenValue is a driver.Value recorded in a golden file
+type GoldenValue struct {
+	Type  string `json:"type"`
+	Value string `json:"value,omitempty"`
+}
+
+// String implements fmt.Stringer
+func (v GoldenValue) String() string {
+	if v.Type == "null" {
+		return "NULL"
+	}
+	return v.Type + "(" + v.Value + ")"
+}
+
+func goldenValueOf(value driver.Value) GoldenValue {
+	switch v := value.(type) {
+	case nil:
+		return GoldenValue{Type: "null"}
+	case int64:
+		return GoldenValue{Type: "int64", Value: strconv.FormatInt(v, 10)}
+	case float64:
+		return GoldenValue{Type: "float64", Value: strconv.FormatFloat(v, 'g', -1, 64)}
+	case bool:
+		return GoldenValue{Type: "bool", Value: strconv.FormatBool(v)}
+	case []byte:
+		return GoldenValue{Type: "bytes", Value: base64.StdEncoding.EncodeToString(v)}
+	case string:
+		return GoldenValue{Type: "string", Value: v}
+	case time.Time:
+		return GoldenValue{Type: "time", Value: v.Format(time.RFC3339Nano)}
+	}
+	return GoldenValue{Type: reflect.TypeOf(value).String(), Value: fmt.Sprint(value)}
+}
+
+// value returns the driver.Value recorded as v
+func (v GoldenValue) value() (driver.Value, error) {
+	switch v.Type {
+	case "null":
+		return nil, nil
+	case "int64":
+		return strconv.ParseInt(v.Value, 10, 64)
+	case "float64":
+		return strconv.ParseFloat(v.Value, 64)
+	case "bool":
+		return strconv.ParseBool(v.Value)
+	case "bytes":
+		return base64.StdEncoding.DecodeString(v.Value)
+	case "string":
+		return v.Value, nil
+	case "time":
+		return time.Parse(time.RFC3339Nano, v.Value)
+	}
+	return nil, fmt.Errorf("libsqltest: cannot replay a value of type %s", v.Type)
+}
+
+func goldenArgs(args []driver.NamedValue) []GoldenValue {
+	if len(args) == 0 {
+		return nil
+	}
+	values := make([]GoldenValue, len(args))
+	for i, arg := range args {
+		values[i] = goldenValueOf(arg.Value)
+	}
+	return values
+}
+
+func errorMessage(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// ReadGolden reads the operations of the golden file at path
+func ReadGolden(path string) ([]GoldenOperation, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var ops []GoldenOperation
+	if err := json.Unmarshal(content, &ops); err != nil {
+		return nil, fmt.Errorf("libsqltest: invalid golden file %s: %v", path, err)
+	}
+	return ops, nil
+}
+
+// WriteGolden writes ops to the golden file at path
+func WriteGolden(path string, ops []GoldenOperation) error {
+	content, err := json.MarshalIndent(ops, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(content, '\n'), 0644)
+}
+
+// Recorder is a database/sql driver recording the operations performed
+// through a wrapped driver, typically connected to a real database,
+// to replay them later with a Replayer.
+// Recorder is safe for concurrent use.
+type Recorder struct {
+	driver driver.Driver
+
+	mu  sync.Mutex
+	ops []*GoldenOperation
+}
+
+var _ driver.Driver = (*Recorder)(nil)
+
+// NewRecorder returns a Recorder of the operations performed through d
+func NewRecorder(d driver.Driver) *Recorder {
+	return &Recorder{driver: d}
+}
+
+// OpenDB returns a *sql.DB connecting to the data source name through the recorder
+func (r *Recorder) OpenDB(dataSourceName string) *sql.DB {
+	return sql.OpenDB(recordingConnector{recorder: r, dataSourceName: dataSourceName})
+}
+
+// Open implements driver.Driver.Open
+func (r *Recorder) Open(dataSourceName string) (driver.Conn, error) {
+	conn, err := r.driver.Open(dataSourceName)
+	if err != nil {
+		return nil, err
+	}
+	return &recordingConn{recorder: r, conn: conn}, nil
+}
+
+// Operations returns the operations recorded so far
+func (r *Recorder) Operations() []GoldenOperation {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ops := make([]GoldenOperation, len(r.ops))
+	for i, op := range r.ops {
+		ops[i] = *op
+	}
+	return ops
+}
+
+// WriteGolden writes the operations recorded so far to the golden file at path
+func (r *Recorder) WriteGolden(path string) error {
+	return WriteGolden(path, r.Operations())
+}
+
+func (r *Recorder) record(op *GoldenOperation) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ops = append(r.ops, op)
+}
+
+// update updates a recorded op with the recorder locked
+func (r *Recorder) update(update func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	update()
+}
+
+type recordingConnector struct {
+	recorder       *Recorder
+	dataSourceName string
+}
+
+// Connect implements driver.Connector.Connect
+func (c recordingConnector) Connect(context.Context) (driver.Conn, error) {
+	return c.recorder.Open(c.dataSourceName)
+}
+
+// Driver implements driver.Connector.Driver
+func (c recordingConnector) Driver() driver.Driver {
+	return c.recorder
+}
+
+type recordingConn struct {
+	recorder *Recorder
+	conn     driver.Conn
+}
+
+var (
+	_ driver.Conn               = (*recordingConn)(nil)
+	_ driver.ConnBeginTx        = (*recordingConn)(nil)
+	_ driver.ConnPrepareContext = (*recordingConn)(nil)
+	_ driver.QueryerContext     = (*recordingConn)(nil)
+	_ driver.ExecerContext      = (*recordingConn)(nil)
+	_ driver.NamedValueChecker  = (*recordingConn)(nil)
+)
+
+// Prepare implements driver.Conn.Prepare
+func (c *recordingConn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+// PrepareContext implements driver.ConnPrepareContext.PrepareContext
+func (c *recordingConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	var stmt driver.Stmt
+	var err error
+	if preparer, ok := c.conn.(driver.ConnPrepareContext); ok {
+		stmt, err = preparer.PrepareContext(ctx, query)
+	} else {
+		stmt, err = c.conn.Prepare(query)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &recordingStmt{conn: c, stmt: stmt, query: query}, nil
+}
+
+// Close implements driver.Conn.Close
+func (c *recordingConn) Close() error {
+	return c.conn.Close()
+}
+
+// Begin implements driver.Conn.Begin
+func (c *recordingConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+// BeginTx implements driver.ConnBeginTx.BeginTx
+func (c *recordingConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	var tx driver.Tx
+	var err error
+	if beginner, ok := c.conn.(driver.ConnBeginTx); ok {
+		tx, err = beginner.BeginTx(ctx, opts)
+	} else {
+		tx, err = c.conn.Begin()
+	}
+	c.recorder.record(&GoldenOperation{Kind: "begin", Error: errorMessage(err)})
+	if err != nil {
+		return nil, err
+	}
+	return &recordingTx{recorder: c.recorder, tx: tx}, nil
+}
+
+// QueryContext implements driver.QueryerContext.QueryContext
+func (c *recordingConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := c.conn.(driver.QueryerContext)
+	if !ok {
+		// database/sql falls back to preparing a statement
+		return nil, driver.ErrSkip
+	}
+	rows, err := queryer.QueryContext(ctx, query, args)
+	if err == driver.ErrSkip {
+		return nil, err
+	}
+	return c.recordQuery(query, args, rows, err)
+}
+
+// ExecContext implements driver.ExecerContext.ExecContext
+func (c *recordingConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	execer, ok := c.conn.(driver.ExecerContext)
+	if !ok {
+		// database/sql falls back to preparing a statement
+		return nil, driver.ErrSkip
+	}
+	result, err := execer.ExecContext(ctx, query, args)
+	if err == driver.ErrSkip {
+		return nil, err
+	}
+	return c.recordExec(query, args, result, err)
+}
+
+// CheckNamedValue implements driver.NamedValueChecker.CheckNamedValue
+func (c *recordingConn) CheckNamedValue(value *driver.NamedValue) error {
+	if checker, ok := c.conn.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(value)
+	}
+	// database/sql falls back to the default conversion
+	return driver.ErrSkip
+}
+
+func (c *recordingConn) recordQuery(query string, args []driver.NamedValue, rows driver.Rows, err error) (driver.Rows, error) {
+	op := &GoldenOperation{Kind: "query", SQL: query, Args: goldenArgs(args), Error: errorMessage(err)}
+	if err == nil {
+		op.Columns = rows.Columns()
+	}
+	c.recorder.record(op)
+	if err != nil {
+		return nil, err
+	}
+	return &recordingRows{recorder: c.recorder, op: op, rows: rows}, nil
+}
+
+func (c *recordingConn) recordExec(query string, args []driver.NamedValue, result driver.Result, err error) (driver.Result, error) {
+	op := &GoldenOperation{Kind: "exec", SQL: query, Args: goldenArgs(args), Error: errorMessage(err)}
+	if err == nil {
+		if id, err := result.LastInsertId(); err == nil {
+			op.LastInsertID = &id
+		}
+		if affected, err := result.RowsAffected(); err == nil {
+			op.RowsAffected = &affected
+		}
+	}
+	c.recorder.record(op)
+	return result, err
+}
+
+type recordingStmt struct {
+	conn  *recordingConn
+	stmt  driver.Stmt
+	query string
+}
+
+var (
+	_ driver.Stmt             = (*recordingStmt)(nil)
+	_ driver.StmtQueryContext = (*recordingStmt)(nil)
+	_ driver.StmtExecContext  = (*recordingStmt)(nil)
+)
+
+// Close implements driver.Stmt.Close
+func (s *recordingStmt) Close() error {
+	return s.stmt.Close()
+}
+
+// NumInput implements driver.Stmt.NumInput
+func (s *recordingStmt) NumInput() int {
+	return s.stmt.NumInput()
+}
+
+// Exec implements driver.Stmt.Exec
+func (s *recordingStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.ExecContext(context.Background(), namedValues(args))
+}
+
+// Query implements driver.Stmt.Query
+func (s *recordingStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.QueryContext(context.Background(), namedValues(args))
+}
+
+// ExecContext implements driver.StmtExecContext.ExecContext
+func (s *recordingStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	var result driver.Result
+	var err error
+	if execer, ok := s.stmt.(driver.StmtExecContext); ok {
+		result, err = execer.ExecContext(ctx, args)
+	} else {
+		result, err = s.stmt.Exec(values(args))
+	}
+	return s.conn.recordExec(s.query, args, result, err)
+}
+
+// QueryContext implements driver.StmtQueryContext.QueryContext
+func (s *recordingStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	var rows driver.Rows
+	var err error
+	if queryer, ok := s.stmt.(driver.StmtQueryContext); ok {
+		rows, err = queryer.QueryContext(ctx, args)
+	} else {
+		rows, err = s.stmt.Query(values(args))
+	}
+	return s.conn.recordQuery(s.query, args, rows, err)
+}
+
+func values(args []driver.NamedValue) []driver.Value {
+	values := make([]driver.Value, len(args))
+	for i, arg := range args {
+		values[i] = arg.Value
+	}
+	return values
+}
+
+type recordingTx struct {
+	recorder *Recorder
+	tx       driver.Tx
+}
+
+var _ driver.Tx = (*recordingTx)(nil)
+
+// Commit implements driver.Tx.Commit
+func (t *recordingTx) Commit() error {
+	err := t.tx.Commit()
+	t.recorder.record(&GoldenOperation{Kind: "commit", Error: errorMessage(err)})
+	return err
+}
+
+// Rollback implements driver.Tx.Rollback
+func (t *recordingTx) Rollback() error {
+	err := t.tx.Rollback()
+	t.recorder.record(&GoldenOperation{Kind: "rollback", Error: errorMessage(err)})
+	return err
+}
+
+type recordingRows struct {
+	recorder *Recorder
+	op       *GoldenOperation
+	rows     driver.Rows
+}
+
+var _ driver.Rows = (*recordingRows)(nil)
+
+// Columns implements driver.Rows.Columns
+func (r *recordingRows) Columns() []string {
+	return r.rows.Columns()
+}
+
+// Close implements driver.Rows.Close
+func (r *recordingRows) Close() error {
+	return r.rows.Close()
+}
+
+// Next implements driver.Rows.Next
+func (r *recordingRows) Next(dest []driver.Value) error {
+	err := r.rows.Next(dest)
+	r.recorder.update(func() {
+		switch err {
+		case nil:
+			row := make([]GoldenValue, len(dest))
+			for i, value := range dest {
+				row[i] = goldenValueOf(value)
+			}
+			r.op.Rows = append(r.op.Rows, row)
+		case io.EOF:
+		default:
+			r.op.RowsError = err.Error()
+		}
+	})
+	return err
+}
+
+// ReplayConfig configures a Replayer
+type ReplayConfig struct {
+	// Unordered matches the operations with any operation of the golden
+	// file not replayed yet, instead of with the next one
+	Unordered bool
+}
+
+// NewReplayer returns a Replayer of the golden file at path, matching the
+// operations in order. It is a shorthand for ReplayConfig{}.New(t, path)
+func NewReplayer(t Tester, path string) *Replayer {
+	t.Helper()
+	return ReplayConfig{}.New(t, path)
+}
+
+// New returns a Replayer of the golden file at path verifying that all its
+// operations were replayed, and closing its *sql.DB, at the end of the test
+func (c ReplayConfig) New(t Tester, path string) *Replayer {
+	t.Helper()
+	ops, err := ReadGolden(path)
+	if err != nil {
+		t.Errorf("%v", err)
+	}
+	r := &Replayer{cfg: c, t: t, path: path, ops: ops, replayed: make([]bool, len(ops))}
+	r.db = sql.OpenDB(replayConnector{replayer: r})
+	t.Cleanup(func() {
+		t.Helper()
+		if err := r.AllReplayed(); err != nil {
+			t.Errorf("%v", err)
+		}
+		_ = r.db.Close()
+	})
+	return r
+}
+
+// Replayer is a database/sql driver replaying the operations of a golden
+// file written with a Recorder, without a database.
+// Operations which do not match the golden file fail with an error
+// describing the difference with the closest recorded operation, which also
+// fails the test.
+// Errors are replayed as errors with the recorded messages.
+// Replayer is safe for concurrent use.
+type Replayer struct {
+	cfg  ReplayConfig
+	t    Tester
+	path string
+	db   *sql.DB
+
+	mu       sync.Mutex
+	ops      []GoldenOperation
+	replayed []bool
+}
+
+// DB returns the *sql.DB replaying the golden file
+func (r *Replayer) DB() *sql.DB {
+	return r.db
+}
+
+// AllReplayed returns an error listing the operations of the golden file which were not replayed
+func (r *Replayer) AllReplayed() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var remaining []string
+	for i, op := range r.ops {
+		if !r.replayed[i] {
+			remaining = append(remaining, fmt.Sprintf("\t#%d %s", i+1, describeGolden(op)))
+		}
+	}
+	if len(remaining) == 0 {
+		return nil
+	}
+	return fmt.Errorf("libsqltest: operations of %s were not replayed:\n%s", r.path, strings.Join(remaining, "\n"))
+}
+
+// replay returns the recorded operation matching an operation, returning an
+// error and failing the test if there is none
+func (r *Replayer) replay(kind, query string, args []driver.NamedValue) (GoldenOperation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	performed := GoldenOperation{Kind: kind, SQL: query, Args: goldenArgs(args)}
+	closest, closestSimilarity := -1, -1
+	for i, op := range r.ops {
+		if r.replayed[i] {
+			continue
+		}
+		similarity := 0
+		if op.Kind == kind {
+			similarity++
+			if op.SQL == query {
+				similarity++
+				if reflect.DeepEqual(op.Args, performed.Args) {
+					r.replayed[i] = true
+					return op, nil
+				}
+			}
+		}
+		if similarity > closestSimilarity {
+			closest, closestSimilarity = i, similarity
+		}
+		if !r.cfg.Unordered {
+			break
+		}
+	}
+	var err error
+	if closest < 0 {
+		err = fmt.Errorf("libsqltest: unexpected %s: all operations of %s were replayed", describeGolden(performed), r.path)
+	} else {
+		err = fmt.Errorf("libsqltest: %s does not match %s:\n%s", kind, r.path, diffGolden(closest, r.ops[closest], performed))
+	}
+	r.t.Helper()
+	r.t.Errorf("%v", err)
+	return GoldenOperation{}, err
+}
+
+func describeGolden(op GoldenOperation) string {
+	var b strings.Builder
+	b.WriteString(op.Kind)
+	if op.SQL != "" {
+		fmt.Fprintf(&b, " %q", op.SQL)
+	}
+	if len(op.Args) > 0 {
+		fmt.Fprintf(&b, " with args %v", op.Args)
+	}
+	return b.String()
+}
+
+// diffGolden describes the differences between the recorded operation of index i and the performed one
+func diffGolden(i int, recorded, performed GoldenOperation) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- recorded #%d\n+++ performed\n", i+1)
+	field := func(name, recorded, performed string) {
+		if recorded == performed {
+			fmt.Fprintf(&b, " %s: %s\n", name, recorded)
+			return
+		}
+		fmt.Fprintf(&b, "-%s: %s\n+%s: %s\n", name, recorded, name, performed)
+	}
+	field("kind", recorded.Kind, performed.Kind)
+	field("sql", recorded.SQL, performed.SQL)
+	field("args", fmt.Sprint(recorded.Args), fmt.Sprint(performed.Args))
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func replayedError(message string) error {
+	if message == "" {
+		return nil
+	}
+	return errors.New(message)
+}
+
+type replayConnector struct {
+	replayer *Replayer
+}
+
+// Connect implements driver.Connector.Connect
+func (c replayConnector) Connect(context.Context) (driver.Conn, error) {
+	return &replayConn{replayer: c.replayer}, nil
+}
+
+// Driver implements driver.Connector.Driver
+func (c replayConnector) Driver() driver.Driver {
+	return replayDriver{connector: c}
+}
+
+type replayDriver struct {
+	connector replayConnector
+}
+
+// Open implements driver.Driver.Open
+func (d replayDriver) Open(string) (driver.Conn, error) {
+	return d.connector.Connect(context.Background())
+}
+
+type replayConn struct {
+	replayer *Replayer
+}
+
+var (
+	_ driver.Conn           = (*replayConn)(nil)
+	_ driver.ConnBeginTx    = (*replayConn)(nil)
+	_ driver.QueryerContext = (*replayConn)(nil)
+	_ driver.ExecerContext  = (*replayConn)(nil)
+)
+
+// Prepare implements driver.Conn.Prepare
+func (c *replayConn) Prepare(query string) (driver.Stmt, error) {
+	return &replayStmt{conn: c, query: query}, nil
+}
+
+// Close implements driver.Conn.Close
+func (c *replayConn) Close() error {
+	return nil
+}
+
+// Begin implements driver.Conn.Begin
+func (c *replayConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+// BeginTx implements driver.ConnBeginTx.BeginTx
+func (c *replayConn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	op, err := c.replayer.replay("begin", "", nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := replayedError(op.Error); err != nil {
+		return nil, err
+	}
+	return replayTx{replayer: c.replayer}, nil
+}
+
+// QueryContext implements driver.QueryerContext.QueryContext
+func (c *replayConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	op, err := c.replayer.replay("query", query, args)
+	if err != nil {
+		return nil, err
+	}
+	if err := replayedError(op.Error); err != nil {
+		return nil, err
+	}
+	return &replayRows{op: op}, nil
+}
+
+// ExecContext implements driver.ExecerContext.ExecContext
+func (c *replayConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	op, err := c.replayer.replay("exec", query, args)
+	if err != nil {
+		return nil, err
+	}
+	if err := replayedError(op.Error); err != nil {
+		return nil, err
+	}
+	return replayResult{op: op}, nil
+}
+
+type replayStmt struct {
+	conn  *replayConn
+	query string
+}
+
+var (
+	_ driver.Stmt             = (*replayStmt)(nil)
+	_ driver.StmtQueryContext = (*replayStmt)(nil)
+	_ driver.StmtExecContext  = (*replayStmt)(nil)
+)
+
+// Close implements driver.Stmt.Close
+func (s *replayStmt) Close() error {
+	return nil
+}
+
+// NumInput implements driver.Stmt.NumInput
+func (s *replayStmt) NumInput() int {
+	return -1
+}
+
+// Exec implements driver.Stmt.Exec
+func (s *replayStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.ExecContext(context.Background(), namedValues(args))
+}
+
+// Query implements driver.Stmt.Query
+func (s *replayStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.QueryContext(context.Background(), namedValues(args))
+}
+
+// ExecContext implements driver.StmtExecContext.ExecContext
+func (s *replayStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	return s.conn.ExecContext(ctx, s.query, args)
+}
+
+// QueryContext implements driver.StmtQueryContext.QueryContext
+func (s *replayStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	return s.conn.QueryContext(ctx, s.query, args)
+}
+
+type replayTx struct {
+	replayer *Replayer
+}
+
+var _ driver.Tx = (*replayTx)(nil)
+
+// Commit implements driver.Tx.Commit
+func (t replayTx) Commit() error {
+	op, err := t.replayer.replay("commit", "", nil)
+	if err != nil {
+		return err
+	}
+	return replayedError(op.Error)
+}
+
+// Rollback implements driver.Tx.Rollback
+func (t replayTx) Rollback() error {
+	op, err := t.replayer.replay("rollback", "", nil)
+	if err != nil {
+		return err
+	}
+	return replayedError(op.Error)
+}
+
+type replayResult struct {
+	op GoldenOperation
+}
+
+// LastInsertId implements driver.Result.LastInsertId
+func (r replayResult) LastInsertId() (int64, error) {
+	if r.op.LastInsertID == nil {
+		return 0, errors.New("libsqltest: no last insert id was recorded")
+	}
+	return *r.op.LastInsertID, nil
+}
+
+// RowsAffected implements driver.Result.RowsAffected
+func (r replayResult) RowsAffected() (int64, error) {
+	if r.op.RowsAffected == nil {
+		return 0, errors.New("libsqltest: no rows affected were recorded")
+	}
+	return *r.op.RowsAffected, nil
+}
+
+type replayRows struct {
+	op   GoldenOperation
+	next int
+}
+
+var _ driver.Rows = (*replayRows)(nil)
+
+// Columns implements driver.Rows.Columns
+func (r *replayRows) Columns() []string {
+	return r.op.Columns
+}
+
+// Close implements driver.Rows.Close
+func (r *replayRows) Close() error {
+	return nil
+}
+
+// Next implements driver.Rows.Next
+func (r *replayRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.op.Rows) {
+		if err := replayedError(r.op.RowsError); err != nil {
+			return err
+		}
+		return io.EOF
+	}
+	for i, recorded := range r.op.Rows[r.next] {
+		value, err := recorded.value()
+		if err != nil {
+			return err
+		}
+		dest[i] = value
+	}
+	r.next++
+	return nil
+}
@@ -0,0 +1,148 @@
+package libsqltest
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"oss.indeed.com/go/libsql"
+)
+
+type goldenElephant struct {
+	name    string
+	age     int64
+	weight  float64
+	tusks   bool
+	created time.Time
+	nick    *string
+}
+
+// runElephantQueries performs the operations recorded and replayed by the tests
+func runElephantQueries(t *testing.T, db libsql.Database) []goldenElephant {
+	ctx := context.Background()
+	err := db.Transaction(ctx, func(tx libsql.Transaction) error {
+		_, err := tx.Update(ctx, "INSERT INTO elephants (name, age) VALUES (?, ?)", "Dumbo", 12)
+		return err
+	})
+	require.NoError(t, err)
+
+	var elephants []goldenElephant
+	var e goldenElephant
+	err = db.Scan(ctx, newFuncScanner([]interface{}{&e.name, &e.age, &e.weight, &e.tusks, &e.created, &e.nick}, func() error {
+		elephants = append(elephants, e)
+		return nil
+	}), "SELECT name, age, weight, tusks, created, nick FROM elephants WHERE age > ?", 10)
+	require.NoError(t, err)
+
+	_, err = db.Update(ctx, "DELETE FROM elephants WHERE name = ?", "Babar")
+	require.EqualError(t, errors.Unwrap(err), "a-test-error")
+	return elephants
+}
+
+// recordElephantQueries records runElephantQueries into a golden file, returning its path and the scanned elephants
+func recordElephantQueries(t *testing.T) (string, []goldenElephant) {
+	created := time.Date(2020, 1, 2, 3, 4, 5, 6, time.UTC)
+	fake := NewFakeDB(t)
+	fake.ExpectBegin()
+	fake.ExpectExec("INSERT").WillReturnResult(NewResult(1, 1))
+	fake.ExpectCommit()
+	fake.ExpectQuery("SELECT").WillReturnRows(NewRows("name", "age", "weight", "tusks", "created", "nick").
+		AddRow([]byte("Dumbo"), int64(12), 1.5, true, created, nil).
+		AddRow("Babar", int64(40), 2.25, false, created, "King"))
+	fake.ExpectExec("DELETE").WillReturnError(errors.New("a-test-error"))
+
+	recorder := NewRecorder(fake.DB().Driver())
+	elephants := runElephantQueries(t, libsql.Wrap(recorder.OpenDB("")))
+	require.Len(t, elephants, 2)
+
+	path := filepath.Join(t.TempDir(), "elephants.golden.json")
+	require.NoError(t, recorder.WriteGolden(path))
+	return path, elephants
+}
+
+func Test_Replayer_ReplaysRecordedOperations(t *testing.T) {
+	path, recorded := recordElephantQueries(t)
+
+	ops, err := ReadGolden(path)
+	require.NoError(t, err)
+	require.Equal(t, []string{"begin", "exec", "commit", "query", "exec"}, kinds(ops))
+	require.Equal(t, []GoldenValue{{Type: "string", Value: "Dumbo"}, {Type: "int64", Value: "12"}}, ops[1].Args)
+
+	replayer := NewReplayer(t, path)
+	require.Equal(t, recorded, runElephantQueries(t, libsql.Wrap(replayer.DB())))
+}
+
+func Test_Replayer_Unordered(t *testing.T) {
+	ctx := context.Background()
+	path, _ := recordElephantQueries(t)
+
+	replayer := ReplayConfig{Unordered: true}.New(t, path)
+	db := libsql.Wrap(replayer.DB())
+
+	_, err := db.Update(ctx, "DELETE FROM elephants WHERE name = ?", "Babar")
+	require.Error(t, err)
+	var e goldenElephant
+	require.NoError(t, db.ScanOne(ctx, libsql.Into(&e.name, &e.age, &e.weight, &e.tusks, &e.created, &e.nick),
+		"SELECT name, age, weight, tusks, created, nick FROM elephants WHERE age > ?", 10))
+	require.Equal(t, "Dumbo", e.name)
+	require.NoError(t, db.Transaction(ctx, func(tx libsql.Transaction) error {
+		_, err := tx.Update(ctx, "INSERT INTO elephants (name, age) VALUES (?, ?)", "Dumbo", 12)
+		return err
+	}))
+}
+
+func Test_Replayer_Mismatch(t *testing.T) {
+	ctx := context.Background()
+	path, _ := recordElephantQueries(t)
+
+	tester := &recordingTester{}
+	replayer := ReplayConfig{Unordered: true}.New(tester, path)
+	db := libsql.Wrap(replayer.DB())
+
+	_, err := db.Update(ctx, "DELETE FROM elephants WHERE name = ?", "Dumbo")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "libsqltest: exec does not match "+path+":\n"+
+		"--- recorded #5\n"+
+		"+++ performed\n"+
+		" kind: exec\n"+
+		" sql: DELETE FROM elephants WHERE name = ?\n"+
+		"-args: [string(Babar)]\n"+
+		"+args: [string(Dumbo)]")
+
+	require.Len(t, tester.errors, 1)
+	require.Contains(t, err.Error(), tester.errors[0])
+
+	tester.finish()
+	require.Len(t, tester.errors, 2)
+	require.Contains(t, tester.errors[1], "libsqltest: operations of "+path+" were not replayed:\n\t#1 begin\n")
+}
+
+func Test_Replayer_Strict(t *testing.T) {
+	ctx := context.Background()
+	path, _ := recordElephantQueries(t)
+
+	tester := &recordingTester{}
+	replayer := NewReplayer(tester, path)
+
+	_, err := libsql.Wrap(replayer.DB()).Update(ctx, "DELETE FROM elephants WHERE name = ?", "Babar")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "libsqltest: exec does not match "+path+":\n"+
+		"--- recorded #1\n"+
+		"+++ performed\n"+
+		"-kind: begin\n"+
+		"+kind: exec\n")
+	require.Len(t, tester.errors, 1)
+	require.Contains(t, err.Error(), tester.errors[0])
+}
+
+func kinds(ops []GoldenOperation) []string {
+	kinds := make([]string, len(ops))
+	for i, op := range ops {
+		kinds[i] = op.Kind
+	}
+	return kinds
+}
@@ -35,6 +35,12 @@ type PreparedStatementMock struct {
 	beforeScanOneCounter uint64
 	ScanOneMock          mPreparedStatementMockScanOne
 
+	funcScanOptional          func(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{}) (b1 bool, err error)
+	inspectFuncScanOptional   func(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{})
+	afterScanOptionalCounter  uint64
+	beforeScanOptionalCounter uint64
+	ScanOptionalMock          mPreparedStatementMockScanOptional
+
 	funcUpdate          func(ctx context.Context, args ...interface{}) (r1 sql.Result, err error)
 	inspectFuncUpdate   func(ctx context.Context, args ...interface{})
 	afterUpdateCounter  uint64
@@ -52,6 +58,12 @@ type PreparedStatementMock struct {
 	afterUpdateAndGetRowsAffectedCounter  uint64
 	beforeUpdateAndGetRowsAffectedCounter uint64
 	UpdateAndGetRowsAffectedMock          mPreparedStatementMockUpdateAndGetRowsAffected
+
+	funcUpdateReturning          func(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{}) (i1 int64, err error)
+	inspectFuncUpdateReturning   func(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{})
+	afterUpdateReturningCounter  uint64
+	beforeUpdateReturningCounter uint64
+	UpdateReturningMock          mPreparedStatementMockUpdateReturning
 }
 
 // NewPreparedStatementMock returns a mock for libsql.PreparedStatement
@@ -69,6 +81,9 @@ func NewPreparedStatementMock(t minimock.Tester) *PreparedStatementMock {
 	m.ScanOneMock = mPreparedStatementMockScanOne{mock: m}
 	m.ScanOneMock.callArgs = []*PreparedStatementMockScanOneParams{}
 
+	m.ScanOptionalMock = mPreparedStatementMockScanOptional{mock: m}
+	m.ScanOptionalMock.callArgs = []*PreparedStatementMockScanOptionalParams{}
+
 	m.UpdateMock = mPreparedStatementMockUpdate{mock: m}
 	m.UpdateMock.callArgs = []*PreparedStatementMockUpdateParams{}
 
@@ -78,6 +93,9 @@ func NewPreparedStatementMock(t minimock.Tester) *PreparedStatementMock {
 	m.UpdateAndGetRowsAffectedMock = mPreparedStatementMockUpdateAndGetRowsAffected{mock: m}
 	m.UpdateAndGetRowsAffectedMock.callArgs = []*PreparedStatementMockUpdateAndGetRowsAffectedParams{}
 
+	m.UpdateReturningMock = mPreparedStatementMockUpdateReturning{mock: m}
+	m.UpdateReturningMock.callArgs = []*PreparedStatementMockUpdateReturningParams{}
+
 	return m
 }
 
@@ -658,6 +676,224 @@ func (m *PreparedStatementMock) MinimockScanOneInspect() {
 	}
 }
 
+type mPreparedStatementMockScanOptional struct {
+	mock               *PreparedStatementMock
+	defaultExpectation *PreparedStatementMockScanOptionalExpectation
+	expectations       []*PreparedStatementMockScanOptionalExpectation
+
+	callArgs []*PreparedStatementMockScanOptionalParams
+	mutex    sync.RWMutex
+}
+
+// PreparedStatementMockScanOptionalExpectation specifies expectation struct of the PreparedStatement.ScanOptional
+type PreparedStatementMockScanOptionalExpectation struct {
+	mock    *PreparedStatementMock
+	params  *PreparedStatementMockScanOptionalParams
+	results *PreparedStatementMockScanOptionalResults
+	Counter uint64
+}
+
+// PreparedStatementMockScanOptionalParams contains parameters of the PreparedStatement.ScanOptional
+type PreparedStatementMockScanOptionalParams struct {
+	ctx     context.Context
+	scanner mm_libsql.RowScanner
+	args    []interface{}
+}
+
+// PreparedStatementMockScanOptionalResults contains results of the PreparedStatement.ScanOptional
+type PreparedStatementMockScanOptionalResults struct {
+	b1  bool
+	err error
+}
+
+// Expect sets up expected params for PreparedStatement.ScanOptional
+func (mmScanOptional *mPreparedStatementMockScanOptional) Expect(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{}) *mPreparedStatementMockScanOptional {
+	if mmScanOptional.mock.funcScanOptional != nil {
+		mmScanOptional.mock.t.Fatalf("PreparedStatementMock.ScanOptional mock is already set by Set")
+	}
+
+	if mmScanOptional.defaultExpectation == nil {
+		mmScanOptional.defaultExpectation = &PreparedStatementMockScanOptionalExpectation{}
+	}
+
+	mmScanOptional.defaultExpectation.params = &PreparedStatementMockScanOptionalParams{ctx, scanner, args}
+	for _, e := range mmScanOptional.expectations {
+		if minimock.Equal(e.params, mmScanOptional.defaultExpectation.params) {
+			mmScanOptional.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmScanOptional.defaultExpectation.params)
+		}
+	}
+
+	return mmScanOptional
+}
+
+// Inspect accepts an inspector function that has same arguments as the PreparedStatement.ScanOptional
+func (mmScanOptional *mPreparedStatementMockScanOptional) Inspect(f func(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{})) *mPreparedStatementMockScanOptional {
+	if mmScanOptional.mock.inspectFuncScanOptional != nil {
+		mmScanOptional.mock.t.Fatalf("Inspect function is already set for PreparedStatementMock.ScanOptional")
+	}
+
+	mmScanOptional.mock.inspectFuncScanOptional = f
+
+	return mmScanOptional
+}
+
+// Return sets up results that will be returned by PreparedStatement.ScanOptional
+func (mmScanOptional *mPreparedStatementMockScanOptional) Return(b1 bool, err error) *PreparedStatementMock {
+	if mmScanOptional.mock.funcScanOptional != nil {
+		mmScanOptional.mock.t.Fatalf("PreparedStatementMock.ScanOptional mock is already set by Set")
+	}
+
+	if mmScanOptional.defaultExpectation == nil {
+		mmScanOptional.defaultExpectation = &PreparedStatementMockScanOptionalExpectation{mock: mmScanOptional.mock}
+	}
+	mmScanOptional.defaultExpectation.results = &PreparedStatementMockScanOptionalResults{b1, err}
+	return mmScanOptional.mock
+}
+
+//Set uses given function f to mock the PreparedStatement.ScanOptional method
+func (mmScanOptional *mPreparedStatementMockScanOptional) Set(f func(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{}) (b1 bool, err error)) *PreparedStatementMock {
+	if mmScanOptional.defaultExpectation != nil {
+		mmScanOptional.mock.t.Fatalf("Default expectation is already set for the PreparedStatement.ScanOptional method")
+	}
+
+	if len(mmScanOptional.expectations) > 0 {
+		mmScanOptional.mock.t.Fatalf("Some expectations are already set for the PreparedStatement.ScanOptional method")
+	}
+
+	mmScanOptional.mock.funcScanOptional = f
+	return mmScanOptional.mock
+}
+
+// When sets expectation for the PreparedStatement.ScanOptional which will trigger the result defined by the following
+// Then helper
+func (mmScanOptional *mPreparedStatementMockScanOptional) When(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{}) *PreparedStatementMockScanOptionalExpectation {
+	if mmScanOptional.mock.funcScanOptional != nil {
+		mmScanOptional.mock.t.Fatalf("PreparedStatementMock.ScanOptional mock is already set by Set")
+	}
+
+	expectation := &PreparedStatementMockScanOptionalExpectation{
+		mock:   mmScanOptional.mock,
+		params: &PreparedStatementMockScanOptionalParams{ctx, scanner, args},
+	}
+	mmScanOptional.expectations = append(mmScanOptional.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PreparedStatement.ScanOptional return parameters for the expectation previously defined by the When method
+func (e *PreparedStatementMockScanOptionalExpectation) Then(b1 bool, err error) *PreparedStatementMock {
+	e.results = &PreparedStatementMockScanOptionalResults{b1, err}
+	return e.mock
+}
+
+// ScanOptional implements libsql.PreparedStatement
+func (mmScanOptional *PreparedStatementMock) ScanOptional(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{}) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmScanOptional.beforeScanOptionalCounter, 1)
+	defer mm_atomic.AddUint64(&mmScanOptional.afterScanOptionalCounter, 1)
+
+	if mmScanOptional.inspectFuncScanOptional != nil {
+		mmScanOptional.inspectFuncScanOptional(ctx, scanner, args...)
+	}
+
+	mm_params := &PreparedStatementMockScanOptionalParams{ctx, scanner, args}
+
+	// Record call args
+	mmScanOptional.ScanOptionalMock.mutex.Lock()
+	mmScanOptional.ScanOptionalMock.callArgs = append(mmScanOptional.ScanOptionalMock.callArgs, mm_params)
+	mmScanOptional.ScanOptionalMock.mutex.Unlock()
+
+	for _, e := range mmScanOptional.ScanOptionalMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmScanOptional.ScanOptionalMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmScanOptional.ScanOptionalMock.defaultExpectation.Counter, 1)
+		mm_want := mmScanOptional.ScanOptionalMock.defaultExpectation.params
+		mm_got := PreparedStatementMockScanOptionalParams{ctx, scanner, args}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmScanOptional.t.Errorf("PreparedStatementMock.ScanOptional got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmScanOptional.ScanOptionalMock.defaultExpectation.results
+		if mm_results == nil {
+			mmScanOptional.t.Fatal("No results are set for the PreparedStatementMock.ScanOptional")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmScanOptional.funcScanOptional != nil {
+		return mmScanOptional.funcScanOptional(ctx, scanner, args...)
+	}
+	mmScanOptional.t.Fatalf("Unexpected call to PreparedStatementMock.ScanOptional. %v %v %v", ctx, scanner, args)
+	return
+}
+
+// ScanOptionalAfterCounter returns a count of finished PreparedStatementMock.ScanOptional invocations
+func (mmScanOptional *PreparedStatementMock) ScanOptionalAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScanOptional.afterScanOptionalCounter)
+}
+
+// ScanOptionalBeforeCounter returns a count of PreparedStatementMock.ScanOptional invocations
+func (mmScanOptional *PreparedStatementMock) ScanOptionalBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScanOptional.beforeScanOptionalCounter)
+}
+
+// Calls returns a list of arguments used in each call to PreparedStatementMock.ScanOptional.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmScanOptional *mPreparedStatementMockScanOptional) Calls() []*PreparedStatementMockScanOptionalParams {
+	mmScanOptional.mutex.RLock()
+
+	argCopy := make([]*PreparedStatementMockScanOptionalParams, len(mmScanOptional.callArgs))
+	copy(argCopy, mmScanOptional.callArgs)
+
+	mmScanOptional.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockScanOptionalDone returns true if the count of the ScanOptional invocations corresponds
+// the number of defined expectations
+func (m *PreparedStatementMock) MinimockScanOptionalDone() bool {
+	for _, e := range m.ScanOptionalMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ScanOptionalMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterScanOptionalCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcScanOptional != nil && mm_atomic.LoadUint64(&m.afterScanOptionalCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockScanOptionalInspect logs each unmet expectation
+func (m *PreparedStatementMock) MinimockScanOptionalInspect() {
+	for _, e := range m.ScanOptionalMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PreparedStatementMock.ScanOptional with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ScanOptionalMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterScanOptionalCounter) < 1 {
+		if m.ScanOptionalMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PreparedStatementMock.ScanOptional")
+		} else {
+			m.t.Errorf("Expected call to PreparedStatementMock.ScanOptional with params: %#v", *m.ScanOptionalMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcScanOptional != nil && mm_atomic.LoadUint64(&m.afterScanOptionalCounter) < 1 {
+		m.t.Error("Expected call to PreparedStatementMock.ScanOptional")
+	}
+}
+
 type mPreparedStatementMockUpdate struct {
 	mock               *PreparedStatementMock
 	defaultExpectation *PreparedStatementMockUpdateExpectation
@@ -1309,6 +1545,224 @@ func (m *PreparedStatementMock) MinimockUpdateAndGetRowsAffectedInspect() {
 	}
 }
 
+type mPreparedStatementMockUpdateReturning struct {
+	mock               *PreparedStatementMock
+	defaultExpectation *PreparedStatementMockUpdateReturningExpectation
+	expectations       []*PreparedStatementMockUpdateReturningExpectation
+
+	callArgs []*PreparedStatementMockUpdateReturningParams
+	mutex    sync.RWMutex
+}
+
+// PreparedStatementMockUpdateReturningExpectation specifies expectation struct of the PreparedStatement.UpdateReturning
+type PreparedStatementMockUpdateReturningExpectation struct {
+	mock    *PreparedStatementMock
+	params  *PreparedStatementMockUpdateReturningParams
+	results *PreparedStatementMockUpdateReturningResults
+	Counter uint64
+}
+
+// PreparedStatementMockUpdateReturningParams contains parameters of the PreparedStatement.UpdateReturning
+type PreparedStatementMockUpdateReturningParams struct {
+	ctx     context.Context
+	scanner mm_libsql.RowScanner
+	args    []interface{}
+}
+
+// PreparedStatementMockUpdateReturningResults contains results of the PreparedStatement.UpdateReturning
+type PreparedStatementMockUpdateReturningResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for PreparedStatement.UpdateReturning
+func (mmUpdateReturning *mPreparedStatementMockUpdateReturning) Expect(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{}) *mPreparedStatementMockUpdateReturning {
+	if mmUpdateReturning.mock.funcUpdateReturning != nil {
+		mmUpdateReturning.mock.t.Fatalf("PreparedStatementMock.UpdateReturning mock is already set by Set")
+	}
+
+	if mmUpdateReturning.defaultExpectation == nil {
+		mmUpdateReturning.defaultExpectation = &PreparedStatementMockUpdateReturningExpectation{}
+	}
+
+	mmUpdateReturning.defaultExpectation.params = &PreparedStatementMockUpdateReturningParams{ctx, scanner, args}
+	for _, e := range mmUpdateReturning.expectations {
+		if minimock.Equal(e.params, mmUpdateReturning.defaultExpectation.params) {
+			mmUpdateReturning.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateReturning.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateReturning
+}
+
+// Inspect accepts an inspector function that has same arguments as the PreparedStatement.UpdateReturning
+func (mmUpdateReturning *mPreparedStatementMockUpdateReturning) Inspect(f func(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{})) *mPreparedStatementMockUpdateReturning {
+	if mmUpdateReturning.mock.inspectFuncUpdateReturning != nil {
+		mmUpdateReturning.mock.t.Fatalf("Inspect function is already set for PreparedStatementMock.UpdateReturning")
+	}
+
+	mmUpdateReturning.mock.inspectFuncUpdateReturning = f
+
+	return mmUpdateReturning
+}
+
+// Return sets up results that will be returned by PreparedStatement.UpdateReturning
+func (mmUpdateReturning *mPreparedStatementMockUpdateReturning) Return(i1 int64, err error) *PreparedStatementMock {
+	if mmUpdateReturning.mock.funcUpdateReturning != nil {
+		mmUpdateReturning.mock.t.Fatalf("PreparedStatementMock.UpdateReturning mock is already set by Set")
+	}
+
+	if mmUpdateReturning.defaultExpectation == nil {
+		mmUpdateReturning.defaultExpectation = &PreparedStatementMockUpdateReturningExpectation{mock: mmUpdateReturning.mock}
+	}
+	mmUpdateReturning.defaultExpectation.results = &PreparedStatementMockUpdateReturningResults{i1, err}
+	return mmUpdateReturning.mock
+}
+
+//Set uses given function f to mock the PreparedStatement.UpdateReturning method
+func (mmUpdateReturning *mPreparedStatementMockUpdateReturning) Set(f func(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{}) (i1 int64, err error)) *PreparedStatementMock {
+	if mmUpdateReturning.defaultExpectation != nil {
+		mmUpdateReturning.mock.t.Fatalf("Default expectation is already set for the PreparedStatement.UpdateReturning method")
+	}
+
+	if len(mmUpdateReturning.expectations) > 0 {
+		mmUpdateReturning.mock.t.Fatalf("Some expectations are already set for the PreparedStatement.UpdateReturning method")
+	}
+
+	mmUpdateReturning.mock.funcUpdateReturning = f
+	return mmUpdateReturning.mock
+}
+
+// When sets expectation for the PreparedStatement.UpdateReturning which will trigger the result defined by the following
+// Then helper
+func (mmUpdateReturning *mPreparedStatementMockUpdateReturning) When(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{}) *PreparedStatementMockUpdateReturningExpectation {
+	if mmUpdateReturning.mock.funcUpdateReturning != nil {
+		mmUpdateReturning.mock.t.Fatalf("PreparedStatementMock.UpdateReturning mock is already set by Set")
+	}
+
+	expectation := &PreparedStatementMockUpdateReturningExpectation{
+		mock:   mmUpdateReturning.mock,
+		params: &PreparedStatementMockUpdateReturningParams{ctx, scanner, args},
+	}
+	mmUpdateReturning.expectations = append(mmUpdateReturning.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PreparedStatement.UpdateReturning return parameters for the expectation previously defined by the When method
+func (e *PreparedStatementMockUpdateReturningExpectation) Then(i1 int64, err error) *PreparedStatementMock {
+	e.results = &PreparedStatementMockUpdateReturningResults{i1, err}
+	return e.mock
+}
+
+// UpdateReturning implements libsql.PreparedStatement
+func (mmUpdateReturning *PreparedStatementMock) UpdateReturning(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{}) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmUpdateReturning.beforeUpdateReturningCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateReturning.afterUpdateReturningCounter, 1)
+
+	if mmUpdateReturning.inspectFuncUpdateReturning != nil {
+		mmUpdateReturning.inspectFuncUpdateReturning(ctx, scanner, args...)
+	}
+
+	mm_params := &PreparedStatementMockUpdateReturningParams{ctx, scanner, args}
+
+	// Record call args
+	mmUpdateReturning.UpdateReturningMock.mutex.Lock()
+	mmUpdateReturning.UpdateReturningMock.callArgs = append(mmUpdateReturning.UpdateReturningMock.callArgs, mm_params)
+	mmUpdateReturning.UpdateReturningMock.mutex.Unlock()
+
+	for _, e := range mmUpdateReturning.UpdateReturningMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmUpdateReturning.UpdateReturningMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateReturning.UpdateReturningMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateReturning.UpdateReturningMock.defaultExpectation.params
+		mm_got := PreparedStatementMockUpdateReturningParams{ctx, scanner, args}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateReturning.t.Errorf("PreparedStatementMock.UpdateReturning got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateReturning.UpdateReturningMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateReturning.t.Fatal("No results are set for the PreparedStatementMock.UpdateReturning")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmUpdateReturning.funcUpdateReturning != nil {
+		return mmUpdateReturning.funcUpdateReturning(ctx, scanner, args...)
+	}
+	mmUpdateReturning.t.Fatalf("Unexpected call to PreparedStatementMock.UpdateReturning. %v %v %v", ctx, scanner, args)
+	return
+}
+
+// UpdateReturningAfterCounter returns a count of finished PreparedStatementMock.UpdateReturning invocations
+func (mmUpdateReturning *PreparedStatementMock) UpdateReturningAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateReturning.afterUpdateReturningCounter)
+}
+
+// UpdateReturningBeforeCounter returns a count of PreparedStatementMock.UpdateReturning invocations
+func (mmUpdateReturning *PreparedStatementMock) UpdateReturningBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateReturning.beforeUpdateReturningCounter)
+}
+
+// Calls returns a list of arguments used in each call to PreparedStatementMock.UpdateReturning.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateReturning *mPreparedStatementMockUpdateReturning) Calls() []*PreparedStatementMockUpdateReturningParams {
+	mmUpdateReturning.mutex.RLock()
+
+	argCopy := make([]*PreparedStatementMockUpdateReturningParams, len(mmUpdateReturning.callArgs))
+	copy(argCopy, mmUpdateReturning.callArgs)
+
+	mmUpdateReturning.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateReturningDone returns true if the count of the UpdateReturning invocations corresponds
+// the number of defined expectations
+func (m *PreparedStatementMock) MinimockUpdateReturningDone() bool {
+	for _, e := range m.UpdateReturningMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateReturningMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateReturningCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateReturning != nil && mm_atomic.LoadUint64(&m.afterUpdateReturningCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockUpdateReturningInspect logs each unmet expectation
+func (m *PreparedStatementMock) MinimockUpdateReturningInspect() {
+	for _, e := range m.UpdateReturningMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PreparedStatementMock.UpdateReturning with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateReturningMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateReturningCounter) < 1 {
+		if m.UpdateReturningMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PreparedStatementMock.UpdateReturning")
+		} else {
+			m.t.Errorf("Expected call to PreparedStatementMock.UpdateReturning with params: %#v", *m.UpdateReturningMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateReturning != nil && mm_atomic.LoadUint64(&m.afterUpdateReturningCounter) < 1 {
+		m.t.Error("Expected call to PreparedStatementMock.UpdateReturning")
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *PreparedStatementMock) MinimockFinish() {
 	if !m.minimockDone() {
@@ -1318,11 +1772,15 @@ func (m *PreparedStatementMock) MinimockFinish() {
 
 		m.MinimockScanOneInspect()
 
+		m.MinimockScanOptionalInspect()
+
 		m.MinimockUpdateInspect()
 
 		m.MinimockUpdateAndGetLastInsertIDInspect()
 
 		m.MinimockUpdateAndGetRowsAffectedInspect()
+
+		m.MinimockUpdateReturningInspect()
 		m.t.FailNow()
 	}
 }
@@ -1349,7 +1807,9 @@ func (m *PreparedStatementMock) minimockDone() bool {
 		m.MinimockCloseDone() &&
 		m.MinimockScanDone() &&
 		m.MinimockScanOneDone() &&
+		m.MinimockScanOptionalDone() &&
 		m.MinimockUpdateDone() &&
 		m.MinimockUpdateAndGetLastInsertIDDone() &&
-		m.MinimockUpdateAndGetRowsAffectedDone()
+		m.MinimockUpdateAndGetRowsAffectedDone() &&
+		m.MinimockUpdateReturningDone()
 }
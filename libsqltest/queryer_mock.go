@@ -29,6 +29,12 @@ type QueryerMock struct {
 	beforeScanOneCounter uint64
 	ScanOneMock          mQueryerMockScanOne
 
+	funcScanOptional          func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (b1 bool, err error)
+	inspectFuncScanOptional   func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{})
+	afterScanOptionalCounter  uint64
+	beforeScanOptionalCounter uint64
+	ScanOptionalMock          mQueryerMockScanOptional
+
 	funcUpdate          func(ctx context.Context, sql string, args ...interface{}) (r1 sql.Result, err error)
 	inspectFuncUpdate   func(ctx context.Context, sql string, args ...interface{})
 	afterUpdateCounter  uint64
@@ -46,6 +52,12 @@ type QueryerMock struct {
 	afterUpdateAndGetRowsAffectedCounter  uint64
 	beforeUpdateAndGetRowsAffectedCounter uint64
 	UpdateAndGetRowsAffectedMock          mQueryerMockUpdateAndGetRowsAffected
+
+	funcUpdateReturning          func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (i1 int64, err error)
+	inspectFuncUpdateReturning   func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{})
+	afterUpdateReturningCounter  uint64
+	beforeUpdateReturningCounter uint64
+	UpdateReturningMock          mQueryerMockUpdateReturning
 }
 
 // NewQueryerMock returns a mock for libsql.Queryer
@@ -61,6 +73,9 @@ func NewQueryerMock(t minimock.Tester) *QueryerMock {
 	m.ScanOneMock = mQueryerMockScanOne{mock: m}
 	m.ScanOneMock.callArgs = []*QueryerMockScanOneParams{}
 
+	m.ScanOptionalMock = mQueryerMockScanOptional{mock: m}
+	m.ScanOptionalMock.callArgs = []*QueryerMockScanOptionalParams{}
+
 	m.UpdateMock = mQueryerMockUpdate{mock: m}
 	m.UpdateMock.callArgs = []*QueryerMockUpdateParams{}
 
@@ -70,6 +85,9 @@ func NewQueryerMock(t minimock.Tester) *QueryerMock {
 	m.UpdateAndGetRowsAffectedMock = mQueryerMockUpdateAndGetRowsAffected{mock: m}
 	m.UpdateAndGetRowsAffectedMock.callArgs = []*QueryerMockUpdateAndGetRowsAffectedParams{}
 
+	m.UpdateReturningMock = mQueryerMockUpdateReturning{mock: m}
+	m.UpdateReturningMock.callArgs = []*QueryerMockUpdateReturningParams{}
+
 	return m
 }
 
@@ -509,6 +527,225 @@ func (m *QueryerMock) MinimockScanOneInspect() {
 	}
 }
 
+type mQueryerMockScanOptional struct {
+	mock               *QueryerMock
+	defaultExpectation *QueryerMockScanOptionalExpectation
+	expectations       []*QueryerMockScanOptionalExpectation
+
+	callArgs []*QueryerMockScanOptionalParams
+	mutex    sync.RWMutex
+}
+
+// QueryerMockScanOptionalExpectation specifies expectation struct of the Queryer.ScanOptional
+type QueryerMockScanOptionalExpectation struct {
+	mock    *QueryerMock
+	params  *QueryerMockScanOptionalParams
+	results *QueryerMockScanOptionalResults
+	Counter uint64
+}
+
+// QueryerMockScanOptionalParams contains parameters of the Queryer.ScanOptional
+type QueryerMockScanOptionalParams struct {
+	ctx     context.Context
+	scanner mm_libsql.RowScanner
+	sql     string
+	args    []interface{}
+}
+
+// QueryerMockScanOptionalResults contains results of the Queryer.ScanOptional
+type QueryerMockScanOptionalResults struct {
+	b1  bool
+	err error
+}
+
+// Expect sets up expected params for Queryer.ScanOptional
+func (mmScanOptional *mQueryerMockScanOptional) Expect(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) *mQueryerMockScanOptional {
+	if mmScanOptional.mock.funcScanOptional != nil {
+		mmScanOptional.mock.t.Fatalf("QueryerMock.ScanOptional mock is already set by Set")
+	}
+
+	if mmScanOptional.defaultExpectation == nil {
+		mmScanOptional.defaultExpectation = &QueryerMockScanOptionalExpectation{}
+	}
+
+	mmScanOptional.defaultExpectation.params = &QueryerMockScanOptionalParams{ctx, scanner, sql, args}
+	for _, e := range mmScanOptional.expectations {
+		if minimock.Equal(e.params, mmScanOptional.defaultExpectation.params) {
+			mmScanOptional.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmScanOptional.defaultExpectation.params)
+		}
+	}
+
+	return mmScanOptional
+}
+
+// Inspect accepts an inspector function that has same arguments as the Queryer.ScanOptional
+func (mmScanOptional *mQueryerMockScanOptional) Inspect(f func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{})) *mQueryerMockScanOptional {
+	if mmScanOptional.mock.inspectFuncScanOptional != nil {
+		mmScanOptional.mock.t.Fatalf("Inspect function is already set for QueryerMock.ScanOptional")
+	}
+
+	mmScanOptional.mock.inspectFuncScanOptional = f
+
+	return mmScanOptional
+}
+
+// Return sets up results that will be returned by Queryer.ScanOptional
+func (mmScanOptional *mQueryerMockScanOptional) Return(b1 bool, err error) *QueryerMock {
+	if mmScanOptional.mock.funcScanOptional != nil {
+		mmScanOptional.mock.t.Fatalf("QueryerMock.ScanOptional mock is already set by Set")
+	}
+
+	if mmScanOptional.defaultExpectation == nil {
+		mmScanOptional.defaultExpectation = &QueryerMockScanOptionalExpectation{mock: mmScanOptional.mock}
+	}
+	mmScanOptional.defaultExpectation.results = &QueryerMockScanOptionalResults{b1, err}
+	return mmScanOptional.mock
+}
+
+//Set uses given function f to mock the Queryer.ScanOptional method
+func (mmScanOptional *mQueryerMockScanOptional) Set(f func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (b1 bool, err error)) *QueryerMock {
+	if mmScanOptional.defaultExpectation != nil {
+		mmScanOptional.mock.t.Fatalf("Default expectation is already set for the Queryer.ScanOptional method")
+	}
+
+	if len(mmScanOptional.expectations) > 0 {
+		mmScanOptional.mock.t.Fatalf("Some expectations are already set for the Queryer.ScanOptional method")
+	}
+
+	mmScanOptional.mock.funcScanOptional = f
+	return mmScanOptional.mock
+}
+
+// When sets expectation for the Queryer.ScanOptional which will trigger the result defined by the following
+// Then helper
+func (mmScanOptional *mQueryerMockScanOptional) When(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) *QueryerMockScanOptionalExpectation {
+	if mmScanOptional.mock.funcScanOptional != nil {
+		mmScanOptional.mock.t.Fatalf("QueryerMock.ScanOptional mock is already set by Set")
+	}
+
+	expectation := &QueryerMockScanOptionalExpectation{
+		mock:   mmScanOptional.mock,
+		params: &QueryerMockScanOptionalParams{ctx, scanner, sql, args},
+	}
+	mmScanOptional.expectations = append(mmScanOptional.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Queryer.ScanOptional return parameters for the expectation previously defined by the When method
+func (e *QueryerMockScanOptionalExpectation) Then(b1 bool, err error) *QueryerMock {
+	e.results = &QueryerMockScanOptionalResults{b1, err}
+	return e.mock
+}
+
+// ScanOptional implements libsql.Queryer
+func (mmScanOptional *QueryerMock) ScanOptional(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmScanOptional.beforeScanOptionalCounter, 1)
+	defer mm_atomic.AddUint64(&mmScanOptional.afterScanOptionalCounter, 1)
+
+	if mmScanOptional.inspectFuncScanOptional != nil {
+		mmScanOptional.inspectFuncScanOptional(ctx, scanner, sql, args...)
+	}
+
+	mm_params := &QueryerMockScanOptionalParams{ctx, scanner, sql, args}
+
+	// Record call args
+	mmScanOptional.ScanOptionalMock.mutex.Lock()
+	mmScanOptional.ScanOptionalMock.callArgs = append(mmScanOptional.ScanOptionalMock.callArgs, mm_params)
+	mmScanOptional.ScanOptionalMock.mutex.Unlock()
+
+	for _, e := range mmScanOptional.ScanOptionalMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmScanOptional.ScanOptionalMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmScanOptional.ScanOptionalMock.defaultExpectation.Counter, 1)
+		mm_want := mmScanOptional.ScanOptionalMock.defaultExpectation.params
+		mm_got := QueryerMockScanOptionalParams{ctx, scanner, sql, args}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmScanOptional.t.Errorf("QueryerMock.ScanOptional got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmScanOptional.ScanOptionalMock.defaultExpectation.results
+		if mm_results == nil {
+			mmScanOptional.t.Fatal("No results are set for the QueryerMock.ScanOptional")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmScanOptional.funcScanOptional != nil {
+		return mmScanOptional.funcScanOptional(ctx, scanner, sql, args...)
+	}
+	mmScanOptional.t.Fatalf("Unexpected call to QueryerMock.ScanOptional. %v %v %v %v", ctx, scanner, sql, args)
+	return
+}
+
+// ScanOptionalAfterCounter returns a count of finished QueryerMock.ScanOptional invocations
+func (mmScanOptional *QueryerMock) ScanOptionalAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScanOptional.afterScanOptionalCounter)
+}
+
+// ScanOptionalBeforeCounter returns a count of QueryerMock.ScanOptional invocations
+func (mmScanOptional *QueryerMock) ScanOptionalBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScanOptional.beforeScanOptionalCounter)
+}
+
+// Calls returns a list of arguments used in each call to QueryerMock.ScanOptional.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmScanOptional *mQueryerMockScanOptional) Calls() []*QueryerMockScanOptionalParams {
+	mmScanOptional.mutex.RLock()
+
+	argCopy := make([]*QueryerMockScanOptionalParams, len(mmScanOptional.callArgs))
+	copy(argCopy, mmScanOptional.callArgs)
+
+	mmScanOptional.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockScanOptionalDone returns true if the count of the ScanOptional invocations corresponds
+// the number of defined expectations
+func (m *QueryerMock) MinimockScanOptionalDone() bool {
+	for _, e := range m.ScanOptionalMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ScanOptionalMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterScanOptionalCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcScanOptional != nil && mm_atomic.LoadUint64(&m.afterScanOptionalCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockScanOptionalInspect logs each unmet expectation
+func (m *QueryerMock) MinimockScanOptionalInspect() {
+	for _, e := range m.ScanOptionalMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to QueryerMock.ScanOptional with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ScanOptionalMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterScanOptionalCounter) < 1 {
+		if m.ScanOptionalMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to QueryerMock.ScanOptional")
+		} else {
+			m.t.Errorf("Expected call to QueryerMock.ScanOptional with params: %#v", *m.ScanOptionalMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcScanOptional != nil && mm_atomic.LoadUint64(&m.afterScanOptionalCounter) < 1 {
+		m.t.Error("Expected call to QueryerMock.ScanOptional")
+	}
+}
+
 type mQueryerMockUpdate struct {
 	mock               *QueryerMock
 	defaultExpectation *QueryerMockUpdateExpectation
@@ -1163,6 +1400,225 @@ func (m *QueryerMock) MinimockUpdateAndGetRowsAffectedInspect() {
 	}
 }
 
+type mQueryerMockUpdateReturning struct {
+	mock               *QueryerMock
+	defaultExpectation *QueryerMockUpdateReturningExpectation
+	expectations       []*QueryerMockUpdateReturningExpectation
+
+	callArgs []*QueryerMockUpdateReturningParams
+	mutex    sync.RWMutex
+}
+
+// QueryerMockUpdateReturningExpectation specifies expectation struct of the Queryer.UpdateReturning
+type QueryerMockUpdateReturningExpectation struct {
+	mock    *QueryerMock
+	params  *QueryerMockUpdateReturningParams
+	results *QueryerMockUpdateReturningResults
+	Counter uint64
+}
+
+// QueryerMockUpdateReturningParams contains parameters of the Queryer.UpdateReturning
+type QueryerMockUpdateReturningParams struct {
+	ctx     context.Context
+	scanner mm_libsql.RowScanner
+	sql     string
+	args    []interface{}
+}
+
+// QueryerMockUpdateReturningResults contains results of the Queryer.UpdateReturning
+type QueryerMockUpdateReturningResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for Queryer.UpdateReturning
+func (mmUpdateReturning *mQueryerMockUpdateReturning) Expect(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) *mQueryerMockUpdateReturning {
+	if mmUpdateReturning.mock.funcUpdateReturning != nil {
+		mmUpdateReturning.mock.t.Fatalf("QueryerMock.UpdateReturning mock is already set by Set")
+	}
+
+	if mmUpdateReturning.defaultExpectation == nil {
+		mmUpdateReturning.defaultExpectation = &QueryerMockUpdateReturningExpectation{}
+	}
+
+	mmUpdateReturning.defaultExpectation.params = &QueryerMockUpdateReturningParams{ctx, scanner, sql, args}
+	for _, e := range mmUpdateReturning.expectations {
+		if minimock.Equal(e.params, mmUpdateReturning.defaultExpectation.params) {
+			mmUpdateReturning.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateReturning.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateReturning
+}
+
+// Inspect accepts an inspector function that has same arguments as the Queryer.UpdateReturning
+func (mmUpdateReturning *mQueryerMockUpdateReturning) Inspect(f func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{})) *mQueryerMockUpdateReturning {
+	if mmUpdateReturning.mock.inspectFuncUpdateReturning != nil {
+		mmUpdateReturning.mock.t.Fatalf("Inspect function is already set for QueryerMock.UpdateReturning")
+	}
+
+	mmUpdateReturning.mock.inspectFuncUpdateReturning = f
+
+	return mmUpdateReturning
+}
+
+// Return sets up results that will be returned by Queryer.UpdateReturning
+func (mmUpdateReturning *mQueryerMockUpdateReturning) Return(i1 int64, err error) *QueryerMock {
+	if mmUpdateReturning.mock.funcUpdateReturning != nil {
+		mmUpdateReturning.mock.t.Fatalf("QueryerMock.UpdateReturning mock is already set by Set")
+	}
+
+	if mmUpdateReturning.defaultExpectation == nil {
+		mmUpdateReturning.defaultExpectation = &QueryerMockUpdateReturningExpectation{mock: mmUpdateReturning.mock}
+	}
+	mmUpdateReturning.defaultExpectation.results = &QueryerMockUpdateReturningResults{i1, err}
+	return mmUpdateReturning.mock
+}
+
+//Set uses given function f to mock the Queryer.UpdateReturning method
+func (mmUpdateReturning *mQueryerMockUpdateReturning) Set(f func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (i1 int64, err error)) *QueryerMock {
+	if mmUpdateReturning.defaultExpectation != nil {
+		mmUpdateReturning.mock.t.Fatalf("Default expectation is already set for the Queryer.UpdateReturning method")
+	}
+
+	if len(mmUpdateReturning.expectations) > 0 {
+		mmUpdateReturning.mock.t.Fatalf("Some expectations are already set for the Queryer.UpdateReturning method")
+	}
+
+	mmUpdateReturning.mock.funcUpdateReturning = f
+	return mmUpdateReturning.mock
+}
+
+// When sets expectation for the Queryer.UpdateReturning which will trigger the result defined by the following
+// Then helper
+func (mmUpdateReturning *mQueryerMockUpdateReturning) When(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) *QueryerMockUpdateReturningExpectation {
+	if mmUpdateReturning.mock.funcUpdateReturning != nil {
+		mmUpdateReturning.mock.t.Fatalf("QueryerMock.UpdateReturning mock is already set by Set")
+	}
+
+	expectation := &QueryerMockUpdateReturningExpectation{
+		mock:   mmUpdateReturning.mock,
+		params: &QueryerMockUpdateReturningParams{ctx, scanner, sql, args},
+	}
+	mmUpdateReturning.expectations = append(mmUpdateReturning.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Queryer.UpdateReturning return parameters for the expectation previously defined by the When method
+func (e *QueryerMockUpdateReturningExpectation) Then(i1 int64, err error) *QueryerMock {
+	e.results = &QueryerMockUpdateReturningResults{i1, err}
+	return e.mock
+}
+
+// UpdateReturning implements libsql.Queryer
+func (mmUpdateReturning *QueryerMock) UpdateReturning(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmUpdateReturning.beforeUpdateReturningCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateReturning.afterUpdateReturningCounter, 1)
+
+	if mmUpdateReturning.inspectFuncUpdateReturning != nil {
+		mmUpdateReturning.inspectFuncUpdateReturning(ctx, scanner, sql, args...)
+	}
+
+	mm_params := &QueryerMockUpdateReturningParams{ctx, scanner, sql, args}
+
+	// Record call args
+	mmUpdateReturning.UpdateReturningMock.mutex.Lock()
+	mmUpdateReturning.UpdateReturningMock.callArgs = append(mmUpdateReturning.UpdateReturningMock.callArgs, mm_params)
+	mmUpdateReturning.UpdateReturningMock.mutex.Unlock()
+
+	for _, e := range mmUpdateReturning.UpdateReturningMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmUpdateReturning.UpdateReturningMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateReturning.UpdateReturningMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateReturning.UpdateReturningMock.defaultExpectation.params
+		mm_got := QueryerMockUpdateReturningParams{ctx, scanner, sql, args}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateReturning.t.Errorf("QueryerMock.UpdateReturning got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateReturning.UpdateReturningMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateReturning.t.Fatal("No results are set for the QueryerMock.UpdateReturning")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmUpdateReturning.funcUpdateReturning != nil {
+		return mmUpdateReturning.funcUpdateReturning(ctx, scanner, sql, args...)
+	}
+	mmUpdateReturning.t.Fatalf("Unexpected call to QueryerMock.UpdateReturning. %v %v %v %v", ctx, scanner, sql, args)
+	return
+}
+
+// UpdateReturningAfterCounter returns a count of finished QueryerMock.UpdateReturning invocations
+func (mmUpdateReturning *QueryerMock) UpdateReturningAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateReturning.afterUpdateReturningCounter)
+}
+
+// UpdateReturningBeforeCounter returns a count of QueryerMock.UpdateReturning invocations
+func (mmUpdateReturning *QueryerMock) UpdateReturningBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateReturning.beforeUpdateReturningCounter)
+}
+
+// Calls returns a list of arguments used in each call to QueryerMock.UpdateReturning.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateReturning *mQueryerMockUpdateReturning) Calls() []*QueryerMockUpdateReturningParams {
+	mmUpdateReturning.mutex.RLock()
+
+	argCopy := make([]*QueryerMockUpdateReturningParams, len(mmUpdateReturning.callArgs))
+	copy(argCopy, mmUpdateReturning.callArgs)
+
+	mmUpdateReturning.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateReturningDone returns true if the count of the UpdateReturning invocations corresponds
+// the number of defined expectations
+func (m *QueryerMock) MinimockUpdateReturningDone() bool {
+	for _, e := range m.UpdateReturningMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateReturningMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateReturningCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateReturning != nil && mm_atomic.LoadUint64(&m.afterUpdateReturningCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockUpdateReturningInspect logs each unmet expectation
+func (m *QueryerMock) MinimockUpdateReturningInspect() {
+	for _, e := range m.UpdateReturningMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to QueryerMock.UpdateReturning with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateReturningMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateReturningCounter) < 1 {
+		if m.UpdateReturningMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to QueryerMock.UpdateReturning")
+		} else {
+			m.t.Errorf("Expected call to QueryerMock.UpdateReturning with params: %#v", *m.UpdateReturningMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateReturning != nil && mm_atomic.LoadUint64(&m.afterUpdateReturningCounter) < 1 {
+		m.t.Error("Expected call to QueryerMock.UpdateReturning")
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *QueryerMock) MinimockFinish() {
 	if !m.minimockDone() {
@@ -1170,11 +1626,15 @@ func (m *QueryerMock) MinimockFinish() {
 
 		m.MinimockScanOneInspect()
 
+		m.MinimockScanOptionalInspect()
+
 		m.MinimockUpdateInspect()
 
 		m.MinimockUpdateAndGetLastInsertIDInspect()
 
 		m.MinimockUpdateAndGetRowsAffectedInspect()
+
+		m.MinimockUpdateReturningInspect()
 		m.t.FailNow()
 	}
 }
@@ -1200,7 +1660,9 @@ func (m *QueryerMock) minimockDone() bool {
 	return done &&
 		m.MinimockScanDone() &&
 		m.MinimockScanOneDone() &&
+		m.MinimockScanOptionalDone() &&
 		m.MinimockUpdateDone() &&
 		m.MinimockUpdateAndGetLastInsertIDDone() &&
-		m.MinimockUpdateAndGetRowsAffectedDone()
+		m.MinimockUpdateAndGetRowsAffectedDone() &&
+		m.MinimockUpdateReturningDone()
 }
@@ -0,0 +1,211 @@
+package libsqltest
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"oss.indeed.com/go/libsql"
+)
+
+// ScanResult feeds rows into the RowScanners passed to the Scan and ScanOne
+// methods of the mocks of libsqltest, e.g.
+//
+//	queryer.ScanOneMock.Set(libsqltest.ScanOneReturns(t, "Dumbo", 12).
+//		ForSQL("SELECT name, age FROM elephants WHERE id = ?").
+//		WithArgs(1).
+//		Queryer())
+//
+// The rows go through database/sql like the rows of an actual driver,
+// so the values are converted into the scanned pointers the same way as in production
+// and ScanOne fails with libsql.ErrNoRows when there are no rows.
+// Calls which do not match the expected SQL or args fail with an error describing the mismatch.
+// Rows which do not fit the scanner, because their number of columns differs from
+// the number of scanned pointers or because a value cannot be converted into its
+// pointer, fail the test with t.Fatalf, so the mocks must be called from the test goroutine.
+type ScanResult struct {
+	t    Tester
+	one  bool
+	rows *Rows
+	sql  *sqlMatcher
+	args []interface{}
+
+	once sync.Once
+	db   *FakeDB
+}
+
+// ScanReturns returns a ScanResult feeding rows to Scan
+func ScanReturns(t Tester, rows ...[]interface{}) *ScanResult {
+	return newScanResult(t, false, rows)
+}
+
+// ScanOneReturns returns a ScanResult feeding the row of values to ScanOne
+func ScanOneReturns(t Tester, row ...interface{}) *ScanResult {
+	return newScanResult(t, true, [][]interface{}{row})
+}
+
+// ScanOneReturnsNoRows returns a ScanResult making ScanOne fail with libsql.ErrNoRows
+func ScanOneReturnsNoRows(t Tester) *ScanResult {
+	return newScanResult(t, true, nil)
+}
+
+func newScanResult(t Tester, one bool, rows [][]interface{}) *ScanResult {
+	var columns []string
+	if len(rows) > 0 {
+		columns = make([]string, len(rows[0]))
+		for i := range columns {
+			columns[i] = "column" + strconv.Itoa(i+1)
+		}
+	}
+
+	r := &ScanResult{t: t, one: one, rows: NewRows(columns...)}
+	for _, row := range rows {
+		values := make([]driver.Value, len(row))
+		for i, v := range row {
+			value, err := driver.DefaultParameterConverter.ConvertValue(v)
+			if err != nil {
+				panic(fmt.Sprintf("libsqltest: converting value %d of row %d: %v", i, len(r.rows.values), err))
+			}
+			values[i] = value
+		}
+		r.rows.AddRow(values...)
+	}
+	return r
+}
+
+// ForSQL expects the SQL to be sql exactly
+func (r *ScanResult) ForSQL(sql string) *ScanResult {
+	r.sql = &sqlMatcher{
+		description: fmt.Sprintf("%q", sql),
+		match: func(query string) bool {
+			return query == sql
+		},
+	}
+	return r
+}
+
+// ForNormalizedSQL expects the SQL to be sql once runs of whitespace are collapsed,
+// e.g. the SQL of a multiline string literal matches its single line version
+func (r *ScanResult) ForNormalizedSQL(sql string) *ScanResult {
+	normalized := normalizeWhitespace(sql)
+	r.sql = &sqlMatcher{
+		description: fmt.Sprintf("%q with normalized whitespace", normalized),
+		match: func(query string) bool {
+			return normalizeWhitespace(query) == normalized
+		},
+	}
+	return r
+}
+
+// ForSQLMatching expects the SQL to match the sqlRegexp regular expression
+func (r *ScanResult) ForSQLMatching(sqlRegexp string) *ScanResult {
+	re := regexp.MustCompile(sqlRegexp)
+	r.sql = &sqlMatcher{
+		description: fmt.Sprintf("matching %q", sqlRegexp),
+		match:       re.MatchString,
+	}
+	return r
+}
+
+// WithArgs expects the scan to be performed with args.
+// The args are compared like the ones of Expectation.WithArgs.
+// Without WithArgs the scan may be performed with any arguments
+func (r *ScanResult) WithArgs(args ...interface{}) *ScanResult {
+	if args == nil {
+		args = []interface{}{}
+	}
+	r.args = args
+	return r
+}
+
+// Queryer returns the function to set the Scan or ScanOne mock of
+// QueryerMock, TransactionMock or DatabaseMock with
+func (r *ScanResult) Queryer() func(ctx context.Context, scanner libsql.RowScanner, sql string, args ...interface{}) error {
+	return func(ctx context.Context, scanner libsql.RowScanner, sql string, args ...interface{}) error {
+		if r.sql != nil && !r.sql.match(sql) {
+			return fmt.Errorf("libsqltest: unexpected scan %q: expected SQL %s", sql, r.sql.description)
+		}
+		return r.scan(ctx, scanner, sql, args)
+	}
+}
+
+// Statement returns the function to set the Scan or ScanOne mock of
+// StatementMock or PreparedStatementMock with.
+// The SQL of statements is not known to their mocks, so only the args are matched
+func (r *ScanResult) Statement() func(ctx context.Context, scanner libsql.RowScanner, args ...interface{}) error {
+	return func(ctx context.Context, scanner libsql.RowScanner, args ...interface{}) error {
+		return r.scan(ctx, scanner, "", args)
+	}
+}
+
+// scan performs the scan through a FakeDB returning the rows
+func (r *ScanResult) scan(ctx context.Context, scanner libsql.RowScanner, query string, args []interface{}) error {
+	r.t.Helper()
+	db := libsql.Wrap(r.fakeDB().DB())
+	scanner = fittingScanner{RowScanner: scanner, t: r.t, columns: len(r.rows.columns)}
+
+	var err error
+	if r.one {
+		err = db.ScanOne(ctx, scanner, query, args...)
+	} else {
+		err = db.Scan(ctx, scanner, query, args...)
+	}
+
+	var columnErr *libsql.ColumnError
+	if errors.As(err, &columnErr) {
+		r.t.Fatalf("libsqltest: the rows of the scan of %q do not fit the scanner: %v", query, err)
+	}
+	return err
+}
+
+// fakeDB returns the FakeDB returning the rows to all the scans, closed at the end of the test.
+// Its expectation is never met to be performed by each scan
+func (r *ScanResult) fakeDB() *FakeDB {
+	r.once.Do(func() {
+		r.db = &FakeDB{}
+		r.db.db = sql.OpenDB(fakeConnector{db: r.db})
+		r.t.Cleanup(func() {
+			_ = r.db.db.Close()
+		})
+		e := r.db.ExpectQuery("").WillReturnRows(r.rows)
+		e.repeated = true
+		if r.args != nil {
+			e.WithArgs(r.args...)
+		}
+	})
+	return r.db
+}
+
+// fittingScanner fails the test when the rows have another number of columns
+// than the number of pointers of the RowScanner
+type fittingScanner struct {
+	libsql.RowScanner
+
+	t       Tester
+	columns int
+}
+
+// Into implements RowScanner.Into
+func (s fittingScanner) Into() []interface{} {
+	into := s.RowScanner.Into()
+	if len(into) != s.columns {
+		s.t.Helper()
+		s.t.Fatalf("libsqltest: the rows of %d columns do not fit the scanner of %d pointers", s.columns, len(into))
+	}
+	return into
+}
+
+type sqlMatcher struct {
+	description string
+	match       func(query string) bool
+}
+
+func normalizeWhitespace(sql string) string {
+	return strings.Join(strings.Fields(sql), " ")
+}
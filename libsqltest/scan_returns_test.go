@@ -0,0 +1,119 @@
+package libsqltest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"oss.indeed.com/go/libsql"
+)
+
+func Test_ScanReturns_Queryer(t *testing.T) {
+	ctx := context.Background()
+	created := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	queryer := NewQueryerMock(t)
+	defer queryer.MinimockFinish()
+	queryer.ScanMock.Set(ScanReturns(t,
+		[]interface{}{"Dumbo", 12, created},
+		[]interface{}{[]byte("Babar"), "40", created},
+	).ForSQL("SELECT name, age, created FROM elephants WHERE age > ?").WithArgs(10).Queryer())
+
+	type elephant struct {
+		name    string
+		age     int
+		created time.Time
+	}
+	var elephants []elephant
+	var e elephant
+	err := queryer.Scan(ctx, newFuncScanner([]interface{}{&e.name, &e.age, &e.created}, func() error {
+		elephants = append(elephants, e)
+		return nil
+	}), "SELECT name, age, created FROM elephants WHERE age > ?", 10)
+	require.NoError(t, err)
+	require.Equal(t, []elephant{{"Dumbo", 12, created}, {"Babar", 40, created}}, elephants)
+}
+
+func Test_ScanOneReturns_Database(t *testing.T) {
+	ctx := context.Background()
+
+	db := NewDatabaseMock(t)
+	defer db.MinimockFinish()
+	db.ScanOneMock.Set(ScanOneReturns(t, "Dumbo", nil).ForNormalizedSQL(`
+		SELECT name, nick
+		FROM elephants
+		WHERE id = ?`).Queryer())
+
+	var name string
+	var nick *string
+	require.NoError(t, db.ScanOne(ctx, libsql.Into(&name, &nick), "SELECT name, nick FROM elephants WHERE id = ?", 1))
+	require.Equal(t, "Dumbo", name)
+	require.Nil(t, nick)
+}
+
+func Test_ScanOneReturnsNoRows_Statement(t *testing.T) {
+	ctx := context.Background()
+
+	stmt := NewStatementMock(t)
+	defer stmt.MinimockFinish()
+	stmt.ScanOneMock.Set(ScanOneReturnsNoRows(t).WithArgs(1).Statement())
+
+	var name string
+	err := stmt.ScanOne(ctx, libsql.Into(&name), 1)
+	require.ErrorIs(t, err, libsql.ErrNoRows)
+}
+
+func Test_ScanReturns_Mismatches(t *testing.T) {
+	ctx := context.Background()
+
+	queryer := NewQueryerMock(t)
+	defer queryer.MinimockFinish()
+	queryer.ScanOneMock.Set(ScanOneReturns(t, "Dumbo").ForSQLMatching(`^SELECT name FROM elephants`).WithArgs(1).Queryer())
+
+	var name string
+	err := queryer.ScanOne(ctx, libsql.Into(&name), "SELECT name FROM giraffes WHERE id = ?", 1)
+	require.Error(t, err)
+	require.Equal(t, `libsqltest: unexpected scan "SELECT name FROM giraffes WHERE id = ?": expected SQL matching "^SELECT name FROM elephants"`, err.Error())
+
+	err = queryer.ScanOne(ctx, libsql.Into(&name), "SELECT name FROM elephants WHERE id = ?", 2)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `libsqltest: unexpected query "SELECT name FROM elephants WHERE id = ?" with args [2]: expected query with args [1]: arg 0 is 2`)
+
+	err = queryer.ScanOne(ctx, libsql.Into(&name), "SELECT name FROM elephants WHERE id = ?", 1)
+	require.NoError(t, err)
+	require.Equal(t, "Dumbo", name)
+}
+
+func Test_ScanReturns_RowsNotFittingTheScanner(t *testing.T) {
+	ctx := context.Background()
+	tester := &recordingTester{}
+
+	queryer := NewQueryerMock(t)
+	defer queryer.MinimockFinish()
+	queryer.ScanOneMock.Set(ScanOneReturns(tester, "Dumbo", 12).Queryer())
+
+	var name string
+	var age int
+	tester.run(func() {
+		_ = queryer.ScanOne(ctx, libsql.Into(&name), "SELECT name, age FROM elephants")
+		require.Fail(t, "the test was not failed")
+	})
+	tester.run(func() {
+		_ = queryer.ScanOne(ctx, libsql.Into(&age, &name), "SELECT name, age FROM elephants")
+		require.Fail(t, "the test was not failed")
+	})
+	tester.run(func() {
+		require.NoError(t, queryer.ScanOne(ctx, libsql.Into(&name, &age), "SELECT name, age FROM elephants"))
+	})
+
+	require.Len(t, tester.errors, 2)
+	require.Equal(t, "libsqltest: the rows of 2 columns do not fit the scanner of 1 pointers", tester.errors[0])
+	require.Contains(t, tester.errors[1], `libsqltest: the rows of the scan of "SELECT name, age FROM elephants" do not fit the scanner: `)
+	require.Equal(t, "Dumbo", name)
+	require.Equal(t, 12, age)
+
+	tester.finish()
+	require.Len(t, tester.errors, 2)
+}
@@ -29,6 +29,12 @@ type StatementMock struct {
 	beforeScanOneCounter uint64
 	ScanOneMock          mStatementMockScanOne
 
+	funcScanOptional          func(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{}) (b1 bool, err error)
+	inspectFuncScanOptional   func(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{})
+	afterScanOptionalCounter  uint64
+	beforeScanOptionalCounter uint64
+	ScanOptionalMock          mStatementMockScanOptional
+
 	funcUpdate          func(ctx context.Context, args ...interface{}) (r1 sql.Result, err error)
 	inspectFuncUpdate   func(ctx context.Context, args ...interface{})
 	afterUpdateCounter  uint64
@@ -46,6 +52,12 @@ type StatementMock struct {
 	afterUpdateAndGetRowsAffectedCounter  uint64
 	beforeUpdateAndGetRowsAffectedCounter uint64
 	UpdateAndGetRowsAffectedMock          mStatementMockUpdateAndGetRowsAffected
+
+	funcUpdateReturning          func(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{}) (i1 int64, err error)
+	inspectFuncUpdateReturning   func(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{})
+	afterUpdateReturningCounter  uint64
+	beforeUpdateReturningCounter uint64
+	UpdateReturningMock          mStatementMockUpdateReturning
 }
 
 // NewStatementMock returns a mock for libsql.Statement
@@ -61,6 +73,9 @@ func NewStatementMock(t minimock.Tester) *StatementMock {
 	m.ScanOneMock = mStatementMockScanOne{mock: m}
 	m.ScanOneMock.callArgs = []*StatementMockScanOneParams{}
 
+	m.ScanOptionalMock = mStatementMockScanOptional{mock: m}
+	m.ScanOptionalMock.callArgs = []*StatementMockScanOptionalParams{}
+
 	m.UpdateMock = mStatementMockUpdate{mock: m}
 	m.UpdateMock.callArgs = []*StatementMockUpdateParams{}
 
@@ -70,6 +85,9 @@ func NewStatementMock(t minimock.Tester) *StatementMock {
 	m.UpdateAndGetRowsAffectedMock = mStatementMockUpdateAndGetRowsAffected{mock: m}
 	m.UpdateAndGetRowsAffectedMock.callArgs = []*StatementMockUpdateAndGetRowsAffectedParams{}
 
+	m.UpdateReturningMock = mStatementMockUpdateReturning{mock: m}
+	m.UpdateReturningMock.callArgs = []*StatementMockUpdateReturningParams{}
+
 	return m
 }
 
@@ -507,6 +525,224 @@ func (m *StatementMock) MinimockScanOneInspect() {
 	}
 }
 
+type mStatementMockScanOptional struct {
+	mock               *StatementMock
+	defaultExpectation *StatementMockScanOptionalExpectation
+	expectations       []*StatementMockScanOptionalExpectation
+
+	callArgs []*StatementMockScanOptionalParams
+	mutex    sync.RWMutex
+}
+
+// StatementMockScanOptionalExpectation specifies expectation struct of the Statement.ScanOptional
+type StatementMockScanOptionalExpectation struct {
+	mock    *StatementMock
+	params  *StatementMockScanOptionalParams
+	results *StatementMockScanOptionalResults
+	Counter uint64
+}
+
+// StatementMockScanOptionalParams contains parameters of the Statement.ScanOptional
+type StatementMockScanOptionalParams struct {
+	ctx     context.Context
+	scanner mm_libsql.RowScanner
+	args    []interface{}
+}
+
+// StatementMockScanOptionalResults contains results of the Statement.ScanOptional
+type StatementMockScanOptionalResults struct {
+	b1  bool
+	err error
+}
+
+// Expect sets up expected params for Statement.ScanOptional
+func (mmScanOptional *mStatementMockScanOptional) Expect(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{}) *mStatementMockScanOptional {
+	if mmScanOptional.mock.funcScanOptional != nil {
+		mmScanOptional.mock.t.Fatalf("StatementMock.ScanOptional mock is already set by Set")
+	}
+
+	if mmScanOptional.defaultExpectation == nil {
+		mmScanOptional.defaultExpectation = &StatementMockScanOptionalExpectation{}
+	}
+
+	mmScanOptional.defaultExpectation.params = &StatementMockScanOptionalParams{ctx, scanner, args}
+	for _, e := range mmScanOptional.expectations {
+		if minimock.Equal(e.params, mmScanOptional.defaultExpectation.params) {
+			mmScanOptional.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmScanOptional.defaultExpectation.params)
+		}
+	}
+
+	return mmScanOptional
+}
+
+// Inspect accepts an inspector function that has same arguments as the Statement.ScanOptional
+func (mmScanOptional *mStatementMockScanOptional) Inspect(f func(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{})) *mStatementMockScanOptional {
+	if mmScanOptional.mock.inspectFuncScanOptional != nil {
+		mmScanOptional.mock.t.Fatalf("Inspect function is already set for StatementMock.ScanOptional")
+	}
+
+	mmScanOptional.mock.inspectFuncScanOptional = f
+
+	return mmScanOptional
+}
+
+// Return sets up results that will be returned by Statement.ScanOptional
+func (mmScanOptional *mStatementMockScanOptional) Return(b1 bool, err error) *StatementMock {
+	if mmScanOptional.mock.funcScanOptional != nil {
+		mmScanOptional.mock.t.Fatalf("StatementMock.ScanOptional mock is already set by Set")
+	}
+
+	if mmScanOptional.defaultExpectation == nil {
+		mmScanOptional.defaultExpectation = &StatementMockScanOptionalExpectation{mock: mmScanOptional.mock}
+	}
+	mmScanOptional.defaultExpectation.results = &StatementMockScanOptionalResults{b1, err}
+	return mmScanOptional.mock
+}
+
+//Set uses given function f to mock the Statement.ScanOptional method
+func (mmScanOptional *mStatementMockScanOptional) Set(f func(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{}) (b1 bool, err error)) *StatementMock {
+	if mmScanOptional.defaultExpectation != nil {
+		mmScanOptional.mock.t.Fatalf("Default expectation is already set for the Statement.ScanOptional method")
+	}
+
+	if len(mmScanOptional.expectations) > 0 {
+		mmScanOptional.mock.t.Fatalf("Some expectations are already set for the Statement.ScanOptional method")
+	}
+
+	mmScanOptional.mock.funcScanOptional = f
+	return mmScanOptional.mock
+}
+
+// When sets expectation for the Statement.ScanOptional which will trigger the result defined by the following
+// Then helper
+func (mmScanOptional *mStatementMockScanOptional) When(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{}) *StatementMockScanOptionalExpectation {
+	if mmScanOptional.mock.funcScanOptional != nil {
+		mmScanOptional.mock.t.Fatalf("StatementMock.ScanOptional mock is already set by Set")
+	}
+
+	expectation := &StatementMockScanOptionalExpectation{
+		mock:   mmScanOptional.mock,
+		params: &StatementMockScanOptionalParams{ctx, scanner, args},
+	}
+	mmScanOptional.expectations = append(mmScanOptional.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Statement.ScanOptional return parameters for the expectation previously defined by the When method
+func (e *StatementMockScanOptionalExpectation) Then(b1 bool, err error) *StatementMock {
+	e.results = &StatementMockScanOptionalResults{b1, err}
+	return e.mock
+}
+
+// ScanOptional implements libsql.Statement
+func (mmScanOptional *StatementMock) ScanOptional(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{}) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmScanOptional.beforeScanOptionalCounter, 1)
+	defer mm_atomic.AddUint64(&mmScanOptional.afterScanOptionalCounter, 1)
+
+	if mmScanOptional.inspectFuncScanOptional != nil {
+		mmScanOptional.inspectFuncScanOptional(ctx, scanner, args...)
+	}
+
+	mm_params := &StatementMockScanOptionalParams{ctx, scanner, args}
+
+	// Record call args
+	mmScanOptional.ScanOptionalMock.mutex.Lock()
+	mmScanOptional.ScanOptionalMock.callArgs = append(mmScanOptional.ScanOptionalMock.callArgs, mm_params)
+	mmScanOptional.ScanOptionalMock.mutex.Unlock()
+
+	for _, e := range mmScanOptional.ScanOptionalMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmScanOptional.ScanOptionalMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmScanOptional.ScanOptionalMock.defaultExpectation.Counter, 1)
+		mm_want := mmScanOptional.ScanOptionalMock.defaultExpectation.params
+		mm_got := StatementMockScanOptionalParams{ctx, scanner, args}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmScanOptional.t.Errorf("StatementMock.ScanOptional got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmScanOptional.ScanOptionalMock.defaultExpectation.results
+		if mm_results == nil {
+			mmScanOptional.t.Fatal("No results are set for the StatementMock.ScanOptional")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmScanOptional.funcScanOptional != nil {
+		return mmScanOptional.funcScanOptional(ctx, scanner, args...)
+	}
+	mmScanOptional.t.Fatalf("Unexpected call to StatementMock.ScanOptional. %v %v %v", ctx, scanner, args)
+	return
+}
+
+// ScanOptionalAfterCounter returns a count of finished StatementMock.ScanOptional invocations
+func (mmScanOptional *StatementMock) ScanOptionalAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScanOptional.afterScanOptionalCounter)
+}
+
+// ScanOptionalBeforeCounter returns a count of StatementMock.ScanOptional invocations
+func (mmScanOptional *StatementMock) ScanOptionalBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScanOptional.beforeScanOptionalCounter)
+}
+
+// Calls returns a list of arguments used in each call to StatementMock.ScanOptional.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmScanOptional *mStatementMockScanOptional) Calls() []*StatementMockScanOptionalParams {
+	mmScanOptional.mutex.RLock()
+
+	argCopy := make([]*StatementMockScanOptionalParams, len(mmScanOptional.callArgs))
+	copy(argCopy, mmScanOptional.callArgs)
+
+	mmScanOptional.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockScanOptionalDone returns true if the count of the ScanOptional invocations corresponds
+// the number of defined expectations
+func (m *StatementMock) MinimockScanOptionalDone() bool {
+	for _, e := range m.ScanOptionalMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ScanOptionalMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterScanOptionalCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcScanOptional != nil && mm_atomic.LoadUint64(&m.afterScanOptionalCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockScanOptionalInspect logs each unmet expectation
+func (m *StatementMock) MinimockScanOptionalInspect() {
+	for _, e := range m.ScanOptionalMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StatementMock.ScanOptional with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ScanOptionalMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterScanOptionalCounter) < 1 {
+		if m.ScanOptionalMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StatementMock.ScanOptional")
+		} else {
+			m.t.Errorf("Expected call to StatementMock.ScanOptional with params: %#v", *m.ScanOptionalMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcScanOptional != nil && mm_atomic.LoadUint64(&m.afterScanOptionalCounter) < 1 {
+		m.t.Error("Expected call to StatementMock.ScanOptional")
+	}
+}
+
 type mStatementMockUpdate struct {
 	mock               *StatementMock
 	defaultExpectation *StatementMockUpdateExpectation
@@ -1158,6 +1394,224 @@ func (m *StatementMock) MinimockUpdateAndGetRowsAffectedInspect() {
 	}
 }
 
+type mStatementMockUpdateReturning struct {
+	mock               *StatementMock
+	defaultExpectation *StatementMockUpdateReturningExpectation
+	expectations       []*StatementMockUpdateReturningExpectation
+
+	callArgs []*StatementMockUpdateReturningParams
+	mutex    sync.RWMutex
+}
+
+// StatementMockUpdateReturningExpectation specifies expectation struct of the Statement.UpdateReturning
+type StatementMockUpdateReturningExpectation struct {
+	mock    *StatementMock
+	params  *StatementMockUpdateReturningParams
+	results *StatementMockUpdateReturningResults
+	Counter uint64
+}
+
+// StatementMockUpdateReturningParams contains parameters of the Statement.UpdateReturning
+type StatementMockUpdateReturningParams struct {
+	ctx     context.Context
+	scanner mm_libsql.RowScanner
+	args    []interface{}
+}
+
+// StatementMockUpdateReturningResults contains results of the Statement.UpdateReturning
+type StatementMockUpdateReturningResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for Statement.UpdateReturning
+func (mmUpdateReturning *mStatementMockUpdateReturning) Expect(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{}) *mStatementMockUpdateReturning {
+	if mmUpdateReturning.mock.funcUpdateReturning != nil {
+		mmUpdateReturning.mock.t.Fatalf("StatementMock.UpdateReturning mock is already set by Set")
+	}
+
+	if mmUpdateReturning.defaultExpectation == nil {
+		mmUpdateReturning.defaultExpectation = &StatementMockUpdateReturningExpectation{}
+	}
+
+	mmUpdateReturning.defaultExpectation.params = &StatementMockUpdateReturningParams{ctx, scanner, args}
+	for _, e := range mmUpdateReturning.expectations {
+		if minimock.Equal(e.params, mmUpdateReturning.defaultExpectation.params) {
+			mmUpdateReturning.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateReturning.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateReturning
+}
+
+// Inspect accepts an inspector function that has same arguments as the Statement.UpdateReturning
+func (mmUpdateReturning *mStatementMockUpdateReturning) Inspect(f func(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{})) *mStatementMockUpdateReturning {
+	if mmUpdateReturning.mock.inspectFuncUpdateReturning != nil {
+		mmUpdateReturning.mock.t.Fatalf("Inspect function is already set for StatementMock.UpdateReturning")
+	}
+
+	mmUpdateReturning.mock.inspectFuncUpdateReturning = f
+
+	return mmUpdateReturning
+}
+
+// Return sets up results that will be returned by Statement.UpdateReturning
+func (mmUpdateReturning *mStatementMockUpdateReturning) Return(i1 int64, err error) *StatementMock {
+	if mmUpdateReturning.mock.funcUpdateReturning != nil {
+		mmUpdateReturning.mock.t.Fatalf("StatementMock.UpdateReturning mock is already set by Set")
+	}
+
+	if mmUpdateReturning.defaultExpectation == nil {
+		mmUpdateReturning.defaultExpectation = &StatementMockUpdateReturningExpectation{mock: mmUpdateReturning.mock}
+	}
+	mmUpdateReturning.defaultExpectation.results = &StatementMockUpdateReturningResults{i1, err}
+	return mmUpdateReturning.mock
+}
+
+//Set uses given function f to mock the Statement.UpdateReturning method
+func (mmUpdateReturning *mStatementMockUpdateReturning) Set(f func(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{}) (i1 int64, err error)) *StatementMock {
+	if mmUpdateReturning.defaultExpectation != nil {
+		mmUpdateReturning.mock.t.Fatalf("Default expectation is already set for the Statement.UpdateReturning method")
+	}
+
+	if len(mmUpdateReturning.expectations) > 0 {
+		mmUpdateReturning.mock.t.Fatalf("Some expectations are already set for the Statement.UpdateReturning method")
+	}
+
+	mmUpdateReturning.mock.funcUpdateReturning = f
+	return mmUpdateReturning.mock
+}
+
+// When sets expectation for the Statement.UpdateReturning which will trigger the result defined by the following
+// Then helper
+func (mmUpdateReturning *mStatementMockUpdateReturning) When(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{}) *StatementMockUpdateReturningExpectation {
+	if mmUpdateReturning.mock.funcUpdateReturning != nil {
+		mmUpdateReturning.mock.t.Fatalf("StatementMock.UpdateReturning mock is already set by Set")
+	}
+
+	expectation := &StatementMockUpdateReturningExpectation{
+		mock:   mmUpdateReturning.mock,
+		params: &StatementMockUpdateReturningParams{ctx, scanner, args},
+	}
+	mmUpdateReturning.expectations = append(mmUpdateReturning.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Statement.UpdateReturning return parameters for the expectation previously defined by the When method
+func (e *StatementMockUpdateReturningExpectation) Then(i1 int64, err error) *StatementMock {
+	e.results = &StatementMockUpdateReturningResults{i1, err}
+	return e.mock
+}
+
+// UpdateReturning implements libsql.Statement
+func (mmUpdateReturning *StatementMock) UpdateReturning(ctx context.Context, scanner mm_libsql.RowScanner, args ...interface{}) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmUpdateReturning.beforeUpdateReturningCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateReturning.afterUpdateReturningCounter, 1)
+
+	if mmUpdateReturning.inspectFuncUpdateReturning != nil {
+		mmUpdateReturning.inspectFuncUpdateReturning(ctx, scanner, args...)
+	}
+
+	mm_params := &StatementMockUpdateReturningParams{ctx, scanner, args}
+
+	// Record call args
+	mmUpdateReturning.UpdateReturningMock.mutex.Lock()
+	mmUpdateReturning.UpdateReturningMock.callArgs = append(mmUpdateReturning.UpdateReturningMock.callArgs, mm_params)
+	mmUpdateReturning.UpdateReturningMock.mutex.Unlock()
+
+	for _, e := range mmUpdateReturning.UpdateReturningMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmUpdateReturning.UpdateReturningMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateReturning.UpdateReturningMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateReturning.UpdateReturningMock.defaultExpectation.params
+		mm_got := StatementMockUpdateReturningParams{ctx, scanner, args}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateReturning.t.Errorf("StatementMock.UpdateReturning got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateReturning.UpdateReturningMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateReturning.t.Fatal("No results are set for the StatementMock.UpdateReturning")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmUpdateReturning.funcUpdateReturning != nil {
+		return mmUpdateReturning.funcUpdateReturning(ctx, scanner, args...)
+	}
+	mmUpdateReturning.t.Fatalf("Unexpected call to StatementMock.UpdateReturning. %v %v %v", ctx, scanner, args)
+	return
+}
+
+// UpdateReturningAfterCounter returns a count of finished StatementMock.UpdateReturning invocations
+func (mmUpdateReturning *StatementMock) UpdateReturningAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateReturning.afterUpdateReturningCounter)
+}
+
+// UpdateReturningBeforeCounter returns a count of StatementMock.UpdateReturning invocations
+func (mmUpdateReturning *StatementMock) UpdateReturningBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateReturning.beforeUpdateReturningCounter)
+}
+
+// Calls returns a list of arguments used in each call to StatementMock.UpdateReturning.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateReturning *mStatementMockUpdateReturning) Calls() []*StatementMockUpdateReturningParams {
+	mmUpdateReturning.mutex.RLock()
+
+	argCopy := make([]*StatementMockUpdateReturningParams, len(mmUpdateReturning.callArgs))
+	copy(argCopy, mmUpdateReturning.callArgs)
+
+	mmUpdateReturning.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateReturningDone returns true if the count of the UpdateReturning invocations corresponds
+// the number of defined expectations
+func (m *StatementMock) MinimockUpdateReturningDone() bool {
+	for _, e := range m.UpdateReturningMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateReturningMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateReturningCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateReturning != nil && mm_atomic.LoadUint64(&m.afterUpdateReturningCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockUpdateReturningInspect logs each unmet expectation
+func (m *StatementMock) MinimockUpdateReturningInspect() {
+	for _, e := range m.UpdateReturningMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StatementMock.UpdateReturning with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateReturningMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateReturningCounter) < 1 {
+		if m.UpdateReturningMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to StatementMock.UpdateReturning")
+		} else {
+			m.t.Errorf("Expected call to StatementMock.UpdateReturning with params: %#v", *m.UpdateReturningMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateReturning != nil && mm_atomic.LoadUint64(&m.afterUpdateReturningCounter) < 1 {
+		m.t.Error("Expected call to StatementMock.UpdateReturning")
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *StatementMock) MinimockFinish() {
 	if !m.minimockDone() {
@@ -1165,11 +1619,15 @@ func (m *StatementMock) MinimockFinish() {
 
 		m.MinimockScanOneInspect()
 
+		m.MinimockScanOptionalInspect()
+
 		m.MinimockUpdateInspect()
 
 		m.MinimockUpdateAndGetLastInsertIDInspect()
 
 		m.MinimockUpdateAndGetRowsAffectedInspect()
+
+		m.MinimockUpdateReturningInspect()
 		m.t.FailNow()
 	}
 }
@@ -1195,7 +1653,9 @@ func (m *StatementMock) minimockDone() bool {
 	return done &&
 		m.MinimockScanDone() &&
 		m.MinimockScanOneDone() &&
+		m.MinimockScanOptionalDone() &&
 		m.MinimockUpdateDone() &&
 		m.MinimockUpdateAndGetLastInsertIDDone() &&
-		m.MinimockUpdateAndGetRowsAffectedDone()
+		m.MinimockUpdateAndGetRowsAffectedDone() &&
+		m.MinimockUpdateReturningDone()
 }
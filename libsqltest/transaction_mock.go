@@ -35,6 +35,12 @@ type TransactionMock struct {
 	beforeScanOneCounter uint64
 	ScanOneMock          mTransactionMockScanOne
 
+	funcScanOptional          func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (b1 bool, err error)
+	inspectFuncScanOptional   func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{})
+	afterScanOptionalCounter  uint64
+	beforeScanOptionalCounter uint64
+	ScanOptionalMock          mTransactionMockScanOptional
+
 	funcUpdate          func(ctx context.Context, sql string, args ...interface{}) (r1 sql.Result, err error)
 	inspectFuncUpdate   func(ctx context.Context, sql string, args ...interface{})
 	afterUpdateCounter  uint64
@@ -52,6 +58,12 @@ type TransactionMock struct {
 	afterUpdateAndGetRowsAffectedCounter  uint64
 	beforeUpdateAndGetRowsAffectedCounter uint64
 	UpdateAndGetRowsAffectedMock          mTransactionMockUpdateAndGetRowsAffected
+
+	funcUpdateReturning          func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (i1 int64, err error)
+	inspectFuncUpdateReturning   func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{})
+	afterUpdateReturningCounter  uint64
+	beforeUpdateReturningCounter uint64
+	UpdateReturningMock          mTransactionMockUpdateReturning
 }
 
 // NewTransactionMock returns a mock for libsql.Transaction
@@ -70,6 +82,9 @@ func NewTransactionMock(t minimock.Tester) *TransactionMock {
 	m.ScanOneMock = mTransactionMockScanOne{mock: m}
 	m.ScanOneMock.callArgs = []*TransactionMockScanOneParams{}
 
+	m.ScanOptionalMock = mTransactionMockScanOptional{mock: m}
+	m.ScanOptionalMock.callArgs = []*TransactionMockScanOptionalParams{}
+
 	m.UpdateMock = mTransactionMockUpdate{mock: m}
 	m.UpdateMock.callArgs = []*TransactionMockUpdateParams{}
 
@@ -79,6 +94,9 @@ func NewTransactionMock(t minimock.Tester) *TransactionMock {
 	m.UpdateAndGetRowsAffectedMock = mTransactionMockUpdateAndGetRowsAffected{mock: m}
 	m.UpdateAndGetRowsAffectedMock.callArgs = []*TransactionMockUpdateAndGetRowsAffectedParams{}
 
+	m.UpdateReturningMock = mTransactionMockUpdateReturning{mock: m}
+	m.UpdateReturningMock.callArgs = []*TransactionMockUpdateReturningParams{}
+
 	return m
 }
 
@@ -735,6 +753,225 @@ func (m *TransactionMock) MinimockScanOneInspect() {
 	}
 }
 
+type mTransactionMockScanOptional struct {
+	mock               *TransactionMock
+	defaultExpectation *TransactionMockScanOptionalExpectation
+	expectations       []*TransactionMockScanOptionalExpectation
+
+	callArgs []*TransactionMockScanOptionalParams
+	mutex    sync.RWMutex
+}
+
+// TransactionMockScanOptionalExpectation specifies expectation struct of the Transaction.ScanOptional
+type TransactionMockScanOptionalExpectation struct {
+	mock    *TransactionMock
+	params  *TransactionMockScanOptionalParams
+	results *TransactionMockScanOptionalResults
+	Counter uint64
+}
+
+// TransactionMockScanOptionalParams contains parameters of the Transaction.ScanOptional
+type TransactionMockScanOptionalParams struct {
+	ctx     context.Context
+	scanner mm_libsql.RowScanner
+	sql     string
+	args    []interface{}
+}
+
+// TransactionMockScanOptionalResults contains results of the Transaction.ScanOptional
+type TransactionMockScanOptionalResults struct {
+	b1  bool
+	err error
+}
+
+// Expect sets up expected params for Transaction.ScanOptional
+func (mmScanOptional *mTransactionMockScanOptional) Expect(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) *mTransactionMockScanOptional {
+	if mmScanOptional.mock.funcScanOptional != nil {
+		mmScanOptional.mock.t.Fatalf("TransactionMock.ScanOptional mock is already set by Set")
+	}
+
+	if mmScanOptional.defaultExpectation == nil {
+		mmScanOptional.defaultExpectation = &TransactionMockScanOptionalExpectation{}
+	}
+
+	mmScanOptional.defaultExpectation.params = &TransactionMockScanOptionalParams{ctx, scanner, sql, args}
+	for _, e := range mmScanOptional.expectations {
+		if minimock.Equal(e.params, mmScanOptional.defaultExpectation.params) {
+			mmScanOptional.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmScanOptional.defaultExpectation.params)
+		}
+	}
+
+	return mmScanOptional
+}
+
+// Inspect accepts an inspector function that has same arguments as the Transaction.ScanOptional
+func (mmScanOptional *mTransactionMockScanOptional) Inspect(f func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{})) *mTransactionMockScanOptional {
+	if mmScanOptional.mock.inspectFuncScanOptional != nil {
+		mmScanOptional.mock.t.Fatalf("Inspect function is already set for TransactionMock.ScanOptional")
+	}
+
+	mmScanOptional.mock.inspectFuncScanOptional = f
+
+	return mmScanOptional
+}
+
+// Return sets up results that will be returned by Transaction.ScanOptional
+func (mmScanOptional *mTransactionMockScanOptional) Return(b1 bool, err error) *TransactionMock {
+	if mmScanOptional.mock.funcScanOptional != nil {
+		mmScanOptional.mock.t.Fatalf("TransactionMock.ScanOptional mock is already set by Set")
+	}
+
+	if mmScanOptional.defaultExpectation == nil {
+		mmScanOptional.defaultExpectation = &TransactionMockScanOptionalExpectation{mock: mmScanOptional.mock}
+	}
+	mmScanOptional.defaultExpectation.results = &TransactionMockScanOptionalResults{b1, err}
+	return mmScanOptional.mock
+}
+
+//Set uses given function f to mock the Transaction.ScanOptional method
+func (mmScanOptional *mTransactionMockScanOptional) Set(f func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (b1 bool, err error)) *TransactionMock {
+	if mmScanOptional.defaultExpectation != nil {
+		mmScanOptional.mock.t.Fatalf("Default expectation is already set for the Transaction.ScanOptional method")
+	}
+
+	if len(mmScanOptional.expectations) > 0 {
+		mmScanOptional.mock.t.Fatalf("Some expectations are already set for the Transaction.ScanOptional method")
+	}
+
+	mmScanOptional.mock.funcScanOptional = f
+	return mmScanOptional.mock
+}
+
+// When sets expectation for the Transaction.ScanOptional which will trigger the result defined by the following
+// Then helper
+func (mmScanOptional *mTransactionMockScanOptional) When(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) *TransactionMockScanOptionalExpectation {
+	if mmScanOptional.mock.funcScanOptional != nil {
+		mmScanOptional.mock.t.Fatalf("TransactionMock.ScanOptional mock is already set by Set")
+	}
+
+	expectation := &TransactionMockScanOptionalExpectation{
+		mock:   mmScanOptional.mock,
+		params: &TransactionMockScanOptionalParams{ctx, scanner, sql, args},
+	}
+	mmScanOptional.expectations = append(mmScanOptional.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Transaction.ScanOptional return parameters for the expectation previously defined by the When method
+func (e *TransactionMockScanOptionalExpectation) Then(b1 bool, err error) *TransactionMock {
+	e.results = &TransactionMockScanOptionalResults{b1, err}
+	return e.mock
+}
+
+// ScanOptional implements libsql.Transaction
+func (mmScanOptional *TransactionMock) ScanOptional(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmScanOptional.beforeScanOptionalCounter, 1)
+	defer mm_atomic.AddUint64(&mmScanOptional.afterScanOptionalCounter, 1)
+
+	if mmScanOptional.inspectFuncScanOptional != nil {
+		mmScanOptional.inspectFuncScanOptional(ctx, scanner, sql, args...)
+	}
+
+	mm_params := &TransactionMockScanOptionalParams{ctx, scanner, sql, args}
+
+	// Record call args
+	mmScanOptional.ScanOptionalMock.mutex.Lock()
+	mmScanOptional.ScanOptionalMock.callArgs = append(mmScanOptional.ScanOptionalMock.callArgs, mm_params)
+	mmScanOptional.ScanOptionalMock.mutex.Unlock()
+
+	for _, e := range mmScanOptional.ScanOptionalMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmScanOptional.ScanOptionalMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmScanOptional.ScanOptionalMock.defaultExpectation.Counter, 1)
+		mm_want := mmScanOptional.ScanOptionalMock.defaultExpectation.params
+		mm_got := TransactionMockScanOptionalParams{ctx, scanner, sql, args}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmScanOptional.t.Errorf("TransactionMock.ScanOptional got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmScanOptional.ScanOptionalMock.defaultExpectation.results
+		if mm_results == nil {
+			mmScanOptional.t.Fatal("No results are set for the TransactionMock.ScanOptional")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmScanOptional.funcScanOptional != nil {
+		return mmScanOptional.funcScanOptional(ctx, scanner, sql, args...)
+	}
+	mmScanOptional.t.Fatalf("Unexpected call to TransactionMock.ScanOptional. %v %v %v %v", ctx, scanner, sql, args)
+	return
+}
+
+// ScanOptionalAfterCounter returns a count of finished TransactionMock.ScanOptional invocations
+func (mmScanOptional *TransactionMock) ScanOptionalAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScanOptional.afterScanOptionalCounter)
+}
+
+// ScanOptionalBeforeCounter returns a count of TransactionMock.ScanOptional invocations
+func (mmScanOptional *TransactionMock) ScanOptionalBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScanOptional.beforeScanOptionalCounter)
+}
+
+// Calls returns a list of arguments used in each call to TransactionMock.ScanOptional.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmScanOptional *mTransactionMockScanOptional) Calls() []*TransactionMockScanOptionalParams {
+	mmScanOptional.mutex.RLock()
+
+	argCopy := make([]*TransactionMockScanOptionalParams, len(mmScanOptional.callArgs))
+	copy(argCopy, mmScanOptional.callArgs)
+
+	mmScanOptional.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockScanOptionalDone returns true if the count of the ScanOptional invocations corresponds
+// the number of defined expectations
+func (m *TransactionMock) MinimockScanOptionalDone() bool {
+	for _, e := range m.ScanOptionalMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ScanOptionalMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterScanOptionalCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcScanOptional != nil && mm_atomic.LoadUint64(&m.afterScanOptionalCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockScanOptionalInspect logs each unmet expectation
+func (m *TransactionMock) MinimockScanOptionalInspect() {
+	for _, e := range m.ScanOptionalMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TransactionMock.ScanOptional with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ScanOptionalMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterScanOptionalCounter) < 1 {
+		if m.ScanOptionalMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to TransactionMock.ScanOptional")
+		} else {
+			m.t.Errorf("Expected call to TransactionMock.ScanOptional with params: %#v", *m.ScanOptionalMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcScanOptional != nil && mm_atomic.LoadUint64(&m.afterScanOptionalCounter) < 1 {
+		m.t.Error("Expected call to TransactionMock.ScanOptional")
+	}
+}
+
 type mTransactionMockUpdate struct {
 	mock               *TransactionMock
 	defaultExpectation *TransactionMockUpdateExpectation
@@ -1389,6 +1626,225 @@ func (m *TransactionMock) MinimockUpdateAndGetRowsAffectedInspect() {
 	}
 }
 
+type mTransactionMockUpdateReturning struct {
+	mock               *TransactionMock
+	defaultExpectation *TransactionMockUpdateReturningExpectation
+	expectations       []*TransactionMockUpdateReturningExpectation
+
+	callArgs []*TransactionMockUpdateReturningParams
+	mutex    sync.RWMutex
+}
+
+// TransactionMockUpdateReturningExpectation specifies expectation struct of the Transaction.UpdateReturning
+type TransactionMockUpdateReturningExpectation struct {
+	mock    *TransactionMock
+	params  *TransactionMockUpdateReturningParams
+	results *TransactionMockUpdateReturningResults
+	Counter uint64
+}
+
+// TransactionMockUpdateReturningParams contains parameters of the Transaction.UpdateReturning
+type TransactionMockUpdateReturningParams struct {
+	ctx     context.Context
+	scanner mm_libsql.RowScanner
+	sql     string
+	args    []interface{}
+}
+
+// TransactionMockUpdateReturningResults contains results of the Transaction.UpdateReturning
+type TransactionMockUpdateReturningResults struct {
+	i1  int64
+	err error
+}
+
+// Expect sets up expected params for Transaction.UpdateReturning
+func (mmUpdateReturning *mTransactionMockUpdateReturning) Expect(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) *mTransactionMockUpdateReturning {
+	if mmUpdateReturning.mock.funcUpdateReturning != nil {
+		mmUpdateReturning.mock.t.Fatalf("TransactionMock.UpdateReturning mock is already set by Set")
+	}
+
+	if mmUpdateReturning.defaultExpectation == nil {
+		mmUpdateReturning.defaultExpectation = &TransactionMockUpdateReturningExpectation{}
+	}
+
+	mmUpdateReturning.defaultExpectation.params = &TransactionMockUpdateReturningParams{ctx, scanner, sql, args}
+	for _, e := range mmUpdateReturning.expectations {
+		if minimock.Equal(e.params, mmUpdateReturning.defaultExpectation.params) {
+			mmUpdateReturning.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateReturning.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateReturning
+}
+
+// Inspect accepts an inspector function that has same arguments as the Transaction.UpdateReturning
+func (mmUpdateReturning *mTransactionMockUpdateReturning) Inspect(f func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{})) *mTransactionMockUpdateReturning {
+	if mmUpdateReturning.mock.inspectFuncUpdateReturning != nil {
+		mmUpdateReturning.mock.t.Fatalf("Inspect function is already set for TransactionMock.UpdateReturning")
+	}
+
+	mmUpdateReturning.mock.inspectFuncUpdateReturning = f
+
+	return mmUpdateReturning
+}
+
+// Return sets up results that will be returned by Transaction.UpdateReturning
+func (mmUpdateReturning *mTransactionMockUpdateReturning) Return(i1 int64, err error) *TransactionMock {
+	if mmUpdateReturning.mock.funcUpdateReturning != nil {
+		mmUpdateReturning.mock.t.Fatalf("TransactionMock.UpdateReturning mock is already set by Set")
+	}
+
+	if mmUpdateReturning.defaultExpectation == nil {
+		mmUpdateReturning.defaultExpectation = &TransactionMockUpdateReturningExpectation{mock: mmUpdateReturning.mock}
+	}
+	mmUpdateReturning.defaultExpectation.results = &TransactionMockUpdateReturningResults{i1, err}
+	return mmUpdateReturning.mock
+}
+
+//Set uses given function f to mock the Transaction.UpdateReturning method
+func (mmUpdateReturning *mTransactionMockUpdateReturning) Set(f func(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (i1 int64, err error)) *TransactionMock {
+	if mmUpdateReturning.defaultExpectation != nil {
+		mmUpdateReturning.mock.t.Fatalf("Default expectation is already set for the Transaction.UpdateReturning method")
+	}
+
+	if len(mmUpdateReturning.expectations) > 0 {
+		mmUpdateReturning.mock.t.Fatalf("Some expectations are already set for the Transaction.UpdateReturning method")
+	}
+
+	mmUpdateReturning.mock.funcUpdateReturning = f
+	return mmUpdateReturning.mock
+}
+
+// When sets expectation for the Transaction.UpdateReturning which will trigger the result defined by the following
+// Then helper
+func (mmUpdateReturning *mTransactionMockUpdateReturning) When(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) *TransactionMockUpdateReturningExpectation {
+	if mmUpdateReturning.mock.funcUpdateReturning != nil {
+		mmUpdateReturning.mock.t.Fatalf("TransactionMock.UpdateReturning mock is already set by Set")
+	}
+
+	expectation := &TransactionMockUpdateReturningExpectation{
+		mock:   mmUpdateReturning.mock,
+		params: &TransactionMockUpdateReturningParams{ctx, scanner, sql, args},
+	}
+	mmUpdateReturning.expectations = append(mmUpdateReturning.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Transaction.UpdateReturning return parameters for the expectation previously defined by the When method
+func (e *TransactionMockUpdateReturningExpectation) Then(i1 int64, err error) *TransactionMock {
+	e.results = &TransactionMockUpdateReturningResults{i1, err}
+	return e.mock
+}
+
+// UpdateReturning implements libsql.Transaction
+func (mmUpdateReturning *TransactionMock) UpdateReturning(ctx context.Context, scanner mm_libsql.RowScanner, sql string, args ...interface{}) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmUpdateReturning.beforeUpdateReturningCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateReturning.afterUpdateReturningCounter, 1)
+
+	if mmUpdateReturning.inspectFuncUpdateReturning != nil {
+		mmUpdateReturning.inspectFuncUpdateReturning(ctx, scanner, sql, args...)
+	}
+
+	mm_params := &TransactionMockUpdateReturningParams{ctx, scanner, sql, args}
+
+	// Record call args
+	mmUpdateReturning.UpdateReturningMock.mutex.Lock()
+	mmUpdateReturning.UpdateReturningMock.callArgs = append(mmUpdateReturning.UpdateReturningMock.callArgs, mm_params)
+	mmUpdateReturning.UpdateReturningMock.mutex.Unlock()
+
+	for _, e := range mmUpdateReturning.UpdateReturningMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmUpdateReturning.UpdateReturningMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateReturning.UpdateReturningMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateReturning.UpdateReturningMock.defaultExpectation.params
+		mm_got := TransactionMockUpdateReturningParams{ctx, scanner, sql, args}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateReturning.t.Errorf("TransactionMock.UpdateReturning got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateReturning.UpdateReturningMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateReturning.t.Fatal("No results are set for the TransactionMock.UpdateReturning")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmUpdateReturning.funcUpdateReturning != nil {
+		return mmUpdateReturning.funcUpdateReturning(ctx, scanner, sql, args...)
+	}
+	mmUpdateReturning.t.Fatalf("Unexpected call to TransactionMock.UpdateReturning. %v %v %v %v", ctx, scanner, sql, args)
+	return
+}
+
+// UpdateReturningAfterCounter returns a count of finished TransactionMock.UpdateReturning invocations
+func (mmUpdateReturning *TransactionMock) UpdateReturningAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateReturning.afterUpdateReturningCounter)
+}
+
+// UpdateReturningBeforeCounter returns a count of TransactionMock.UpdateReturning invocations
+func (mmUpdateReturning *TransactionMock) UpdateReturningBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateReturning.beforeUpdateReturningCounter)
+}
+
+// Calls returns a list of arguments used in each call to TransactionMock.UpdateReturning.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateReturning *mTransactionMockUpdateReturning) Calls() []*TransactionMockUpdateReturningParams {
+	mmUpdateReturning.mutex.RLock()
+
+	argCopy := make([]*TransactionMockUpdateReturningParams, len(mmUpdateReturning.callArgs))
+	copy(argCopy, mmUpdateReturning.callArgs)
+
+	mmUpdateReturning.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateReturningDone returns true if the count of the UpdateReturning invocations corresponds
+// the number of defined expectations
+func (m *TransactionMock) MinimockUpdateReturningDone() bool {
+	for _, e := range m.UpdateReturningMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateReturningMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateReturningCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateReturning != nil && mm_atomic.LoadUint64(&m.afterUpdateReturningCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockUpdateReturningInspect logs each unmet expectation
+func (m *TransactionMock) MinimockUpdateReturningInspect() {
+	for _, e := range m.UpdateReturningMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TransactionMock.UpdateReturning with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateReturningMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterUpdateReturningCounter) < 1 {
+		if m.UpdateReturningMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to TransactionMock.UpdateReturning")
+		} else {
+			m.t.Errorf("Expected call to TransactionMock.UpdateReturning with params: %#v", *m.UpdateReturningMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateReturning != nil && mm_atomic.LoadUint64(&m.afterUpdateReturningCounter) < 1 {
+		m.t.Error("Expected call to TransactionMock.UpdateReturning")
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *TransactionMock) MinimockFinish() {
 	if !m.minimockDone() {
@@ -1398,11 +1854,15 @@ func (m *TransactionMock) MinimockFinish() {
 
 		m.MinimockScanOneInspect()
 
+		m.MinimockScanOptionalInspect()
+
 		m.MinimockUpdateInspect()
 
 		m.MinimockUpdateAndGetLastInsertIDInspect()
 
 		m.MinimockUpdateAndGetRowsAffectedInspect()
+
+		m.MinimockUpdateReturningInspect()
 		m.t.FailNow()
 	}
 }
@@ -1429,7 +1889,9 @@ func (m *TransactionMock) minimockDone() bool {
 		m.MinimockPreparedDone() &&
 		m.MinimockScanDone() &&
 		m.MinimockScanOneDone() &&
+		m.MinimockScanOptionalDone() &&
 		m.MinimockUpdateDone() &&
 		m.MinimockUpdateAndGetLastInsertIDDone() &&
-		m.MinimockUpdateAndGetRowsAffectedDone()
+		m.MinimockUpdateAndGetRowsAffectedDone() &&
+		m.MinimockUpdateReturningDone()
 }
@@ -0,0 +1,368 @@
+package libsql
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"errors"
+	"expvar"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MetricsSink receives metrics of database operations and connection pools
+type MetricsSink interface {
+	// ObserveQuery records a completed operation
+	ObserveQuery(QueryObservation)
+
+	// ObservePool records a snapshot of the statistics of the named connection pool
+	ObservePool(pool string, stats sql.DBStats)
+}
+
+// QueryObservation describes a completed operation
+type QueryObservation struct {
+	Kind OperationKind
+	// Fingerprint is the operation's SQL normalized with NormalizeSQL
+	Fingerprint string
+	Duration    time.Duration
+	RowsScanned int64
+	// Err is the error of the operation, nil for ErrNoRows
+	Err error
+}
+
+// MetricsInterceptor returns an Interceptor reporting operations to sink
+func MetricsInterceptor(sink MetricsSink) Interceptor {
+	return func(ctx context.Context, op *Operation, next func(context.Context) error) error {
+		err := next(ctx)
+		observation := QueryObservation{
+			Kind:        op.Kind,
+			Fingerprint: NormalizeSQL(op.SQL),
+			Duration:    op.Duration,
+			RowsScanned: op.RowsScanned,
+		}
+		if err != nil && !errors.Is(err, ErrNoRows) {
+			observation.Err = err
+		}
+		sink.ObserveQuery(observation)
+		return err
+	}
+}
+
+// CollectPoolStats reports the statistics returned by stats, e.g. Database.Stats,
+// to sink under the given pool name every interval until ctx is done
+func CollectPoolStats(ctx context.Context, sink MetricsSink, pool string, stats func() sql.DBStats, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		sink.ObservePool(pool, stats())
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DefaultLatencyBuckets are the default upper bounds of the latency histogram buckets of Metrics
+var DefaultLatencyBuckets = []time.Duration{
+	time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+const (
+	defaultMaxFingerprints = 1000
+
+	// OtherFingerprint replaces the fingerprints of queries exceeding MetricsConfig.MaxFingerprints
+	OtherFingerprint = "other"
+)
+
+// MetricsConfig configures Metrics
+type MetricsConfig struct {
+	// LatencyBuckets are the upper bounds of the latency histogram buckets.
+	// Defaults to DefaultLatencyBuckets
+	LatencyBuckets []time.Duration
+
+	// MaxFingerprints is the maximum number of distinct query fingerprints tracked.
+	// Further fingerprints are tracked as OtherFingerprint. Defaults to 1000
+	MaxFingerprints int
+}
+
+// NewMetrics returns Metrics with the default configuration.
+// It is a shorthand for MetricsConfig{}.New()
+func NewMetrics() *Metrics {
+	return MetricsConfig{}.New()
+}
+
+// New returns Metrics with the configuration
+func (c MetricsConfig) New() *Metrics {
+	if len(c.LatencyBuckets) == 0 {
+		c.LatencyBuckets = DefaultLatencyBuckets
+	}
+	c.LatencyBuckets = append([]time.Duration(nil), c.LatencyBuckets...)
+	sort.Slice(c.LatencyBuckets, func(i, j int) bool {
+		return c.LatencyBuckets[i] < c.LatencyBuckets[j]
+	})
+	if c.MaxFingerprints <= 0 {
+		c.MaxFingerprints = defaultMaxFingerprints
+	}
+	return &Metrics{
+		cfg:     c,
+		queries: make(map[queryMetricsKey]*QueryMetrics),
+		pools:   make(map[string]sql.DBStats),
+	}
+}
+
+// Metrics is an in-memory MetricsSink aggregating metrics per operation kind
+// and query fingerprint. Metrics can be exported in the Prometheus text
+// format and published with expvar.
+// Metrics is safe for concurrent use.
+type Metrics struct {
+	cfg MetricsConfig
+
+	mu           sync.Mutex
+	queries      map[queryMetricsKey]*QueryMetrics
+	fingerprints map[string]bool
+	pools        map[string]sql.DBStats
+}
+
+var _ MetricsSink = (*Metrics)(nil)
+
+type queryMetricsKey struct {
+	kind        OperationKind
+	fingerprint string
+}
+
+// QueryMetrics are the metrics of operations of a kind with the same fingerprint
+type QueryMetrics struct {
+	Operation   string
+	Fingerprint string
+	Count       uint64
+	Errors      uint64
+	RowsScanned int64
+	DurationSum time.Duration
+	// BucketCounts are the cumulative counts of operations taking at most
+	// the corresponding MetricsConfig.LatencyBuckets
+	BucketCounts []uint64
+}
+
+// MetricsSnapshot is a copy of the metrics held by Metrics
+type MetricsSnapshot struct {
+	Queries []QueryMetrics
+	Pools   map[string]sql.DBStats
+}
+
+// ObserveQuery implements MetricsSink.ObserveQuery
+func (m *Metrics) ObserveQuery(o QueryObservation) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	fingerprint := o.Fingerprint
+	if m.fingerprints == nil {
+		m.fingerprints = make(map[string]bool)
+	}
+	if !m.fingerprints[fingerprint] {
+		if len(m.fingerprints) >= m.cfg.MaxFingerprints {
+			fingerprint = OtherFingerprint
+		} else {
+			m.fingerprints[fingerprint] = true
+		}
+	}
+
+	key := queryMetricsKey{kind: o.Kind, fingerprint: fingerprint}
+	qm, ok := m.queries[key]
+	if !ok {
+		qm = &QueryMetrics{
+			Operation:    o.Kind.String(),
+			Fingerprint:  fingerprint,
+			BucketCounts: make([]uint64, len(m.cfg.LatencyBuckets)),
+		}
+		m.queries[key] = qm
+	}
+
+	qm.Count++
+	if o.Err != nil {
+		qm.Errors++
+	}
+	qm.RowsScanned += o.RowsScanned
+	qm.DurationSum += o.Duration
+	for i, bound := range m.cfg.LatencyBuckets {
+		if o.Duration <= bound {
+			qm.BucketCounts[i]++
+		}
+	}
+}
+
+// ObservePool implements MetricsSink.ObservePool
+func (m *Metrics) ObservePool(pool string, stats sql.DBStats) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pools[pool] = stats
+}
+
+// Snapshot returns a copy of the metrics ordered by operation and fingerprint
+func (m *Metrics) Snapshot() MetricsSnapshot {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snapshot := MetricsSnapshot{
+		Queries: make([]QueryMetrics, 0, len(m.queries)),
+		Pools:   make(map[string]sql.DBStats, len(m.pools)),
+	}
+	for _, qm := range m.queries {
+		c := *qm
+		c.BucketCounts = append([]uint64(nil), qm.BucketCounts...)
+		snapshot.Queries = append(snapshot.Queries, c)
+	}
+	sort.Slice(snapshot.Queries, func(i, j int) bool {
+		qi, qj := snapshot.Queries[i], snapshot.Queries[j]
+		if qi.Operation != qj.Operation {
+			return qi.Operation < qj.Operation
+		}
+		return qi.Fingerprint < qj.Fingerprint
+	})
+	for pool, stats := range m.pools {
+		snapshot.Pools[pool] = stats
+	}
+	return snapshot
+}
+
+// PublishExpvar publishes the metrics' snapshot as an expvar variable with the given name.
+// Like expvar.Publish, it panics if the name is already in use.
+func (m *Metrics) PublishExpvar(name string) {
+	expvar.Publish(name, expvar.Func(func() interface{} {
+		return m.Snapshot()
+	}))
+}
+
+// ServeHTTP implements http.Handler serving the metrics in the Prometheus text format
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_ = m.WritePrometheus(w)
+}
+
+// WritePrometheus writes the metrics in the Prometheus text exposition format
+func (m *Metrics) WritePrometheus(w io.Writer) error {
+	snapshot := m.Snapshot()
+	pw := &promWriter{w: bufio.NewWriter(w)}
+
+	pw.header("libsql_queries_total", "counter", "Number of database operations.")
+	for _, q := range snapshot.Queries {
+		pw.sample("libsql_queries_total", queryLabels(q), strconv.FormatUint(q.Count, 10))
+	}
+	pw.header("libsql_query_errors_total", "counter", "Number of failed database operations.")
+	for _, q := range snapshot.Queries {
+		pw.sample("libsql_query_errors_total", queryLabels(q), strconv.FormatUint(q.Errors, 10))
+	}
+	pw.header("libsql_rows_scanned_total", "counter", "Number of rows scanned by database operations.")
+	for _, q := range snapshot.Queries {
+		pw.sample("libsql_rows_scanned_total", queryLabels(q), strconv.FormatInt(q.RowsScanned, 10))
+	}
+	pw.header("libsql_query_duration_seconds", "histogram", "Duration of database operations.")
+	for _, q := range snapshot.Queries {
+		labels := queryLabels(q)
+		for i, bound := range m.cfg.LatencyBuckets {
+			pw.sample("libsql_query_duration_seconds_bucket", append(labels, "le", formatSeconds(bound)), strconv.FormatUint(q.BucketCounts[i], 10))
+		}
+		pw.sample("libsql_query_duration_seconds_bucket", append(labels, "le", "+Inf"), strconv.FormatUint(q.Count, 10))
+		pw.sample("libsql_query_duration_seconds_sum", labels, formatSeconds(q.DurationSum))
+		pw.sample("libsql_query_duration_seconds_count", labels, strconv.FormatUint(q.Count, 10))
+	}
+
+	pools := make([]string, 0, len(snapshot.Pools))
+	for pool := range snapshot.Pools {
+		pools = append(pools, pool)
+	}
+	sort.Strings(pools)
+
+	poolMetrics := []struct {
+		name, kind, help string
+		value            func(sql.DBStats) string
+	}{
+		{"libsql_pool_max_open_connections", "gauge", "Maximum number of open connections.", func(s sql.DBStats) string { return strconv.Itoa(s.MaxOpenConnections) }},
+		{"libsql_pool_open_connections", "gauge", "Number of open connections.", func(s sql.DBStats) string { return strconv.Itoa(s.OpenConnections) }},
+		{"libsql_pool_in_use_connections", "gauge", "Number of connections in use.", func(s sql.DBStats) string { return strconv.Itoa(s.InUse) }},
+		{"libsql_pool_idle_connections", "gauge", "Number of idle connections.", func(s sql.DBStats) string { return strconv.Itoa(s.Idle) }},
+		{"libsql_pool_wait_count_total", "counter", "Number of connections waited for.", func(s sql.DBStats) string { return strconv.FormatInt(s.WaitCount, 10) }},
+		{"libsql_pool_wait_duration_seconds_total", "counter", "Time blocked waiting for connections.", func(s sql.DBStats) string { return formatSeconds(s.WaitDuration) }},
+		{"libsql_pool_max_idle_closed_total", "counter", "Number of connections closed due to the idle connection limit.", func(s sql.DBStats) string { return strconv.FormatInt(s.MaxIdleClosed, 10) }},
+		{"libsql_pool_max_idle_time_closed_total", "counter", "Number of connections closed due to the maximum idle time.", func(s sql.DBStats) string { return strconv.FormatInt(s.MaxIdleTimeClosed, 10) }},
+		{"libsql_pool_max_lifetime_closed_total", "counter", "Number of connections closed due to the maximum lifetime.", func(s sql.DBStats) string { return strconv.FormatInt(s.MaxLifetimeClosed, 10) }},
+	}
+	for _, pm := range poolMetrics {
+		pw.header(pm.name, pm.kind, pm.help)
+		for _, pool := range pools {
+			pw.sample(pm.name, []string{"pool", pool}, pm.value(snapshot.Pools[pool]))
+		}
+	}
+
+	return pw.flush()
+}
+
+func queryLabels(q QueryMetrics) []string {
+	return []string{"operation", q.Operation, "query", q.Fingerprint}
+}
+
+func formatSeconds(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'g', -1, 64)
+}
+
+var promLabelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// promWriter writes the Prometheus text format, remembering the first error
+type promWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (p *promWriter) header(name, kind, help string) {
+	p.printf("# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+// sample writes a sample with labels given as alternating names and values
+func (p *promWriter) sample(name string, labels []string, value string) {
+	var b strings.Builder
+	b.WriteString(name)
+	if len(labels) > 0 {
+		b.WriteByte('{')
+		for i := 0; i+1 < len(labels); i += 2 {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(labels[i])
+			b.WriteString(`="`)
+			b.WriteString(promLabelEscaper.Replace(labels[i+1]))
+			b.WriteByte('"')
+		}
+		b.WriteByte('}')
+	}
+	p.printf("%s %s\n", b.String(), value)
+}
+
+func (p *promWriter) printf(format string, args ...interface{}) {
+	if p.err == nil {
+		_, p.err = fmt.Fprintf(p.w, format, args...)
+	}
+}
+
+func (p *promWriter) flush() error {
+	if p.err != nil {
+		return p.err
+	}
+	return p.w.Flush()
+}
@@ -0,0 +1,160 @@
+package libsql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/stretchr/testify/require"
+)
+
+type recordingSink struct {
+	queries []QueryObservation
+	pools   chan sql.DBStats
+}
+
+func (s *recordingSink) ObserveQuery(o QueryObservation) {
+	s.queries = append(s.queries, o)
+}
+
+func (s *recordingSink) ObservePool(_ string, stats sql.DBStats) {
+	s.pools <- stats
+}
+
+func Test_MetricsInterceptor(t *testing.T) {
+	sink := &recordingSink{}
+	intercept := MetricsInterceptor(sink)
+
+	expErr := errors.New("a-test-error")
+	op := &Operation{Kind: OperationScan, SQL: "SELECT x FROM aTable WHERE y = 1", Duration: time.Millisecond, RowsScanned: 2}
+	require.NoError(t, intercept(context.Background(), op, func(context.Context) error { return nil }))
+	require.Equal(t, ErrNoRows, intercept(context.Background(), &Operation{Kind: OperationScanOne}, func(context.Context) error { return ErrNoRows }))
+	require.Equal(t, expErr, intercept(context.Background(), &Operation{Kind: OperationUpdate}, func(context.Context) error { return expErr }))
+
+	require.Equal(t, []QueryObservation{
+		{Kind: OperationScan, Fingerprint: "SELECT x FROM aTable WHERE y = ?", Duration: time.Millisecond, RowsScanned: 2},
+		{Kind: OperationScanOne},
+		{Kind: OperationUpdate, Err: expErr},
+	}, sink.queries)
+}
+
+func Test_CollectPoolStats(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	sink := &recordingSink{pools: make(chan sql.DBStats)}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		CollectPoolStats(ctx, sink, "primary", func() sql.DBStats {
+			return sql.DBStats{OpenConnections: 3}
+		}, time.Millisecond)
+	}()
+
+	require.Equal(t, 3, (<-sink.pools).OpenConnections)
+	require.Equal(t, 3, (<-sink.pools).OpenConnections)
+	cancel()
+	go func() {
+		for range sink.pools {
+		}
+	}()
+	<-done
+	close(sink.pools)
+}
+
+func Test_Metrics_Snapshot(t *testing.T) {
+	metrics := MetricsConfig{
+		LatencyBuckets:  []time.Duration{time.Second, time.Millisecond},
+		MaxFingerprints: 2,
+	}.New()
+
+	metrics.ObserveQuery(QueryObservation{Kind: OperationScan, Fingerprint: "SELECT ?", Duration: time.Millisecond, RowsScanned: 2})
+	metrics.ObserveQuery(QueryObservation{Kind: OperationScan, Fingerprint: "SELECT ?", Duration: 2 * time.Second, Err: errors.New("a-test-error")})
+	metrics.ObserveQuery(QueryObservation{Kind: OperationUpdate, Fingerprint: "UPDATE t SET x = ?", Duration: 10 * time.Millisecond})
+	metrics.ObserveQuery(QueryObservation{Kind: OperationUpdate, Fingerprint: "DELETE FROM t", Duration: 10 * time.Millisecond})
+	metrics.ObservePool("primary", sql.DBStats{InUse: 1})
+
+	require.Equal(t, MetricsSnapshot{
+		Queries: []QueryMetrics{
+			{Operation: "scan", Fingerprint: "SELECT ?", Count: 2, Errors: 1, RowsScanned: 2, DurationSum: 2*time.Second + time.Millisecond, BucketCounts: []uint64{1, 1}},
+			{Operation: "update", Fingerprint: "UPDATE t SET x = ?", Count: 1, DurationSum: 10 * time.Millisecond, BucketCounts: []uint64{0, 1}},
+			{Operation: "update", Fingerprint: OtherFingerprint, Count: 1, DurationSum: 10 * time.Millisecond, BucketCounts: []uint64{0, 1}},
+		},
+		Pools: map[string]sql.DBStats{"primary": {InUse: 1}},
+	}, metrics.Snapshot())
+}
+
+func Test_Metrics_WritePrometheus(t *testing.T) {
+	metrics := MetricsConfig{LatencyBuckets: []time.Duration{time.Millisecond, time.Second}}.New()
+	metrics.ObserveQuery(QueryObservation{Kind: OperationScan, Fingerprint: `SELECT "x"`, Duration: 500 * time.Millisecond, RowsScanned: 4})
+	metrics.ObservePool("primary", sql.DBStats{OpenConnections: 2, WaitDuration: 1500 * time.Millisecond})
+
+	recorder := httptest.NewRecorder()
+	metrics.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	require.Equal(t, "text/plain; version=0.0.4; charset=utf-8", recorder.Header().Get("Content-Type"))
+
+	body := recorder.Body.String()
+	for _, line := range []string{
+		"# TYPE libsql_queries_total counter",
+		`libsql_queries_total{operation="scan",query="SELECT \"x\""} 1`,
+		`libsql_query_errors_total{operation="scan",query="SELECT \"x\""} 0`,
+		`libsql_rows_scanned_total{operation="scan",query="SELECT \"x\""} 4`,
+		"# TYPE libsql_query_duration_seconds histogram",
+		`libsql_query_duration_seconds_bucket{operation="scan",query="SELECT \"x\"",le="0.001"} 0`,
+		`libsql_query_duration_seconds_bucket{operation="scan",query="SELECT \"x\"",le="1"} 1`,
+		`libsql_query_duration_seconds_bucket{operation="scan",query="SELECT \"x\"",le="+Inf"} 1`,
+		`libsql_query_duration_seconds_sum{operation="scan",query="SELECT \"x\""} 0.5`,
+		`libsql_query_duration_seconds_count{operation="scan",query="SELECT \"x\""} 1`,
+		`libsql_pool_open_connections{pool="primary"} 2`,
+		`libsql_pool_wait_duration_seconds_total{pool="primary"} 1.5`,
+	} {
+		require.Contains(t, strings.Split(body, "\n"), line)
+	}
+}
+
+var expvarTestRuns int64
+
+func Test_Metrics_PublishExpvar(t *testing.T) {
+	metrics := NewMetrics()
+	metrics.ObserveQuery(QueryObservation{Kind: OperationScan, Fingerprint: "SELECT ?"})
+	// expvar names are global, so each run of the test needs its own
+	name := fmt.Sprintf("libsql_test_metrics_%d", atomic.AddInt64(&expvarTestRuns, 1))
+	metrics.PublishExpvar(name)
+
+	var snapshot MetricsSnapshot
+	require.NoError(t, json.Unmarshal([]byte(expvar.Get(name).String()), &snapshot))
+	require.Len(t, snapshot.Queries, 1)
+	require.Equal(t, uint64(1), snapshot.Queries[0].Count)
+}
+
+func Test_Metrics_WithInterceptors(t *testing.T) {
+	ctx := context.Background()
+	const expQuery = "SELECT x FROM aTable WHERE y = ?"
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	sqlRows := newRowsMock(t, 3)
+	defer sqlRows.MinimockFinish()
+
+	sqlDB.QueryMock.Expect(ctx, expQuery, 1).Return(sqlRows, nil)
+
+	metrics := NewMetrics()
+	db := newDatabase(sqlDB, newConfig([]Option{WithInterceptors(MetricsInterceptor(metrics))}))
+
+	require.NoError(t, db.Scan(ctx, Into(), expQuery, 1))
+
+	snapshot := metrics.Snapshot()
+	require.Len(t, snapshot.Queries, 1)
+	require.Equal(t, expQuery, snapshot.Queries[0].Fingerprint)
+	require.Equal(t, uint64(1), snapshot.Queries[0].Count)
+	require.Equal(t, int64(3), snapshot.Queries[0].RowsScanned)
+}
@@ -0,0 +1,159 @@
+package libsql
+
+import (
+	"regexp"
+	"strings"
+)
+
+var placeholderListRegexp = regexp.MustCompile(`\(\?(?:, ?\?)+\)`)
+
+// NormalizeSQL returns a normalized form of sql suitable for logging and for
+// grouping queries that differ only in literal values.
+//
+// Comments, including MySQL # comments, are removed, whitespace is collapsed,
+// string literals, including E'\n' and dollar quoted $$text$$ strings, and numeric literals
+// are replaced with ? and lists of placeholders such as IN (?, ?, ?) are
+// collapsed into (?+).
+// Placeholders and quoted identifiers are preserved.
+func NormalizeSQL(sql string) string {
+	var b strings.Builder
+	b.Grow(len(sql))
+
+	pendingSpace := false
+	lastByte := byte(0)
+	writeToken := func(s string) {
+		if pendingSpace && lastByte != 0 && lastByte != '(' {
+			b.WriteByte(' ')
+		}
+		pendingSpace = false
+		b.WriteString(s)
+		lastByte = s[len(s)-1]
+	}
+
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		switch {
+		case isSpace(c):
+			pendingSpace = true
+			i++
+		case c == '-' && i+1 < len(sql) && sql[i+1] == '-', c == '#':
+			for i < len(sql) && sql[i] != '\n' {
+				i++
+			}
+			pendingSpace = true
+		case c == '/' && i+1 < len(sql) && sql[i+1] == '*':
+			end := strings.Index(sql[i+2:], "*/")
+			if end < 0 {
+				i = len(sql)
+			} else {
+				i += end + 4
+			}
+			pendingSpace = true
+		case c == '\'':
+			i = skipQuoted(sql, i, '\'')
+			writeToken("?")
+		case isStringPrefix(c) && i+1 < len(sql) && sql[i+1] == '\'':
+			// E'', N'', X'' and B'' strings
+			i = skipQuoted(sql, i+1, '\'')
+			writeToken("?")
+		case c == '$' && dollarQuoteTag(sql[i:]) != "":
+			tag := dollarQuoteTag(sql[i:])
+			end := strings.Index(sql[i+len(tag):], tag)
+			if end < 0 {
+				// an unterminated dollar quoted string runs to the end
+				i = len(sql)
+			} else {
+				i += len(tag) + end + len(tag)
+			}
+			writeToken("?")
+		case c == '"' || c == '`':
+			end := skipQuoted(sql, i, c)
+			writeToken(sql[i:end])
+			i = end
+		case isDigit(c):
+			end := i
+			for end < len(sql) && (isIdentByte(sql[end]) || sql[end] == '.') {
+				end++
+			}
+			writeToken("?")
+			i = end
+		case isIdentByte(c):
+			end := i
+			for end < len(sql) && isIdentByte(sql[end]) {
+				end++
+			}
+			writeToken(sql[i:end])
+			i = end
+		default:
+			if c == ',' || c == ')' {
+				// no space before separators and closing parentheses
+				pendingSpace = false
+			}
+			writeToken(sql[i : i+1])
+			pendingSpace = c == ','
+			i++
+		}
+	}
+
+	return placeholderListRegexp.ReplaceAllString(b.String(), "(?+)")
+}
+
+// isStringPrefix reports whether c is the prefix of a string literal, e.g. E in E'\n'
+func isStringPrefix(c byte) bool {
+	switch c {
+	case 'E', 'e', 'N', 'n', 'X', 'x', 'B', 'b':
+		return true
+	}
+	return false
+}
+
+// dollarQuoteTag returns the opening $tag$ or $$ of the dollar quoted string sql starts with, if any
+func dollarQuoteTag(sql string) string {
+	for i := 1; i < len(sql); i++ {
+		c := sql[i]
+		switch {
+		case c == '$':
+			return sql[:i+1]
+		case c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80:
+		case isDigit(c) && i > 1:
+		default:
+			// not a tag, e.g. the placeholder $1
+			return ""
+		}
+	}
+	return ""
+}
+
+// skipQuoted returns the index following the quoted literal starting at start
+func skipQuoted(sql string, start int, quote byte) int {
+	for i := start + 1; i < len(sql); i++ {
+		switch sql[i] {
+		case '\\':
+			if quote == '\'' {
+				i++
+			}
+		case quote:
+			if i+1 < len(sql) && sql[i+1] == quote {
+				// escaped quote
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(sql)
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f' || c == '\v'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// isIdentByte reports whether c can be part of an identifier or a placeholder like $1 or :name
+func isIdentByte(c byte) bool {
+	return c == '_' || c == '$' || c == '@' || c == ':' || isDigit(c) ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
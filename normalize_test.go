@@ -0,0 +1,31 @@
+package libsql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NormalizeSQL(t *testing.T) {
+	for sql, expected := range map[string]string{
+		"SELECT 1":                                               "SELECT ?",
+		"  SELECT\n\tx ,y\nFROM  aTable  ":                       "SELECT x, y FROM aTable",
+		"SELECT * FROM t WHERE name = 'O''Brien'":                "SELECT * FROM t WHERE name = ?",
+		`SELECT * FROM t WHERE name = 'a\'b' AND x = 1.5`:        "SELECT * FROM t WHERE name = ? AND x = ?",
+		"SELECT * FROM t WHERE id IN (1, 2, 3)":                  "SELECT * FROM t WHERE id IN (?+)",
+		"SELECT * FROM t WHERE id IN ( ?,?, ? )":                 "SELECT * FROM t WHERE id IN (?+)",
+		"SELECT * FROM t WHERE id = $1 AND v = $2":               "SELECT * FROM t WHERE id = $1 AND v = $2",
+		"SELECT t1.c2 FROM t1 -- trailing comment":               "SELECT t1.c2 FROM t1",
+		"SELECT /* hint */ `weird col` FROM \"T\"":               "SELECT `weird col` FROM \"T\"",
+		"INSERT INTO t (a, b) VALUES (?, 0x1F)":                  "INSERT INTO t (a, b) VALUES (?+)",
+		"SELECT COUNT(*) FROM t":                                 "SELECT COUNT(*) FROM t",
+		"SELECT a FROM t # email = bob@example.com\nWHERE b = 1": "SELECT a FROM t WHERE b = ?",
+		"SELECT $$bob's secret$$, $1":                            "SELECT ?, $1",
+		"SELECT $tag$a $$ b$tag$ FROM t WHERE c = $2":            "SELECT ? FROM t WHERE c = $2",
+		"SELECT $$unterminated secret":                           "SELECT ?",
+		`SELECT E'bob\'s secret', e'x' FROM t`:                   "SELECT ?, ? FROM t",
+		"SELECT N'bob', X'1F', B'01' FROM t":                     "SELECT ?, ?, ? FROM t",
+	} {
+		require.Equal(t, expected, NormalizeSQL(sql), sql)
+	}
+}
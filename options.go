@@ -0,0 +1,99 @@
+package libsql
+
+import (
+	"context"
+	"time"
+)
+
+// Option configures a Database returned by Wrap
+type Option func(*config)
+
+type config struct {
+	stmtCache    *StatementCache
+	interceptors []Interceptor
+	slowQueries  *slowQueryDetector
+	poolSettings []func(sqlDB)
+	leaks        *leakTracker
+	scanLimits   *ScanLimits
+	retries      *RetryPolicy
+
+	// inTransaction is set for the configuration of operations in a transaction
+	inTransaction bool
+	// txContext is the context of the transaction operation, for operations in a transaction
+	txContext context.Context
+}
+
+func newConfig(opts []Option) *config {
+	cfg := &config{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// WithMaxOpenConns sets the maximum number of open connections of the pool.
+// Refer to sql.DB.SetMaxOpenConns's godoc for details.
+func WithMaxOpenConns(n int) Option {
+	return withPoolSetting(func(db sqlDB) { db.SetMaxOpenConns(n) })
+}
+
+// WithMaxIdleConns sets the maximum number of idle connections of the pool.
+// Refer to sql.DB.SetMaxIdleConns's godoc for details.
+func WithMaxIdleConns(n int) Option {
+	return withPoolSetting(func(db sqlDB) { db.SetMaxIdleConns(n) })
+}
+
+// WithConnMaxLifetime sets the maximum amount of time a connection may be reused.
+// Refer to sql.DB.SetConnMaxLifetime's godoc for details.
+func WithConnMaxLifetime(d time.Duration) Option {
+	return withPoolSetting(func(db sqlDB) { db.SetConnMaxLifetime(d) })
+}
+
+// WithConnMaxIdleTime sets the maximum amount of time a connection may be idle.
+// Refer to sql.DB.SetConnMaxIdleTime's godoc for details.
+func WithConnMaxIdleTime(d time.Duration) Option {
+	return withPoolSetting(func(db sqlDB) { db.SetConnMaxIdleTime(d) })
+}
+
+func withPoolSetting(setting func(sqlDB)) Option {
+	return func(c *config) {
+		c.poolSettings = append(c.poolSettings, setting)
+	}
+}
+
+// configurePool applies the pool settings to db
+func (c *config) configurePool(db sqlDB) {
+	for _, setting := range c.poolSettings {
+		setting(db)
+	}
+}
+
+// wrapSQLDB decorates db according to the configuration
+func (c *config) wrapSQLDB(db sqlDB) sqlDB {
+	if c.stmtCache != nil {
+		db = c.stmtCache.wrap(db)
+	}
+	return db
+}
+
+// forTransaction returns the configuration of operations in a transaction
+func (c *config) forTransaction(ctx context.Context) *config {
+	txCfg := &config{}
+	if c != nil {
+		*txCfg = *c
+	}
+	txCfg.inTransaction = true
+	txCfg.txContext = ctx
+	txCfg.retries = nil
+	return txCfg
+}
+
+// forConnection returns the configuration of operations on a reserved connection
+func (c *config) forConnection() *config {
+	if c == nil || c.retries == nil {
+		return c
+	}
+	connCfg := *c
+	connCfg.retries = nil
+	return &connCfg
+}
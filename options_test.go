@@ -0,0 +1,41 @@
+package libsql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_config_wrapSQLDB_withoutOptions(t *testing.T) {
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	require.Equal(t, sqlDB, newConfig(nil).wrapSQLDB(sqlDB))
+}
+
+func Test_config_wrapSQLDB_withStatementCache(t *testing.T) {
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	cache := NewStatementCache(1)
+	actualDB := newConfig([]Option{WithStatementCache(cache)}).wrapSQLDB(sqlDB)
+	require.Equal(t, stmtCachingDB{sqlDB: sqlDB, cache: cache, owner: 1}, actualDB)
+}
+
+func Test_config_configurePool(t *testing.T) {
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	sqlDB.SetMaxOpenConnsMock.Expect(10).Return()
+	sqlDB.SetMaxIdleConnsMock.Expect(5).Return()
+	sqlDB.SetConnMaxLifetimeMock.Expect(time.Hour).Return()
+	sqlDB.SetConnMaxIdleTimeMock.Expect(time.Minute).Return()
+
+	newConfig([]Option{
+		WithMaxOpenConns(10),
+		WithMaxIdleConns(5),
+		WithConnMaxLifetime(time.Hour),
+		WithConnMaxIdleTime(time.Minute),
+	}).configurePool(sqlDB)
+}
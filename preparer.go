@@ -2,23 +2,49 @@ package libsql
 
 import "context"
 
-func newPreparerMixin(preparer sqlPreparer) Preparer {
-	return preparerMixin{preparer: preparer, newStatement: newStatement}
+func newPreparerMixin(preparer sqlPreparer, cfg *config) Preparer {
+	return preparerMixin{
+		preparer: preparer,
+		newStatement: func(stmt sqlStmt, sql string) Statement {
+			return newStatement(stmt, sql, cfg)
+		},
+		cfg: cfg,
+	}
 }
 
 type preparerMixin struct {
 	preparer     sqlPreparer
-	newStatement func(sqlStmt) Statement
+	newStatement func(sqlStmt, string) Statement
+	cfg          *config
 }
 
 var _ Preparer = (*preparerMixin)(nil)
 
 // Prepared implements Preparer.Prepared
 func (m preparerMixin) Prepared(ctx context.Context, sql string, work func(Statement) error) error {
-	stmt, err := m.preparer.Prepare(ctx, sql)
+	stmt, sql, err := prepare(ctx, m.preparer, sql, m.cfg)
 	if err != nil {
 		return err
 	}
 	defer stmt.Close()
-	return work(m.newStatement(stmt))
+	return work(m.newStatement(stmt, sql))
+}
+
+// prepare prepares a statement through the configured interceptors.
+// Returns the prepared statement and its SQL
+func prepare(ctx context.Context, preparer sqlPreparer, sql string, cfg *config) (sqlStmt, string, error) {
+	op := cfg.operation(OperationPrepare, sql, nil)
+	var stmt sqlStmt
+	err := cfg.intercept(ctx, op, func(ctx context.Context) error {
+		var err error
+		stmt, err = preparer.Prepare(ctx, op.SQL)
+		return err
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	if stmt == nil {
+		return nil, "", errNoStatement
+	}
+	return stmt, op.SQL, nil
 }
@@ -16,10 +16,10 @@ func Test_preparerMixin_Prepare(t *testing.T) {
 	expSQLStmt := NewSqlStmtMock(t)
 	defer expSQLStmt.MinimockFinish()
 
-	expStatement := newStatement(expSQLStmt)
-
 	expQuery := "SELECT something From somewhere"
 
+	expStatement := newStatement(expSQLStmt, expQuery, nil)
+
 	expCtx := context.Background()
 
 	sqlDB.PrepareMock.When(expCtx, expQuery).Then(expSQLStmt, (error)(nil))
@@ -27,8 +27,9 @@ func Test_preparerMixin_Prepare(t *testing.T) {
 	expSQLStmt.CloseMock.Return((error)(nil))
 
 	newStatementFuncCalls := 0
-	newStatementFunc := func(actualSQLStatement sqlStmt) Statement {
+	newStatementFunc := func(actualSQLStatement sqlStmt, actualQuery string) Statement {
 		require.Equal(t, expSQLStmt, actualSQLStatement)
+		require.Equal(t, expQuery, actualQuery)
 		newStatementFuncCalls++
 		return expStatement
 	}
@@ -71,5 +72,5 @@ func Test_preparerMixin_PrepareErrorPropagated(t *testing.T) {
 	}
 
 	actualError := mixin.Prepared(expCtx, expQuery, nil)
-	require.Equal(t, expErr, actualError)
+	require.ErrorIs(t, actualError, expErr)
 }
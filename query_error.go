@@ -0,0 +1,122 @@
+package libsql
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// QueryError is the error of a failed operation performed through a Database,
+// including operations of its Transactions, Connections and Statements.
+// It wraps the error of the operation, e.g. a driver error or ErrNoRows,
+// which can be inspected with errors.Is and errors.As.
+type QueryError struct {
+	// Op is the kind of the operation
+	Op OperationKind
+	// SQL is the SQL of the operation, empty for begin, commit, rollback, ping and conn
+	SQL string
+	// ArgCount is the number of arguments of the operation
+	ArgCount int
+	// Duration is how long the operation took until it failed
+	Duration time.Duration
+	// Err is the error of the operation
+	Err error
+}
+
+// Error implements error. The SQL is normalized with NormalizeSQL to not
+// reveal literal values, and arguments are never included.
+func (e *QueryError) Error() string {
+	var b strings.Builder
+	b.WriteString("libsql: ")
+	b.WriteString(e.Op.String())
+	if e.SQL != "" {
+		b.WriteByte(' ')
+		b.WriteString(strconv.Quote(NormalizeSQL(e.SQL)))
+	}
+	if e.ArgCount > 0 {
+		fmt.Fprintf(&b, " (%d args)", e.ArgCount)
+	}
+	fmt.Fprintf(&b, " failed after %s: %v", e.Duration, e.Err)
+	return b.String()
+}
+
+// Unwrap returns the error of the operation
+func (e *QueryError) Unwrap() error {
+	return e.Err
+}
+
+// queryError wraps a non-nil err of op started at start in a QueryError
+func queryError(op *Operation, start time.Time, err error) error {
+	if err == nil || op.Kind == OperationTransaction {
+		// transactions return the errors of their work as is
+		return err
+	}
+	if _, ok := err.(*QueryError); ok {
+		return err
+	}
+	return &QueryError{
+		Op:       op.Kind,
+		SQL:      op.SQL,
+		ArgCount: len(op.Args),
+		Duration: time.Since(start),
+		Err:      err,
+	}
+}
+
+// ColumnError is the error of scanning a column of a row
+type ColumnError struct {
+	// Index is the index of the column
+	Index int
+	// Name is the name of the column, empty if unknown
+	Name string
+	// Type is the type of the value the column was scanned into
+	Type reflect.Type
+	// Err is the error scanning the column
+	Err error
+}
+
+// Error implements error
+func (e *ColumnError) Error() string {
+	return fmt.Sprintf("libsql: scanning column %d %q into %v: %v", e.Index, e.Name, e.Type, e.Err)
+}
+
+// Unwrap returns the error scanning the column
+func (e *ColumnError) Unwrap() error {
+	return e.Err
+}
+
+// scanErrorColumnRegexp matches the column index of database/sql scan errors
+var scanErrorColumnRegexp = regexp.MustCompile(`column index (\d+)`)
+
+// columnError annotates err of scanning a row of rows into pointers with the failing column, if known
+func columnError(rows sqlRows, pointers []interface{}, err error) error {
+	index := -1
+	if m := scanErrorColumnRegexp.FindStringSubmatch(err.Error()); m != nil {
+		index, _ = strconv.Atoi(m[1])
+	} else if len(pointers) == 1 {
+		index = 0
+	}
+	if index < 0 || index >= len(pointers) {
+		return err
+	}
+
+	columns, columnsErr := rows.Columns()
+	if columnsErr == nil && len(columns) != len(pointers) {
+		// the error is about the number of columns, not about scanning one of them
+		return err
+	}
+
+	columnErr := &ColumnError{Index: index, Err: err}
+	if t := reflect.TypeOf(pointers[index]); t != nil && t.Kind() == reflect.Ptr {
+		columnErr.Type = t.Elem()
+	} else {
+		columnErr.Type = t
+	}
+	if columnsErr == nil {
+		columnErr.Name = columns[index]
+	}
+	return columnErr
+}
@@ -0,0 +1,123 @@
+package libsql
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_QueryError_Error(t *testing.T) {
+	err := &QueryError{
+		Op:       OperationScanOne,
+		SQL:      "SELECT x FROM aTable WHERE y = 'secret' AND z = ?",
+		ArgCount: 1,
+		Duration: 2 * time.Millisecond,
+		Err:      ErrNoRows,
+	}
+	require.Equal(t, `libsql: scanOne "SELECT x FROM aTable WHERE y = ? AND z = ?" (1 args) failed after 2ms: no rows, expected 1`, err.Error())
+
+	err = &QueryError{Op: OperationBegin, Err: errors.New("a-test-error")}
+	require.Equal(t, "libsql: begin failed after 0s: a-test-error", err.Error())
+}
+
+func Test_QueryError_WrapsOperationErrors(t *testing.T) {
+	ctx := context.Background()
+	const expQuery = "UPDATE aTable SET x = ? WHERE y = ?"
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	expErr := errors.New("a-test-error")
+	sqlDB.ExecMock.Return(nil, expErr)
+
+	_, err := newDatabase(sqlDB, nil).Update(ctx, expQuery, 1, 2)
+
+	var queryErr *QueryError
+	require.ErrorAs(t, err, &queryErr)
+	require.Equal(t, OperationUpdate, queryErr.Op)
+	require.Equal(t, expQuery, queryErr.SQL)
+	require.Equal(t, 2, queryErr.ArgCount)
+	require.ErrorIs(t, err, expErr)
+}
+
+func Test_QueryError_NotWrappingTransactionWork(t *testing.T) {
+	ctx := context.Background()
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	sqlTx := NewSqlTxMock(t)
+	defer sqlTx.MinimockFinish()
+
+	sqlDB.BeginMock.Return(sqlTx, nil)
+	sqlTx.RollbackMock.Return(nil)
+
+	expErr := errors.New("a-test-error")
+	err := newDatabase(sqlDB, nil).Transaction(ctx, func(Transaction) error {
+		return expErr
+	})
+	require.Equal(t, expErr, err)
+}
+
+func Test_scan_columnError(t *testing.T) {
+	rows := NewSqlRowsMock(t)
+	defer rows.MinimockFinish()
+
+	scanErr := errors.New(`sql: Scan error on column index 1, name "y": converting driver.Value type string ("a") to a int: invalid syntax`)
+	rows.NextMock.Return(true)
+	rows.ScanMock.Return(scanErr)
+	rows.ColumnsMock.Return([]string{"x", "y"}, nil)
+	rows.CloseMock.Return(nil)
+
+	var x string
+	var y int
+	err := scan(Into(&x, &y), false, nil, func() (sqlRows, error) {
+		return rows, nil
+	})
+
+	var columnErr *ColumnError
+	require.ErrorAs(t, err, &columnErr)
+	require.Equal(t, 1, columnErr.Index)
+	require.Equal(t, "y", columnErr.Name)
+	require.Equal(t, reflect.TypeOf(0), columnErr.Type)
+	require.ErrorIs(t, err, scanErr)
+	require.Equal(t, `libsql: scanning column 1 "y" into int: `+scanErr.Error(), err.Error())
+}
+
+func Test_scan_columnErrorSingleColumn(t *testing.T) {
+	rows := NewSqlRowsMock(t)
+	defer rows.MinimockFinish()
+
+	scanErr := errors.New("a-test-error")
+	rows.NextMock.Return(true)
+	rows.ScanMock.Return(scanErr)
+	rows.ColumnsMock.Return(nil, errors.New("no columns"))
+	rows.CloseMock.Return(nil)
+
+	var x time.Time
+	err := scan(Into(&x), true, nil, func() (sqlRows, error) {
+		return rows, nil
+	})
+	require.Equal(t, &ColumnError{Index: 0, Type: reflect.TypeOf(time.Time{}), Err: scanErr}, err)
+}
+
+func Test_scan_columnErrorColumnCountMismatch(t *testing.T) {
+	rows := NewSqlRowsMock(t)
+	defer rows.MinimockFinish()
+
+	scanErr := errors.New("sql: expected 2 destination arguments in Scan, not 1")
+	rows.NextMock.Return(true)
+	rows.ScanMock.Return(scanErr)
+	rows.ColumnsMock.Return([]string{"x", "y"}, nil)
+	rows.CloseMock.Return(nil)
+
+	var x string
+	err := scan(Into(&x), true, nil, func() (sqlRows, error) {
+		return rows, nil
+	})
+	require.Equal(t, scanErr, err)
+}
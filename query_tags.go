@@ -0,0 +1,134 @@
+package libsql
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+type queryTagsKey struct{}
+
+// queryTags are the tags carried by a context, with their comment rendered once
+type queryTags struct {
+	tags map[string]string
+
+	once    sync.Once
+	comment string
+}
+
+// WithQueryTags returns a copy of ctx carrying tags to be appended as a comment
+// to the SQL of operations performed with it by Databases wrapped with
+// WithQueryTagComments. The tags are merged with the ones ctx already carries,
+// the given ones taking precedence.
+func WithQueryTags(ctx context.Context, tags map[string]string) context.Context {
+	merged := make(map[string]string, len(tags))
+	if parent, ok := ctx.Value(queryTagsKey{}).(*queryTags); ok {
+		for k, v := range parent.tags {
+			merged[k] = v
+		}
+	}
+	for k, v := range tags {
+		merged[k] = v
+	}
+	return context.WithValue(ctx, queryTagsKey{}, &queryTags{tags: merged})
+}
+
+// QueryTags returns a copy of the tags carried by ctx
+func QueryTags(ctx context.Context) map[string]string {
+	t, ok := ctx.Value(queryTagsKey{}).(*queryTags)
+	if !ok {
+		return nil
+	}
+	tags := make(map[string]string, len(t.tags))
+	for k, v := range t.tags {
+		tags[k] = v
+	}
+	return tags
+}
+
+// WithQueryTagComments makes a Database append the tags carried by the context
+// of operations, see WithQueryTags, to their SQL as a comment in the sqlcommenter
+// format, e.g. SELECT 1 /*route='%2Fusers',service='api'*/.
+//
+// Queries, updates and the preparation of Statements are tagged, including in
+// transactions. The comment is appended even to SQL already containing comments,
+// such as optimizer hints. Queries and updates executed through a StatementCache
+// are not tagged: their statements are prepared once without tags and shared
+// by all the contexts executing them.
+func WithQueryTagComments() Option {
+	return WithInterceptors(tagQuery)
+}
+
+func tagQuery(ctx context.Context, op *Operation, next func(context.Context) error) error {
+	if op.Prepared || op.SQL == "" {
+		return next(ctx)
+	}
+	switch op.Kind {
+	case OperationScan, OperationScanOne, OperationUpdate, OperationPrepare:
+		if t, ok := ctx.Value(queryTagsKey{}).(*queryTags); ok {
+			op.SQL = t.tag(op.SQL)
+		}
+	}
+	return next(ctx)
+}
+
+// tag returns sql with the comment of the tags appended
+func (t *queryTags) tag(sql string) string {
+	t.once.Do(func() {
+		t.comment = sqlComment(t.tags)
+	})
+	if t.comment == "" {
+		return sql
+	}
+	sql = strings.TrimRight(sql, " \t\r\n;")
+	// a line comment would swallow the tags appended to its line
+	if strings.Contains(sql[strings.LastIndexByte(sql, '\n')+1:], "--") {
+		return sql + "\n" + t.comment
+	}
+	return sql + " " + t.comment
+}
+
+// sqlComment renders tags as a sqlcommenter comment, empty if there are no tags
+func sqlComment(tags map[string]string) string {
+	if len(tags) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString("/*")
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(sqlCommentEscape(k))
+		b.WriteString("='")
+		b.WriteString(sqlCommentEscape(tags[k]))
+		b.WriteByte('\'')
+	}
+	b.WriteString("*/")
+	return b.String()
+}
+
+// sqlCommentEscape URL-encodes s, which also encodes the quotes and the
+// characters that could end the comment, as sqlcommenter requires
+func sqlCommentEscape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+var queryTagCommentRegexp = regexp.MustCompile(`[ \n]/\*[0-9A-Za-z%._~-]+='[0-9A-Za-z%._~-]*'(?:,[0-9A-Za-z%._~-]+='[0-9A-Za-z%._~-]*')*\*/$`)
+
+// stripQueryTagComment returns sql without the comment appended by WithQueryTagComments
+func stripQueryTagComment(sql string) string {
+	if !strings.HasSuffix(sql, "*/") {
+		return sql
+	}
+	return queryTagCommentRegexp.ReplaceAllString(sql, "")
+}
@@ -0,0 +1,110 @@
+package libsql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_WithQueryTags(t *testing.T) {
+	ctx := WithQueryTags(context.Background(), map[string]string{"service": "api", "route": "/users"})
+	ctx = WithQueryTags(ctx, map[string]string{"route": "/orders", "traceparent": "00-abc-01"})
+
+	require.Equal(t, map[string]string{
+		"service":     "api",
+		"route":       "/orders",
+		"traceparent": "00-abc-01",
+	}, QueryTags(ctx))
+	require.Nil(t, QueryTags(context.Background()))
+}
+
+func Test_sqlComment(t *testing.T) {
+	require.Equal(t, "", sqlComment(nil))
+	require.Equal(t,
+		`/*a%20key='it%27s%20%2A%2F%20done',route='%2Fusers%3Fid%3D1%2C2'*/`,
+		sqlComment(map[string]string{"route": "/users?id=1,2", "a key": "it's */ done"}))
+}
+
+func Test_queryTags_tag(t *testing.T) {
+	tags := &queryTags{tags: map[string]string{"service": "api"}}
+	require.Equal(t, "SELECT 1 /*service='api'*/", tags.tag("SELECT 1"))
+	require.Equal(t, "SELECT 1 /*service='api'*/", tags.tag("SELECT 1;\n"))
+	require.Equal(t, "SELECT /*+ INDEX(t i) */ 1 /*service='api'*/", tags.tag("SELECT /*+ INDEX(t i) */ 1"))
+	require.Equal(t, "SELECT 'a' /*service='api'*/", tags.tag("SELECT 'a'"))
+	require.Equal(t, "SELECT 1 -- comment\n/*service='api'*/", tags.tag("SELECT 1 -- comment"))
+	require.Equal(t, "SELECT 1 -- comment\nFROM t /*service='api'*/", tags.tag("SELECT 1 -- comment\nFROM t"))
+}
+
+func Test_stripQueryTagComment(t *testing.T) {
+	require.Equal(t, "SELECT 1", stripQueryTagComment("SELECT 1 /*a%20key='x%27',route='%2Fusers'*/"))
+	require.Equal(t, "SELECT 1 -- comment", stripQueryTagComment("SELECT 1 -- comment\n/*route='%2Fusers'*/"))
+	require.Equal(t, "SELECT 1 /* hint */", stripQueryTagComment("SELECT 1 /* hint */"))
+	require.Equal(t, "SELECT 1", stripQueryTagComment("SELECT 1"))
+}
+
+func Test_WithQueryTagComments(t *testing.T) {
+	ctx := WithQueryTags(context.Background(), map[string]string{"service": "api"})
+	const expQuery = "UPDATE aTable SET x = ?"
+	const expTaggedQuery = "UPDATE aTable SET x = ? /*service='api'*/"
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	sqlStmt := NewSqlStmtMock(t)
+	defer sqlStmt.MinimockFinish()
+
+	sqlResult := NewSqlResultMock(t)
+	defer sqlResult.MinimockFinish()
+
+	sqlDB.ExecMock.Expect(ctx, expTaggedQuery, 1).Return(sqlResult, nil)
+	sqlDB.PrepareMock.Expect(ctx, expTaggedQuery).Return(sqlStmt, nil)
+	sqlStmt.ExecMock.Expect(ctx, 2).Return(sqlResult, nil)
+	sqlStmt.CloseMock.Return(nil)
+
+	db := newDatabase(sqlDB, newConfig([]Option{WithQueryTagComments()}))
+
+	_, err := db.Update(ctx, expQuery, 1)
+	require.NoError(t, err)
+
+	err = db.Prepared(ctx, expQuery, func(stmt Statement) error {
+		_, err := stmt.Update(ctx, 2)
+		return err
+	})
+	require.NoError(t, err)
+}
+
+func Test_WithQueryTagComments_StatementCache(t *testing.T) {
+	const expQuery = "UPDATE aTable SET x = ?"
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	stmt := NewSqlStmtMock(t)
+	defer stmt.MinimockFinish()
+
+	sqlResult := NewSqlResultMock(t)
+	defer sqlResult.MinimockFinish()
+
+	var prepared []string
+	sqlDB.PrepareMock.Set(func(_ context.Context, query string) (sqlStmt, error) {
+		prepared = append(prepared, query)
+		return stmt, nil
+	})
+	stmt.ExecMock.Set(func(context.Context, ...interface{}) (sql.Result, error) {
+		return sqlResult, nil
+	})
+
+	cache := NewStatementCache(10)
+	cfg := newConfig([]Option{WithStatementCache(cache), WithQueryTagComments()})
+	db := newDatabase(cfg.wrapSQLDB(sqlDB), cfg)
+
+	for _, route := range []string{"/users", "/users", "/orders"} {
+		ctx := WithQueryTags(context.Background(), map[string]string{"route": route})
+		_, err := db.Update(ctx, expQuery, 1)
+		require.NoError(t, err)
+	}
+	require.Equal(t, []string{expQuery}, prepared)
+	require.Equal(t, StatementCacheStats{Hits: 2, Misses: 1, Size: 1}, cache.Stats())
+}
@@ -5,30 +5,45 @@ import (
 	"database/sql"
 )
 
-func newQueryerMixin(q sqlQueryer) Queryer {
-	return queryerMixin{q: q, scan: defaultScanDoer()}
+func newQueryerMixin(q sqlQueryer, cfg *config) Queryer {
+	return queryerMixin{q: q, scan: defaultScanDoer(), cfg: cfg}
 }
 
 type queryerMixin struct {
 	q    sqlQueryer
 	scan scanDoer
+	cfg  *config
 }
 
 var _ Queryer = (*queryerMixin)(nil)
 
 // Scan implements Queryer.Scan
 func (m queryerMixin) Scan(ctx context.Context, scanner RowScanner, sql string, args ...interface{}) error {
-	return m.scan.Do(scanner, false, m.queryFunc(ctx, sql, args...))
+	op := m.cfg.operation(OperationScan, sql, args)
+	return m.cfg.interceptScan(ctx, op, scanner, func(ctx context.Context, scanner RowScanner) error {
+		return m.scan.Do(scanner, false, m.cfg.scanLimiter(ctx, op.SQL), m.queryFunc(ctx, op.SQL, op.Args...))
+	})
 }
 
 // ScanOne implements Queryer.ScanOne
 func (m queryerMixin) ScanOne(ctx context.Context, scanner RowScanner, sql string, args ...interface{}) error {
-	return m.scan.Do(scanner, true, m.queryFunc(ctx, sql, args...))
+	op := m.cfg.operation(OperationScanOne, sql, args)
+	return m.cfg.interceptScan(ctx, op, scanner, func(ctx context.Context, scanner RowScanner) error {
+		return noRowsOf(m.scan.Do(scanner, true, m.cfg.scanLimiter(ctx, op.SQL), m.queryFunc(ctx, op.SQL, op.Args...)), op.SQL)
+	})
+}
+
+// ScanOptional implements Queryer.ScanOptional
+func (m queryerMixin) ScanOptional(ctx context.Context, scanner RowScanner, sql string, args ...interface{}) (bool, error) {
+	return found(m.ScanOne(ctx, scanner, sql, args...))
 }
 
 // Update implements Queryer.Update
-func (m queryerMixin) Update(ctx context.Context, sql string, args ...interface{}) (sql.Result, error) {
-	return m.q.Exec(ctx, sql, args...)
+func (m queryerMixin) Update(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	op := m.cfg.operation(OperationUpdate, query, args)
+	return m.cfg.interceptUpdate(ctx, op, func(ctx context.Context) (sql.Result, error) {
+		return m.q.Exec(ctx, op.SQL, driverArgs(op.Args)...)
+	})
 }
 
 // UpdateAndGetRowsAffected implements Queryer.UpdateAndGetRowsAffected
@@ -41,8 +56,20 @@ func (m queryerMixin) UpdateAndGetLastInsertID(ctx context.Context, sql string,
 	return lastInsertID(m.Update(ctx, sql, args...))
 }
 
+// UpdateReturning implements Queryer.UpdateReturning
+func (m queryerMixin) UpdateReturning(ctx context.Context, scanner RowScanner, sql string, args ...interface{}) (int64, error) {
+	op := m.cfg.operation(OperationUpdate, sql, args)
+	var rowsAffected int64
+	err := m.cfg.interceptScan(ctx, op, scanner, func(ctx context.Context, scanner RowScanner) error {
+		var err error
+		rowsAffected, err = updateReturning(m.scan, scanner, m.queryFunc(ctx, op.SQL, op.Args...))
+		return err
+	})
+	return rowsAffected, err
+}
+
 func (m queryerMixin) queryFunc(ctx context.Context, sql string, args ...interface{}) func() (sqlRows, error) {
 	return func() (sqlRows, error) {
-		return m.q.Query(ctx, sql, args...)
+		return m.q.Query(ctx, sql, driverArgs(args)...)
 	}
 }
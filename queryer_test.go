@@ -2,6 +2,8 @@ package libsql
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -46,6 +48,39 @@ func (s *QueryerMixinSuite) TestScanOne() {
 	s.doTestScan(s.mixin.ScanOne, true)
 }
 
+func (s *QueryerMixinSuite) TestScanOne_noRows() {
+	s.scan.DoMock.Return(ErrNoRows)
+
+	err := s.mixin.ScanOne(context.Background(), Into(), "SELECT x FROM aTable WHERE y = 'a'")
+	var noRows *NoRowsError
+	s.Require().ErrorAs(err, &noRows)
+	s.Require().Equal("SELECT x FROM aTable WHERE y = ?", noRows.Fingerprint)
+	s.Require().ErrorIs(err, ErrNoRows)
+	s.Require().ErrorIs(err, sql.ErrNoRows)
+}
+
+func (s *QueryerMixinSuite) TestScanOptional() {
+	expErr := errors.New("a-test-error")
+	results := []error{ErrNoRows, nil, expErr}
+	s.scan.DoMock.Set(func(rowScanner RowScanner, oneRow bool, limits *scanLimiter, query func() (sqlRows, error)) (err error) {
+		s.Require().True(oneRow)
+		err, results = results[0], results[1:]
+		return err
+	})
+
+	found, err := s.mixin.ScanOptional(context.Background(), Into(), "SELECT 1")
+	s.Require().NoError(err)
+	s.Require().False(found)
+
+	found, err = s.mixin.ScanOptional(context.Background(), Into(), "SELECT 1")
+	s.Require().NoError(err)
+	s.Require().True(found)
+
+	found, err = s.mixin.ScanOptional(context.Background(), Into(), "SELECT 1")
+	s.Require().ErrorIs(err, expErr)
+	s.Require().False(found)
+}
+
 func (s *QueryerMixinSuite) TestUpdate() {
 	sqlResultMock := NewSqlResultMock(s.T())
 	defer sqlResultMock.MinimockFinish()
@@ -116,6 +151,36 @@ func (s *QueryerMixinSuite) TestUpdateAndGetLastInsertID() {
 	s.Require().Equal(expectedLastInsertID, actualLastInsertID)
 }
 
+func (s *QueryerMixinSuite) TestUpdateReturning() {
+	expRowScanner := NewRowScannerMock(s.T())
+	defer expRowScanner.MinimockFinish()
+
+	expSqlRows := NewSqlRowsMock(s.T())
+	defer expSqlRows.MinimockFinish()
+
+	expCtx := context.Background()
+	expQuery := "INSERT INTO aTable (x) VALUES (?), (?) RETURNING id"
+	expArgs := []interface{}{1, 94}
+
+	s.queryer.QueryMock.When(
+		expCtx, expQuery, expArgs...,
+	).Then(expSqlRows, (error)(nil))
+
+	expRowScanner.RowScannedMock.Return((error)(nil))
+
+	s.scan.DoMock.Set(func(rowScanner RowScanner, oneRow bool, limits *scanLimiter, query func() (sqlRows, error)) (err error) {
+		s.Require().False(oneRow)
+		_, _ = query()
+		s.Require().NoError(rowScanner.RowScanned())
+		s.Require().NoError(rowScanner.RowScanned())
+		return (error)(nil)
+	})
+
+	actualRowsAffected, err := s.mixin.UpdateReturning(expCtx, expRowScanner, expQuery, expArgs...)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(2), actualRowsAffected)
+}
+
 func (s *QueryerMixinSuite) doTestScan(
 	scan func(context.Context, RowScanner, string, ...interface{}) error,
 	expectedOneRow bool,
@@ -135,7 +200,7 @@ func (s *QueryerMixinSuite) doTestScan(
 		expCtx, expQuery, expArgs...,
 	).Then(expSqlRows, (error)(nil))
 
-	s.scan.DoMock.Set(func(rowScanner RowScanner, oneRow bool, query func() (sqlRows, error)) (err error) {
+	s.scan.DoMock.Set(func(rowScanner RowScanner, oneRow bool, limits *scanLimiter, query func() (sqlRows, error)) (err error) {
 		s.Require().Equal(expRowScanner, rowScanner)
 		s.Require().Equal(expectedOneRow, oneRow)
 		// execute query and and the assertion is that the call is
@@ -0,0 +1,108 @@
+package libsql
+
+import (
+	"context"
+	"time"
+)
+
+// RetryPolicy retries reads of a Database failing with transient errors.
+//
+// Only Scan and ScanOne performed on the Database, directly or with its
+// Statements, are retried: updates are never retried, and neither are reads
+// in a Transaction or on a Connection since their connection is the failing one.
+// Each attempt is performed through the interceptors, with Operation.Attempt set.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts of a read, including the first one. Defaults to 3
+	MaxAttempts int
+
+	// Backoff is the delay before the first retry, doubled before each subsequent retry. Defaults to 10ms
+	Backoff time.Duration
+
+	// MaxBackoff is the maximum delay before a retry. Defaults to 1s
+	MaxBackoff time.Duration
+
+	// Retryable reports whether a read failing with an error is retried. Defaults to IsConnectionError
+	Retryable func(error) bool
+
+	// OnRetry, if set, is called before retrying a failed attempt
+	OnRetry func(op *Operation, err error)
+}
+
+// ResettableScanner is a RowScanner which can discard the rows it scanned.
+// The RowScanner of a retried read is reset before each retry if it implements
+// ResettableScanner. Otherwise the read is not retried once it scanned rows.
+type ResettableScanner interface {
+	RowScanner
+
+	// Reset discards the rows scanned so far
+	Reset()
+}
+
+// WithRetryPolicy retries the reads of a Database according to policy
+func WithRetryPolicy(policy RetryPolicy) Option {
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = 3
+	}
+	if policy.Backoff <= 0 {
+		policy.Backoff = 10 * time.Millisecond
+	}
+	if policy.MaxBackoff <= 0 {
+		policy.MaxBackoff = time.Second
+	}
+	if policy.Retryable == nil {
+		policy.Retryable = IsConnectionError
+	}
+	return func(c *config) {
+		c.retries = &policy
+	}
+}
+
+// retrying reports whether op is retried
+func (c *config) retrying(op *Operation) bool {
+	return c != nil && c.retries != nil && (op.Kind == OperationScan || op.Kind == OperationScanOne)
+}
+
+// do performs the read op with scanner, by calling attempt until it succeeds or is not retried
+func (p *RetryPolicy) do(ctx context.Context, op *Operation, scanner RowScanner, attempt func(RowScanner) error) error {
+	resettable, _ := scanner.(ResettableScanner)
+	initial := *op
+	backoff := p.Backoff
+	for {
+		counter := &countingScanner{RowScanner: scanner}
+		err := attempt(counter)
+		if err == nil || op.Attempt >= p.MaxAttempts || !p.Retryable(err) ||
+			(counter.rowsScanned > 0 && resettable == nil) {
+			return err
+		}
+
+		if p.OnRetry != nil {
+			p.OnRetry(op, err)
+		}
+		if !sleep(ctx, backoff) {
+			return err
+		}
+		backoff *= 2
+		if backoff > p.MaxBackoff {
+			backoff = p.MaxBackoff
+		}
+
+		if resettable != nil {
+			resettable.Reset()
+		}
+		attempts := op.Attempt
+		*op = initial
+		op.Attempt = attempts + 1
+	}
+}
+
+// sleep waits for d, returning false if ctx is done first
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,198 @@
+package libsql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// resettableScanner counts the rows scanned since it was last reset
+type resettableScanner struct {
+	rows   int
+	resets int
+}
+
+var _ ResettableScanner = (*resettableScanner)(nil)
+
+// Into implements RowScanner.Into
+func (s *resettableScanner) Into() []interface{} {
+	return nil
+}
+
+// RowScanned implements RowScanner.RowScanned
+func (s *resettableScanner) RowScanned() error {
+	s.rows++
+	return nil
+}
+
+// Reset implements ResettableScanner.Reset
+func (s *resettableScanner) Reset() {
+	s.rows = 0
+	s.resets++
+}
+
+// newFailingRowsMock returns rows failing with err after returning rows
+func newFailingRowsMock(t *testing.T, rows int, err error) *SqlRowsMock {
+	failing := newRowsMock(t, rows)
+	failing.ErrMock.Return(err)
+	return failing
+}
+
+var testRetryPolicy = RetryPolicy{Backoff: time.Nanosecond}
+
+func Test_WithRetryPolicy_RetriesReads(t *testing.T) {
+	ctx := context.Background()
+	const expQuery = "SELECT x FROM aTable"
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	rows := newRowsMock(t, 2)
+	defer rows.MinimockFinish()
+
+	queries := 0
+	sqlDB.QueryMock.Set(func(context.Context, string, ...interface{}) (sqlRows, error) {
+		queries++
+		if queries == 1 {
+			return nil, driver.ErrBadConn
+		}
+		return rows, nil
+	})
+
+	var attempts []int
+	var retried []error
+	policy := testRetryPolicy
+	policy.OnRetry = func(op *Operation, err error) {
+		retried = append(retried, err)
+	}
+	recordAttempts := func(ctx context.Context, op *Operation, next func(context.Context) error) error {
+		attempts = append(attempts, op.Attempt)
+		return next(ctx)
+	}
+	db := newDatabase(sqlDB, newConfig([]Option{WithRetryPolicy(policy), WithInterceptors(recordAttempts)}))
+
+	scanner := &resettableScanner{}
+	require.NoError(t, db.Scan(ctx, scanner, expQuery))
+	require.Equal(t, 2, scanner.rows)
+	require.Equal(t, []int{1, 2}, attempts)
+	require.Len(t, retried, 1)
+	require.ErrorIs(t, retried[0], driver.ErrBadConn)
+}
+
+func Test_WithRetryPolicy_ResetsScanner(t *testing.T) {
+	ctx := context.Background()
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	failing := newFailingRowsMock(t, 1, driver.ErrBadConn)
+	defer failing.MinimockFinish()
+
+	succeeding := newRowsMock(t, 1)
+	defer succeeding.MinimockFinish()
+
+	queries := 0
+	sqlDB.QueryMock.Set(func(context.Context, string, ...interface{}) (sqlRows, error) {
+		queries++
+		if queries == 1 {
+			return failing, nil
+		}
+		return succeeding, nil
+	})
+
+	db := newDatabase(sqlDB, newConfig([]Option{WithRetryPolicy(testRetryPolicy)}))
+
+	scanner := &resettableScanner{}
+	require.NoError(t, db.ScanOne(ctx, scanner, "SELECT x FROM aTable"))
+	require.Equal(t, 1, scanner.rows)
+	require.Equal(t, 1, scanner.resets)
+}
+
+func Test_WithRetryPolicy_NotRetryingScannedRows(t *testing.T) {
+	ctx := context.Background()
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	rows := newFailingRowsMock(t, 1, driver.ErrBadConn)
+	defer rows.MinimockFinish()
+
+	sqlDB.QueryMock.Return(rows, nil)
+
+	db := newDatabase(sqlDB, newConfig([]Option{WithRetryPolicy(testRetryPolicy)}))
+
+	err := db.Scan(ctx, Into(), "SELECT x FROM aTable")
+	require.ErrorIs(t, err, driver.ErrBadConn)
+	require.Equal(t, uint64(1), sqlDB.QueryAfterCounter())
+}
+
+func Test_WithRetryPolicy_MaxAttempts(t *testing.T) {
+	ctx := context.Background()
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	sqlDB.QueryMock.Return(nil, driver.ErrBadConn)
+
+	policy := testRetryPolicy
+	policy.MaxAttempts = 2
+	db := newDatabase(sqlDB, newConfig([]Option{WithRetryPolicy(policy)}))
+
+	err := db.ScanOne(ctx, Into(), "SELECT x FROM aTable")
+	require.ErrorIs(t, err, driver.ErrBadConn)
+	require.Equal(t, uint64(2), sqlDB.QueryAfterCounter())
+}
+
+func Test_WithRetryPolicy_NotRetrying(t *testing.T) {
+	ctx := context.Background()
+	expErr := errors.New("a-test-error")
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	sqlTx := NewSqlTxMock(t)
+	defer sqlTx.MinimockFinish()
+
+	sqlDB.QueryMock.Return(nil, expErr)
+	sqlDB.ExecMock.Return(nil, driver.ErrBadConn)
+	sqlDB.BeginMock.Return(sqlTx, nil)
+	sqlTx.QueryMock.Return(nil, driver.ErrBadConn)
+	sqlTx.RollbackMock.Return(nil)
+
+	db := newDatabase(sqlDB, newConfig([]Option{WithRetryPolicy(testRetryPolicy)}))
+
+	// errors which are not retryable
+	require.ErrorIs(t, db.Scan(ctx, Into(), "SELECT x FROM aTable"), expErr)
+	require.Equal(t, uint64(1), sqlDB.QueryAfterCounter())
+
+	// updates
+	_, err := db.Update(ctx, "UPDATE aTable SET x = 1")
+	require.ErrorIs(t, err, driver.ErrBadConn)
+	require.Equal(t, uint64(1), sqlDB.ExecAfterCounter())
+
+	// reads in transactions
+	err = db.Transaction(ctx, func(tx Transaction) error {
+		return tx.Scan(ctx, Into(), "SELECT x FROM aTable")
+	})
+	require.ErrorIs(t, err, driver.ErrBadConn)
+	require.Equal(t, uint64(1), sqlTx.QueryAfterCounter())
+}
+
+func Test_WithRetryPolicy_StopsWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	sqlDB.QueryMock.Return(nil, driver.ErrBadConn)
+
+	policy := RetryPolicy{Backoff: time.Hour, OnRetry: func(*Operation, error) { cancel() }}
+	db := newDatabase(sqlDB, newConfig([]Option{WithRetryPolicy(policy)}))
+
+	require.ErrorIs(t, db.Scan(ctx, Into(), "SELECT x FROM aTable"), driver.ErrBadConn)
+	require.Equal(t, uint64(1), sqlDB.QueryAfterCounter())
+}
@@ -12,28 +12,28 @@ import (
 //go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i scanDoer -o scan_mock_test.go
 
 type scanDoer interface {
-	// Do scans row(s) returned by the query using rowScanner
-	Do(rowScanner RowScanner, oneRow bool, query func() (sqlRows, error)) error
+	// Do scans row(s) returned by the query using rowScanner, enforcing limits unless nil
+	Do(rowScanner RowScanner, oneRow bool, limits *scanLimiter, query func() (sqlRows, error)) error
 }
 
 func defaultScanDoer() scanDoer {
 	return scanDoerFunc(scan)
 }
 
-type scanDoerFunc func(rowScanner RowScanner, oneRow bool, query func() (sqlRows, error)) error
+type scanDoerFunc func(rowScanner RowScanner, oneRow bool, limits *scanLimiter, query func() (sqlRows, error)) error
 
 var _ scanDoer = (scanDoerFunc)(nil)
 
 // Do implements scanDoer.Do
-func (f scanDoerFunc) Do(rowScanner RowScanner, oneRow bool, query func() (sqlRows, error)) error {
-	return f(rowScanner, oneRow, query)
+func (f scanDoerFunc) Do(rowScanner RowScanner, oneRow bool, limits *scanLimiter, query func() (sqlRows, error)) error {
+	return f(rowScanner, oneRow, limits, query)
 }
 
 func ignoreClose(c io.Closer) {
 	_ = c.Close()
 }
 
-func scan(rowScanner RowScanner, oneRow bool, query func() (sqlRows, error)) error {
+func scan(rowScanner RowScanner, oneRow bool, limits *scanLimiter, query func() (sqlRows, error)) error {
 	rows, err := query()
 	if err != nil {
 		return err
@@ -42,10 +42,24 @@ func scan(rowScanner RowScanner, oneRow bool, query func() (sqlRows, error)) err
 	defer ignoreClose(rows)
 
 	rowsScanned := 0
+	var bytesScanned int64
+	exceeded := false
 
 	for (!oneRow || rowsScanned < 1) && rows.Next() {
 		if err := rows.Scan(rowScanner.Into()...); err != nil {
-			return err
+			return columnError(rows, rowScanner.Into(), err)
+		}
+		if limits != nil && !exceeded {
+			bytesScanned += limits.rowBytes(rowScanner.Into())
+			if err := limits.check(int64(rowsScanned+1), bytesScanned); err != nil {
+				if !limits.WarnOnly {
+					return err
+				}
+				exceeded = true
+				if limits.OnExceeded != nil {
+					limits.OnExceeded(err)
+				}
+			}
 		}
 		if err := rowScanner.RowScanned(); err != nil {
 			return err
@@ -104,3 +118,21 @@ func (s simpleScanner) Into() []interface{} {
 func (s simpleScanner) RowScanned() error {
 	return nil
 }
+
+// countingScanner is a RowScanner counting the rows scanned by the wrapped RowScanner
+type countingScanner struct {
+	RowScanner
+
+	rowsScanned int64
+}
+
+var _ RowScanner = (*countingScanner)(nil)
+
+// RowScanned implements RowScanner.RowScanned
+func (c *countingScanner) RowScanned() error {
+	if err := c.RowScanner.RowScanned(); err != nil {
+		return err
+	}
+	c.rowsScanned++
+	return nil
+}
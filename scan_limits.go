@@ -0,0 +1,156 @@
+package libsql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// ScanLimits limits the size of the results of scans.
+// The rows returned by writes, see Queryer.UpdateReturning, are not limited
+// since the write is done when they are scanned
+type ScanLimits struct {
+	// MaxRows is the maximum number of rows scanned. Zero means no limit
+	MaxRows int64
+
+	// MaxBytes is the maximum approximate number of bytes scanned. Zero means no limit
+	MaxBytes int64
+
+	// WarnOnly makes scans exceeding a limit continue, calling OnExceeded instead of failing
+	WarnOnly bool
+
+	// OnExceeded is called once per scan exceeding a limit in WarnOnly mode
+	OnExceeded func(*ErrRowLimitExceeded)
+}
+
+// WithDefaultScanLimits sets the limits of the scans of a Database,
+// which can be overridden per context with WithScanLimits
+func WithDefaultScanLimits(limits ScanLimits) Option {
+	return func(c *config) {
+		c.scanLimits = &limits
+	}
+}
+
+type scanLimitsKey struct{}
+
+// WithScanLimits returns a copy of ctx overriding the default scan limits of
+// the scans performed with it. Zero ScanLimits remove the limits.
+func WithScanLimits(ctx context.Context, limits ScanLimits) context.Context {
+	return context.WithValue(ctx, scanLimitsKey{}, limits)
+}
+
+// ScanLimitKind is the kind of limit exceeded by a scan
+type ScanLimitKind int
+
+const (
+	// RowLimit is ScanLimits.MaxRows
+	RowLimit ScanLimitKind = iota + 1
+	// ByteLimit is ScanLimits.MaxBytes
+	ByteLimit
+)
+
+// ErrRowLimitExceeded is the error of scans exceeding their ScanLimits
+type ErrRowLimitExceeded struct {
+	Kind ScanLimitKind
+	// Limit is the exceeded limit
+	Limit int64
+	// SQL is the SQL of the scan
+	SQL string
+}
+
+// Error implements error
+func (e *ErrRowLimitExceeded) Error() string {
+	unit := "rows"
+	if e.Kind == ByteLimit {
+		unit = "bytes"
+	}
+	return fmt.Sprintf("libsql: scan exceeded the limit of %d %s", e.Limit, unit)
+}
+
+// scanLimiter enforces the limits of a scan of sql
+type scanLimiter struct {
+	ScanLimits
+
+	sql string
+}
+
+// scanLimiter returns the limiter of a scan of sql performed with ctx, nil if the scan is not limited
+func (c *config) scanLimiter(ctx context.Context, sql string) *scanLimiter {
+	limits, ok := ctx.Value(scanLimitsKey{}).(ScanLimits)
+	if !ok {
+		if c == nil || c.scanLimits == nil {
+			return nil
+		}
+		limits = *c.scanLimits
+	}
+	if limits.MaxRows <= 0 && limits.MaxBytes <= 0 {
+		return nil
+	}
+	return &scanLimiter{ScanLimits: limits, sql: sql}
+}
+
+// check returns an error if rows or bytes exceed the limits
+func (l *scanLimiter) check(rows int64, bytes int64) *ErrRowLimitExceeded {
+	if l.MaxRows > 0 && rows > l.MaxRows {
+		return &ErrRowLimitExceeded{Kind: RowLimit, Limit: l.MaxRows, SQL: l.sql}
+	}
+	if l.MaxBytes > 0 && bytes > l.MaxBytes {
+		return &ErrRowLimitExceeded{Kind: ByteLimit, Limit: l.MaxBytes, SQL: l.sql}
+	}
+	return nil
+}
+
+// rowBytes returns the approximate size of a row scanned into pointers
+func (l *scanLimiter) rowBytes(pointers []interface{}) int64 {
+	if l.MaxBytes <= 0 {
+		return 0
+	}
+	var size int64
+	for _, p := range pointers {
+		size += approxSize(p)
+	}
+	return size
+}
+
+// approxSize returns the approximate size of the value pointed to by p
+func approxSize(p interface{}) int64 {
+	switch v := p.(type) {
+	case *string:
+		return int64(len(*v))
+	case *[]byte:
+		return int64(len(*v))
+	case *sql.RawBytes:
+		return int64(len(*v))
+	case *sql.NullString:
+		return int64(len(v.String))
+	case *time.Time, *sql.NullTime:
+		return 24
+	}
+
+	value := reflect.ValueOf(p)
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return 0
+	}
+	value = value.Elem()
+	switch value.Kind() {
+	case reflect.String, reflect.Slice:
+		return int64(value.Len()) * int64(sizeOfElem(value.Type()))
+	case reflect.Interface:
+		if value.IsNil() {
+			return 0
+		}
+		elem := reflect.New(value.Elem().Type())
+		elem.Elem().Set(value.Elem())
+		return approxSize(elem.Interface())
+	}
+	return int64(value.Type().Size())
+}
+
+func sizeOfElem(t reflect.Type) uintptr {
+	if t.Kind() == reflect.String {
+		return 1
+	}
+	return t.Elem().Size()
+}
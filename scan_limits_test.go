@@ -0,0 +1,156 @@
+package libsql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newStringRowsMock returns rows of a single string column, closed once
+func newStringRowsMock(t *testing.T, values ...string) *SqlRowsMock {
+	sqlRows := NewSqlRowsMock(t)
+	next := 0
+	sqlRows.NextMock.Set(func() bool {
+		next++
+		return next <= len(values)
+	})
+	sqlRows.ScanMock.Set(func(dest ...interface{}) error {
+		*dest[0].(*string) = values[next-1]
+		return nil
+	})
+	sqlRows.CloseMock.Return(nil)
+	return sqlRows
+}
+
+func Test_scan_rowLimitExceeded(t *testing.T) {
+	rows := newStringRowsMock(t, "a", "b", "c")
+	defer rows.MinimockFinish()
+
+	var value string
+	limits := &scanLimiter{ScanLimits: ScanLimits{MaxRows: 2}, sql: "SELECT x FROM aTable"}
+	err := scan(Into(&value), false, limits, func() (sqlRows, error) {
+		return rows, nil
+	})
+	require.Equal(t, &ErrRowLimitExceeded{Kind: RowLimit, Limit: 2, SQL: "SELECT x FROM aTable"}, err)
+	require.Equal(t, "libsql: scan exceeded the limit of 2 rows", err.Error())
+}
+
+func Test_scan_byteLimitExceeded(t *testing.T) {
+	rows := newStringRowsMock(t, "abc", "def")
+	defer rows.MinimockFinish()
+
+	var value string
+	limits := &scanLimiter{ScanLimits: ScanLimits{MaxBytes: 5}, sql: "SELECT x FROM aTable"}
+	err := scan(Into(&value), false, limits, func() (sqlRows, error) {
+		return rows, nil
+	})
+	require.Equal(t, &ErrRowLimitExceeded{Kind: ByteLimit, Limit: 5, SQL: "SELECT x FROM aTable"}, err)
+	require.Equal(t, "libsql: scan exceeded the limit of 5 bytes", err.Error())
+}
+
+func Test_scan_limitExceededWarnOnly(t *testing.T) {
+	rows := newStringRowsMock(t, "a", "b", "c", "d")
+	defer rows.MinimockFinish()
+
+	rows.ErrMock.Return(nil)
+
+	var exceeded []*ErrRowLimitExceeded
+	limits := &scanLimiter{ScanLimits: ScanLimits{
+		MaxRows:  2,
+		WarnOnly: true,
+		OnExceeded: func(err *ErrRowLimitExceeded) {
+			exceeded = append(exceeded, err)
+		},
+	}}
+	counter := &countingScanner{RowScanner: Into(new(string))}
+	err := scan(counter, false, limits, func() (sqlRows, error) {
+		return rows, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(4), counter.rowsScanned)
+	require.Equal(t, []*ErrRowLimitExceeded{{Kind: RowLimit, Limit: 2}}, exceeded)
+}
+
+func Test_WithScanLimits_OverridesDefaults(t *testing.T) {
+	ctx := context.Background()
+	const expQuery = "SELECT x FROM aTable"
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	queries := 0
+	sqlDB.QueryMock.Set(func(context.Context, string, ...interface{}) (sqlRows, error) {
+		rows := newStringRowsMock(t, "a", "b")
+		queries++
+		if queries > 1 {
+			// the first scan fails before checking for errors
+			rows.ErrMock.Return(nil)
+		}
+		return rows, nil
+	})
+
+	db := newDatabase(sqlDB, newConfig([]Option{WithDefaultScanLimits(ScanLimits{MaxRows: 1})}))
+
+	var value string
+	err := db.Scan(ctx, Into(&value), expQuery)
+	var limitErr *ErrRowLimitExceeded
+	require.ErrorAs(t, err, &limitErr)
+	require.Equal(t, &ErrRowLimitExceeded{Kind: RowLimit, Limit: 1, SQL: expQuery}, limitErr)
+
+	require.NoError(t, db.Scan(WithScanLimits(ctx, ScanLimits{MaxRows: 2}), Into(&value), expQuery))
+	require.NoError(t, db.Scan(WithScanLimits(ctx, ScanLimits{}), Into(&value), expQuery))
+}
+
+func Test_UpdateReturning_IsNotLimited(t *testing.T) {
+	ctx := context.Background()
+	const expQuery = "UPDATE aTable SET x = x || 'z' RETURNING x"
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	stmt := NewSqlStmtMock(t)
+	defer stmt.MinimockFinish()
+
+	newRows := func() *SqlRowsMock {
+		rows := newStringRowsMock(t, "az", "bz")
+		rows.ErrMock.Return(nil)
+		return rows
+	}
+	sqlDB.QueryMock.Set(func(context.Context, string, ...interface{}) (sqlRows, error) {
+		return newRows(), nil
+	})
+	sqlDB.PrepareMock.Return(stmt, nil)
+	stmt.QueryMock.Set(func(context.Context, ...interface{}) (sqlRows, error) {
+		return newRows(), nil
+	})
+	stmt.CloseMock.Return(nil)
+
+	db := newDatabase(sqlDB, newConfig([]Option{WithDefaultScanLimits(ScanLimits{MaxRows: 1})}))
+
+	var value string
+	rowsAffected, err := db.UpdateReturning(ctx, Into(&value), expQuery)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), rowsAffected)
+
+	err = db.Prepared(ctx, expQuery, func(stmt Statement) error {
+		rowsAffected, err := stmt.UpdateReturning(ctx, Into(&value))
+		require.Equal(t, int64(2), rowsAffected)
+		return err
+	})
+	require.NoError(t, err)
+}
+
+func Test_approxSize(t *testing.T) {
+	var iface interface{} = "abcd"
+	require.Equal(t, int64(3), approxSize(&[]string{"abc"}[0]))
+	require.Equal(t, int64(2), approxSize(&[]byte{1, 2}))
+	require.Equal(t, int64(3), approxSize(&sql.NullString{String: "abc", Valid: true}))
+	require.Equal(t, int64(8), approxSize(new(int64)))
+	require.Equal(t, int64(24), approxSize(&time.Time{}))
+	require.Equal(t, int64(4), approxSize(&iface))
+	require.Equal(t, int64(16), approxSize(&[]int32{1, 2, 3, 4}))
+	require.Zero(t, approxSize(nil))
+}
@@ -14,8 +14,8 @@ import (
 type ScanDoerMock struct {
 	t minimock.Tester
 
-	funcDo          func(rowScanner RowScanner, oneRow bool, query func() (sqlRows, error)) (err error)
-	inspectFuncDo   func(rowScanner RowScanner, oneRow bool, query func() (sqlRows, error))
+	funcDo          func(rowScanner RowScanner, oneRow bool, limits *scanLimiter, query func() (sqlRows, error)) (err error)
+	inspectFuncDo   func(rowScanner RowScanner, oneRow bool, limits *scanLimiter, query func() (sqlRows, error))
 	afterDoCounter  uint64
 	beforeDoCounter uint64
 	DoMock          mScanDoerMockDo
@@ -55,6 +55,7 @@ type ScanDoerMockDoExpectation struct {
 type ScanDoerMockDoParams struct {
 	rowScanner RowScanner
 	oneRow     bool
+	limits     *scanLimiter
 	query      func() (sqlRows, error)
 }
 
@@ -64,7 +65,7 @@ type ScanDoerMockDoResults struct {
 }
 
 // Expect sets up expected params for scanDoer.Do
-func (mmDo *mScanDoerMockDo) Expect(rowScanner RowScanner, oneRow bool, query func() (sqlRows, error)) *mScanDoerMockDo {
+func (mmDo *mScanDoerMockDo) Expect(rowScanner RowScanner, oneRow bool, limits *scanLimiter, query func() (sqlRows, error)) *mScanDoerMockDo {
 	if mmDo.mock.funcDo != nil {
 		mmDo.mock.t.Fatalf("ScanDoerMock.Do mock is already set by Set")
 	}
@@ -73,7 +74,7 @@ func (mmDo *mScanDoerMockDo) Expect(rowScanner RowScanner, oneRow bool, query fu
 		mmDo.defaultExpectation = &ScanDoerMockDoExpectation{}
 	}
 
-	mmDo.defaultExpectation.params = &ScanDoerMockDoParams{rowScanner, oneRow, query}
+	mmDo.defaultExpectation.params = &ScanDoerMockDoParams{rowScanner, oneRow, limits, query}
 	for _, e := range mmDo.expectations {
 		if minimock.Equal(e.params, mmDo.defaultExpectation.params) {
 			mmDo.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDo.defaultExpectation.params)
@@ -84,7 +85,7 @@ func (mmDo *mScanDoerMockDo) Expect(rowScanner RowScanner, oneRow bool, query fu
 }
 
 // Inspect accepts an inspector function that has same arguments as the scanDoer.Do
-func (mmDo *mScanDoerMockDo) Inspect(f func(rowScanner RowScanner, oneRow bool, query func() (sqlRows, error))) *mScanDoerMockDo {
+func (mmDo *mScanDoerMockDo) Inspect(f func(rowScanner RowScanner, oneRow bool, limits *scanLimiter, query func() (sqlRows, error))) *mScanDoerMockDo {
 	if mmDo.mock.inspectFuncDo != nil {
 		mmDo.mock.t.Fatalf("Inspect function is already set for ScanDoerMock.Do")
 	}
@@ -108,7 +109,7 @@ func (mmDo *mScanDoerMockDo) Return(err error) *ScanDoerMock {
 }
 
 //Set uses given function f to mock the scanDoer.Do method
-func (mmDo *mScanDoerMockDo) Set(f func(rowScanner RowScanner, oneRow bool, query func() (sqlRows, error)) (err error)) *ScanDoerMock {
+func (mmDo *mScanDoerMockDo) Set(f func(rowScanner RowScanner, oneRow bool, limits *scanLimiter, query func() (sqlRows, error)) (err error)) *ScanDoerMock {
 	if mmDo.defaultExpectation != nil {
 		mmDo.mock.t.Fatalf("Default expectation is already set for the scanDoer.Do method")
 	}
@@ -123,14 +124,14 @@ func (mmDo *mScanDoerMockDo) Set(f func(rowScanner RowScanner, oneRow bool, quer
 
 // When sets expectation for the scanDoer.Do which will trigger the result defined by the following
 // Then helper
-func (mmDo *mScanDoerMockDo) When(rowScanner RowScanner, oneRow bool, query func() (sqlRows, error)) *ScanDoerMockDoExpectation {
+func (mmDo *mScanDoerMockDo) When(rowScanner RowScanner, oneRow bool, limits *scanLimiter, query func() (sqlRows, error)) *ScanDoerMockDoExpectation {
 	if mmDo.mock.funcDo != nil {
 		mmDo.mock.t.Fatalf("ScanDoerMock.Do mock is already set by Set")
 	}
 
 	expectation := &ScanDoerMockDoExpectation{
 		mock:   mmDo.mock,
-		params: &ScanDoerMockDoParams{rowScanner, oneRow, query},
+		params: &ScanDoerMockDoParams{rowScanner, oneRow, limits, query},
 	}
 	mmDo.expectations = append(mmDo.expectations, expectation)
 	return expectation
@@ -143,15 +144,15 @@ func (e *ScanDoerMockDoExpectation) Then(err error) *ScanDoerMock {
 }
 
 // Do implements scanDoer
-func (mmDo *ScanDoerMock) Do(rowScanner RowScanner, oneRow bool, query func() (sqlRows, error)) (err error) {
+func (mmDo *ScanDoerMock) Do(rowScanner RowScanner, oneRow bool, limits *scanLimiter, query func() (sqlRows, error)) (err error) {
 	mm_atomic.AddUint64(&mmDo.beforeDoCounter, 1)
 	defer mm_atomic.AddUint64(&mmDo.afterDoCounter, 1)
 
 	if mmDo.inspectFuncDo != nil {
-		mmDo.inspectFuncDo(rowScanner, oneRow, query)
+		mmDo.inspectFuncDo(rowScanner, oneRow, limits, query)
 	}
 
-	mm_params := &ScanDoerMockDoParams{rowScanner, oneRow, query}
+	mm_params := &ScanDoerMockDoParams{rowScanner, oneRow, limits, query}
 
 	// Record call args
 	mmDo.DoMock.mutex.Lock()
@@ -168,7 +169,7 @@ func (mmDo *ScanDoerMock) Do(rowScanner RowScanner, oneRow bool, query func() (s
 	if mmDo.DoMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmDo.DoMock.defaultExpectation.Counter, 1)
 		mm_want := mmDo.DoMock.defaultExpectation.params
-		mm_got := ScanDoerMockDoParams{rowScanner, oneRow, query}
+		mm_got := ScanDoerMockDoParams{rowScanner, oneRow, limits, query}
 		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmDo.t.Errorf("ScanDoerMock.Do got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
@@ -180,9 +181,9 @@ func (mmDo *ScanDoerMock) Do(rowScanner RowScanner, oneRow bool, query func() (s
 		return (*mm_results).err
 	}
 	if mmDo.funcDo != nil {
-		return mmDo.funcDo(rowScanner, oneRow, query)
+		return mmDo.funcDo(rowScanner, oneRow, limits, query)
 	}
-	mmDo.t.Fatalf("Unexpected call to ScanDoerMock.Do. %v %v %v", rowScanner, oneRow, query)
+	mmDo.t.Fatalf("Unexpected call to ScanDoerMock.Do. %v %v %v %v", rowScanner, oneRow, limits, query)
 	return
 }
 
@@ -47,7 +47,7 @@ func Test_scan(t *testing.T) {
 	rowScannerMock.RowScannedMock.Return((error)(nil))
 	sqlRowsMock.ErrMock.Return((error)(nil))
 
-	err := scan(rowScannerMock, false, func() (sqlRows, error) {
+	err := scan(rowScannerMock, false, nil, func() (sqlRows, error) {
 		return sqlRowsMock, nil
 	})
 	require.NoError(t, err)
@@ -61,7 +61,7 @@ func Test_scan_queryError(t *testing.T) {
 	defer sqlRowsMock.MinimockFinish()
 
 	expErr := errors.New("a-test-error")
-	err := scan(rowScannerMock, false, func() (sqlRows, error) {
+	err := scan(rowScannerMock, false, nil, func() (sqlRows, error) {
 		return sqlRowsMock, expErr
 	})
 	require.Error(t, err)
@@ -86,7 +86,7 @@ func Test_scan_scanError(t *testing.T) {
 	sqlRowsMock.ScanMock.Expect(scannerTargets...).Return(expErr)
 	sqlRowsMock.CloseMock.Return((error)(nil))
 
-	err := scan(rowScannerMock, false, func() (sqlRows, error) {
+	err := scan(rowScannerMock, false, nil, func() (sqlRows, error) {
 		return sqlRowsMock, nil
 	})
 	require.Error(t, err)
@@ -113,7 +113,7 @@ func Test_scan_scannerError(t *testing.T) {
 	sqlRowsMock.ScanMock.Expect(scannerTargets...).Return((error)(nil))
 	sqlRowsMock.CloseMock.Return((error)(nil))
 
-	err := scan(rowScannerMock, false, func() (sqlRows, error) {
+	err := scan(rowScannerMock, false, nil, func() (sqlRows, error) {
 		return sqlRowsMock, nil
 	})
 	require.Error(t, err)
@@ -133,7 +133,7 @@ func Test_scan_iterationError(t *testing.T) {
 	sqlRowsMock.ErrMock.Return(expErr)
 	sqlRowsMock.CloseMock.Return((error)(nil))
 
-	err := scan(rowScannerMock, false, func() (sqlRows, error) {
+	err := scan(rowScannerMock, false, nil, func() (sqlRows, error) {
 		return sqlRowsMock, nil
 	})
 	require.Error(t, err)
@@ -151,7 +151,7 @@ func Test_scan_oneRowNoResults(t *testing.T) {
 	sqlRowsMock.ErrMock.Return((error)(nil))
 	sqlRowsMock.CloseMock.Return((error)(nil))
 
-	err := scan(rowScannerMock, true, func() (sqlRows, error) {
+	err := scan(rowScannerMock, true, nil, func() (sqlRows, error) {
 		return sqlRowsMock, nil
 	})
 	require.Error(t, err)
@@ -232,3 +232,25 @@ func Test_Into(t *testing.T) {
 	require.Equal(t, []interface{}{&column1, &column2}, scanner.Into())
 	require.NoError(t, scanner.RowScanned())
 }
+
+func Test_countingScanner(t *testing.T) {
+	var column1 int
+	counter := &countingScanner{RowScanner: Into(&column1)}
+
+	err := FeedScanner(counter, []interface{}{1}, []interface{}{2}, []interface{}{3})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), counter.rowsScanned)
+	require.Equal(t, 3, column1)
+}
+
+func Test_countingScanner_RowScannerErrorIsNotCounted(t *testing.T) {
+	rowScannerMock := NewRowScannerMock(t)
+	defer rowScannerMock.MinimockFinish()
+
+	expErr := errors.New("a-test-error")
+	rowScannerMock.RowScannedMock.Return(expErr)
+
+	counter := &countingScanner{RowScanner: rowScannerMock}
+	require.Equal(t, expErr, counter.RowScanned())
+	require.Zero(t, counter.rowsScanned)
+}
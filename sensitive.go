@@ -0,0 +1,46 @@
+package libsql
+
+import "database/sql/driver"
+
+// RedactedArg replaces redacted arguments in logs
+const RedactedArg = "[REDACTED]"
+
+// Sensitive wraps a query argument that must never be logged.
+// The wrapped value is passed to the database as is.
+func Sensitive(v interface{}) interface{} {
+	return sensitiveArg{value: v}
+}
+
+// IsSensitive reports whether arg was wrapped with Sensitive
+func IsSensitive(arg interface{}) bool {
+	_, ok := arg.(sensitiveArg)
+	return ok
+}
+
+type sensitiveArg struct {
+	value interface{}
+}
+
+var _ driver.Valuer = (*sensitiveArg)(nil)
+
+// Value implements driver.Valuer for sensitive arguments that reach database/sql wrapped
+func (s sensitiveArg) Value() (driver.Value, error) {
+	return driver.DefaultParameterConverter.ConvertValue(s.value)
+}
+
+// driverArgs returns args with Sensitive wrappers removed
+func driverArgs(args []interface{}) []interface{} {
+	var unwrapped []interface{}
+	for i, arg := range args {
+		if s, ok := arg.(sensitiveArg); ok {
+			if unwrapped == nil {
+				unwrapped = append([]interface{}(nil), args...)
+			}
+			unwrapped[i] = s.value
+		}
+	}
+	if unwrapped == nil {
+		return args
+	}
+	return unwrapped
+}
@@ -0,0 +1,32 @@
+package libsql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Sensitive_ArgsAreUnwrappedForTheDriver(t *testing.T) {
+	ctx := context.Background()
+	const expQuery = "UPDATE users SET password = ? WHERE id = ?"
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	sqlDB.ExecMock.Expect(ctx, expQuery, "hunter2", 42).Return(nil, errors.New("a-test-error"))
+
+	_, err := newDatabase(sqlDB, nil).Update(ctx, expQuery, Sensitive("hunter2"), 42)
+	require.Error(t, err)
+
+	value, err := Sensitive(42).(sensitiveArg).Value()
+	require.NoError(t, err)
+	require.Equal(t, int64(42), value)
+}
+
+func Test_IsSensitive(t *testing.T) {
+	require.True(t, IsSensitive(Sensitive("hunter2")))
+	require.False(t, IsSensitive("hunter2"))
+}
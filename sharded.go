@@ -0,0 +1,201 @@
+package libsql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"sync"
+)
+
+// ShardFunc maps a shard key, e.g. a tenant ID, to the index of a shard in [0, shards)
+type ShardFunc func(key interface{}, shards int) int
+
+// ErrNoShards is returned when creating a Sharded without shards
+var ErrNoShards = errors.New("libsql: no shards")
+
+// HashShardFunc is a ShardFunc distributing keys by the FNV-1a hash of their
+// default string representation. shards must be positive
+func HashShardFunc(key interface{}, shards int) int {
+	h := fnv.New32a()
+	_, _ = fmt.Fprint(h, key)
+	return int(h.Sum32() % uint32(shards))
+}
+
+// ShardedConfig configures a Sharded
+type ShardedConfig struct {
+	// MaxParallelism is the maximum number of shards queried concurrently by
+	// ScanAll. Defaults to the number of shards
+	MaxParallelism int
+}
+
+// NewSharded returns a Sharded over the given shards.
+// It is a shorthand for ShardedConfig{}.New(shardFunc, shards...)
+func NewSharded(shardFunc ShardFunc, shards ...Database) (*Sharded, error) {
+	return ShardedConfig{}.New(shardFunc, shards...)
+}
+
+// New returns a Sharded over the given shards, or ErrNoShards if there are none
+func (c ShardedConfig) New(shardFunc ShardFunc, shards ...Database) (*Sharded, error) {
+	if len(shards) == 0 {
+		return nil, ErrNoShards
+	}
+	if c.MaxParallelism <= 0 || c.MaxParallelism > len(shards) {
+		c.MaxParallelism = len(shards)
+	}
+	return &Sharded{
+		cfg:       c,
+		shardFunc: shardFunc,
+		shards:    shards,
+	}, nil
+}
+
+// Sharded routes queries to Databases holding disjoint parts of the data
+// according to a shard key.
+// Sharded is safe for concurrent use.
+type Sharded struct {
+	cfg       ShardedConfig
+	shardFunc ShardFunc
+	shards    []Database
+}
+
+// For returns the shard holding the data for key.
+// It panics if the ShardFunc returns an index outside of [0, shards), which is
+// a bug of the ShardFunc.
+func (s *Sharded) For(key interface{}) Database {
+	shard := s.shardFunc(key, len(s.shards))
+	if shard < 0 || shard >= len(s.shards) {
+		panic(fmt.Sprintf("libsql: ShardFunc returned shard %d for key %v, not in [0, %d)", shard, key, len(s.shards)))
+	}
+	return s.shards[shard]
+}
+
+// Shards returns all shards in order
+func (s *Sharded) Shards() []Database {
+	return append([]Database(nil), s.shards...)
+}
+
+// ScanAll executes sql on all shards concurrently and scans the result rows
+// of each shard with a RowScanner returned by newScanner for that shard.
+//
+// newScanner is called for each shard in order from the calling goroutine
+// before any query starts, so it needs no synchronization.
+// The RowScanners of different shards are used from different goroutines.
+//
+// ScanAll leaves merging the results to the caller, since only the caller
+// knows whether to concatenate, sort, deduplicate or aggregate them: scanning
+// each shard into its own RowScanner, e.g. an element of a slice indexed by
+// shard, and merging them after ScanAll returns needs no synchronization and
+// keeps the order of the shards. RowScanners may instead merge as they scan
+// by synchronizing access to shared state.
+//
+// ScanAll waits for all shards and returns ShardErrors if any of them failed;
+// the RowScanners of the other shards still hold their results.
+func (s *Sharded) ScanAll(
+	ctx context.Context,
+	newScanner func(shard int) RowScanner,
+	sql string,
+	args ...interface{},
+) error {
+	scanners := make([]RowScanner, len(s.shards))
+	for i := range s.shards {
+		scanners[i] = newScanner(i)
+	}
+	errs := make([]error, len(s.shards))
+
+	sem := make(chan struct{}, s.cfg.MaxParallelism)
+	var wg sync.WaitGroup
+	for i, shard := range s.shards {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, shard Database) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = shard.Scan(ctx, scanners[i], sql, args...)
+		}(i, shard)
+	}
+	wg.Wait()
+
+	var shardErrs ShardErrors
+	for i, err := range errs {
+		if err != nil {
+			shardErrs = append(shardErrs, &ShardError{Shard: i, Err: err})
+		}
+	}
+	if len(shardErrs) == 0 {
+		return nil
+	}
+	return shardErrs
+}
+
+// Close closes all shards
+func (s *Sharded) Close() error {
+	var err error
+	for _, shard := range s.shards {
+		if shardErr := shard.Close(); err == nil {
+			err = shardErr
+		}
+	}
+	return err
+}
+
+// ShardError is an error of a query on a single shard
+type ShardError struct {
+	Shard int
+	Err   error
+}
+
+// Error implements error
+func (e *ShardError) Error() string {
+	return fmt.Sprintf("shard %d: %v", e.Shard, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *ShardError) Unwrap() error {
+	return e.Err
+}
+
+// ShardErrors are the errors of the shards that failed a query, ordered by shard
+type ShardErrors []*ShardError
+
+// Error implements error
+func (e ShardErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the errors of the shards, so that errors.Is and errors.As
+// find the causes of the failures of each shard with Go 1.20 or later
+func (e ShardErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
+// Is reports whether the error of any shard matches target, for errors.Is
+// with Go versions before 1.20
+func (e ShardErrors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first error of a shard matching target, for errors.As
+// with Go versions before 1.20
+func (e ShardErrors) As(target interface{}) bool {
+	for _, err := range e {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
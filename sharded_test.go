@@ -0,0 +1,201 @@
+package libsql
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Sharded_For(t *testing.T) {
+	shard0 := NewDatabaseMock(t)
+	defer shard0.MinimockFinish()
+
+	shard1 := NewDatabaseMock(t)
+	defer shard1.MinimockFinish()
+
+	byTenant := func(key interface{}, shards int) int {
+		return int(key.(int64) % int64(shards))
+	}
+
+	sharded, err := NewSharded(byTenant, shard0, shard1)
+	require.NoError(t, err)
+	require.Equal(t, shard0, sharded.For(int64(42)))
+	require.Equal(t, shard1, sharded.For(int64(43)))
+	require.Equal(t, []Database{shard0, shard1}, sharded.Shards())
+}
+
+func Test_Sharded_ForPanicsOnShardOutOfRange(t *testing.T) {
+	shard0 := NewDatabaseMock(t)
+	defer shard0.MinimockFinish()
+
+	sharded, err := NewSharded(func(interface{}, int) int { return 1 }, shard0)
+	require.NoError(t, err)
+	require.PanicsWithValue(t, "libsql: ShardFunc returned shard 1 for key 42, not in [0, 1)", func() {
+		sharded.For(42)
+	})
+}
+
+func Test_HashShardFunc(t *testing.T) {
+	for _, key := range []interface{}{"tenant-a", "tenant-b", 42, int64(-7)} {
+		shard := HashShardFunc(key, 3)
+		require.True(t, shard >= 0 && shard < 3)
+		require.Equal(t, shard, HashShardFunc(key, 3))
+	}
+}
+
+func Test_Sharded_ScanAll(t *testing.T) {
+	ctx := context.Background()
+	const expQuery = "SELECT name FROM tenants WHERE active = ?"
+
+	shards := make([]Database, 3)
+	for i := range shards {
+		shard := NewDatabaseMock(t)
+		defer shard.MinimockFinish()
+
+		i := i
+		shard.ScanMock.Set(func(actualCtx context.Context, scanner RowScanner, sql string, args ...interface{}) error {
+			require.Equal(t, ctx, actualCtx)
+			require.Equal(t, expQuery, sql)
+			require.Equal(t, []interface{}{true}, args)
+			return FeedScanner(scanner, []interface{}{int64(i)})
+		})
+		shards[i] = shard
+	}
+
+	var mu sync.Mutex
+	var merged []int64
+	newScanner := func(shard int) RowScanner {
+		var value int64
+		return rowScannerFunc{
+			into: []interface{}{&value},
+			scanned: func() error {
+				mu.Lock()
+				defer mu.Unlock()
+				merged = append(merged, value)
+				return nil
+			},
+		}
+	}
+
+	sharded, err := NewSharded(HashShardFunc, shards...)
+	require.NoError(t, err)
+	require.NoError(t, sharded.ScanAll(ctx, newScanner, expQuery, true))
+	require.ElementsMatch(t, []int64{0, 1, 2}, merged)
+}
+
+func Test_Sharded_ScanAllMergedAfterwards(t *testing.T) {
+	ctx := context.Background()
+
+	shards := make([]Database, 3)
+	for i := range shards {
+		shard := NewDatabaseMock(t)
+		defer shard.MinimockFinish()
+
+		i := i
+		shard.ScanMock.Set(func(_ context.Context, scanner RowScanner, _ string, _ ...interface{}) error {
+			return FeedScanner(scanner, []interface{}{int64(i)}, []interface{}{int64(i + 10)})
+		})
+		shards[i] = shard
+	}
+
+	perShard := make([][]int64, len(shards))
+	newScanner := func(shard int) RowScanner {
+		var value int64
+		return rowScannerFunc{
+			into: []interface{}{&value},
+			scanned: func() error {
+				perShard[shard] = append(perShard[shard], value)
+				return nil
+			},
+		}
+	}
+
+	sharded, err := NewSharded(HashShardFunc, shards...)
+	require.NoError(t, err)
+	require.NoError(t, sharded.ScanAll(ctx, newScanner, "SELECT id FROM tenants"))
+
+	var merged []int64
+	for _, values := range perShard {
+		merged = append(merged, values...)
+	}
+	require.Equal(t, []int64{0, 10, 1, 11, 2, 12}, merged)
+}
+
+func Test_Sharded_ScanAllReportsShardErrors(t *testing.T) {
+	ctx := context.Background()
+
+	shard0 := NewDatabaseMock(t)
+	defer shard0.MinimockFinish()
+
+	shard1 := NewDatabaseMock(t)
+	defer shard1.MinimockFinish()
+
+	expErr := errors.New("a-test-error")
+	shard0.ScanMock.Return(nil)
+	shard1.ScanMock.Return(expErr)
+
+	sharded, err := NewSharded(HashShardFunc, shard0, shard1)
+	require.NoError(t, err)
+	err = sharded.ScanAll(ctx, func(int) RowScanner { return Into() }, "SELECT 1")
+	require.Equal(t, ShardErrors{{Shard: 1, Err: expErr}}, err)
+	require.EqualError(t, err, "shard 1: a-test-error")
+	require.ErrorIs(t, err, expErr)
+
+	var shardErr *ShardError
+	require.ErrorAs(t, err, &shardErr)
+	require.Equal(t, 1, shardErr.Shard)
+	require.Equal(t, uint64(1), shard0.ScanAfterCounter())
+}
+
+func Test_Sharded_ScanAllBoundsParallelism(t *testing.T) {
+	ctx := context.Background()
+
+	var inFlight, maxInFlight int32
+	shards := make([]Database, 5)
+	for i := range shards {
+		shard := NewDatabaseMock(t)
+		defer shard.MinimockFinish()
+
+		shard.ScanMock.Set(func(context.Context, RowScanner, string, ...interface{}) error {
+			n := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			for {
+				max := atomic.LoadInt32(&maxInFlight)
+				if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+					break
+				}
+			}
+			return nil
+		})
+		shards[i] = shard
+	}
+
+	sharded, err := ShardedConfig{MaxParallelism: 2}.New(HashShardFunc, shards...)
+	require.NoError(t, err)
+	require.NoError(t, sharded.ScanAll(ctx, func(int) RowScanner { return Into() }, "SELECT 1"))
+	require.True(t, atomic.LoadInt32(&maxInFlight) <= 2)
+}
+
+func Test_NewSharded_RejectsNoShards(t *testing.T) {
+	sharded, err := NewSharded(HashShardFunc)
+	require.Equal(t, ErrNoShards, err)
+	require.Nil(t, sharded)
+}
+
+type rowScannerFunc struct {
+	into    []interface{}
+	scanned func() error
+}
+
+func (r rowScannerFunc) Into() []interface{} {
+	return r.into
+}
+
+func (r rowScannerFunc) RowScanned() error {
+	return r.scanned()
+}
@@ -0,0 +1,215 @@
+package libsql
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrExplainSkipped is the SlowQuery.ExplainErr of slow queries that were not
+// explained because of the rate limit or because no ExplainFunc is configured
+var ErrExplainSkipped = errors.New("libsql: explain skipped")
+
+// ExplainFunc returns the plan of the query sql with args using q
+type ExplainFunc func(ctx context.Context, q Queryer, sql string, args ...interface{}) (string, error)
+
+// MySQLExplain returns the plan of a query with EXPLAIN FORMAT=JSON
+func MySQLExplain(ctx context.Context, q Queryer, sql string, args ...interface{}) (string, error) {
+	return explainJSON(ctx, q, "EXPLAIN FORMAT=JSON "+sql, args)
+}
+
+// PostgresExplain returns the plan of a query with EXPLAIN (FORMAT JSON).
+// The query is not executed.
+func PostgresExplain(ctx context.Context, q Queryer, sql string, args ...interface{}) (string, error) {
+	return explainJSON(ctx, q, "EXPLAIN (FORMAT JSON) "+sql, args)
+}
+
+func explainJSON(ctx context.Context, q Queryer, explain string, args []interface{}) (string, error) {
+	var plan string
+	if err := q.ScanOne(ctx, Into(&plan), explain, args...); err != nil {
+		return "", err
+	}
+	return plan, nil
+}
+
+// SlowQuery describes a query that took at least SlowQueryConfig.Threshold
+type SlowQuery struct {
+	SQL string
+	// Args are the arguments of the query, with Sensitive ones replaced by RedactedArg
+	Args     []interface{}
+	Duration time.Duration
+	// Caller is the frame of the call into libsql that performed the query
+	Caller runtime.Frame
+	// Plan is the plan returned by SlowQueryConfig.Explain, empty if ExplainErr is set
+	Plan string
+	// ExplainErr is the error getting the plan, ErrExplainSkipped if the query was not explained
+	ExplainErr error
+}
+
+// SlowQueryConfig configures detection of slow queries
+type SlowQueryConfig struct {
+	// Threshold is the duration from which queries are slow
+	Threshold time.Duration
+
+	// Explain returns the plan of slow queries, e.g. MySQLExplain or PostgresExplain
+	Explain ExplainFunc
+
+	// ExplainInterval is the minimum interval between two explains.
+	// Slow queries are reported with ErrExplainSkipped while an explain
+	// is in progress or the interval is not over. Defaults to a second
+	ExplainInterval time.Duration
+
+	// ExplainTimeout is the timeout of explains. Defaults to 5 seconds
+	ExplainTimeout time.Duration
+
+	// Now returns the current time. Defaults to time.Now
+	Now func() time.Time
+}
+
+// Option returns an Option reporting slow queries with their plan to report.
+//
+// Only SELECT queries performed with Scan and ScanOne, including those of
+// Statements, are considered, except in transactions to avoid side effects.
+// Slow queries are explained asynchronously on a separate connection,
+// bypassing interceptors, and the rate of explains is limited per Database.
+// report is called from the goroutine explaining the query, or synchronously
+// if the query is not explained. It may be called concurrently.
+func (c SlowQueryConfig) Option(report func(SlowQuery)) Option {
+	if c.ExplainInterval <= 0 {
+		c.ExplainInterval = time.Second
+	}
+	if c.ExplainTimeout <= 0 {
+		c.ExplainTimeout = 5 * time.Second
+	}
+	if c.Now == nil {
+		c.Now = time.Now
+	}
+	return func(cfg *config) {
+		d := &slowQueryDetector{cfg: c, report: report}
+		cfg.slowQueries = d
+		cfg.interceptors = append(cfg.interceptors, d.intercept)
+	}
+}
+
+type slowQueryDetector struct {
+	cfg    SlowQueryConfig
+	report func(SlowQuery)
+
+	// db performs explains, bound when the Database is created
+	db Database
+
+	mu          sync.Mutex
+	explaining  bool
+	lastExplain time.Time
+}
+
+// bind makes the detector explain queries with db
+func (d *slowQueryDetector) bind(db Database) {
+	d.db = db
+}
+
+func (d *slowQueryDetector) intercept(ctx context.Context, op *Operation, next func(context.Context) error) error {
+	err := next(ctx)
+	if (err == nil || errors.Is(err, ErrNoRows)) && op.Duration >= d.cfg.Threshold && d.applies(op) {
+		d.detected(op, callerFrame())
+	}
+	return err
+}
+
+// applies reports whether slow query detection applies to op
+func (d *slowQueryDetector) applies(op *Operation) bool {
+	return (op.Kind == OperationScan || op.Kind == OperationScanOne) &&
+		!op.InTransaction &&
+		operationName(op) == "SELECT"
+}
+
+func (d *slowQueryDetector) detected(op *Operation, caller runtime.Frame) {
+	slow := SlowQuery{
+		SQL:      op.SQL,
+		Args:     redactSensitive(op.Args),
+		Duration: op.Duration,
+		Caller:   caller,
+	}
+	if d.db == nil || d.cfg.Explain == nil || !d.startExplain() {
+		slow.ExplainErr = ErrExplainSkipped
+		d.report(slow)
+		return
+	}
+
+	args := op.Args
+	go func() {
+		defer d.endExplain()
+		ctx, cancel := context.WithTimeout(context.Background(), d.cfg.ExplainTimeout)
+		defer cancel()
+		slow.ExplainErr = d.db.Conn(ctx, func(conn Connection) error {
+			var err error
+			slow.Plan, err = d.cfg.Explain(ctx, conn, slow.SQL, args...)
+			return err
+		})
+		d.report(slow)
+	}()
+}
+
+// startExplain reports whether an explain may start, marking it in progress if so
+func (d *slowQueryDetector) startExplain() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := d.cfg.Now()
+	if d.explaining || (!d.lastExplain.IsZero() && now.Sub(d.lastExplain) < d.cfg.ExplainInterval) {
+		return false
+	}
+	d.explaining = true
+	d.lastExplain = now
+	return true
+}
+
+func (d *slowQueryDetector) endExplain() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.explaining = false
+}
+
+// redactSensitive returns args with Sensitive ones replaced by RedactedArg
+func redactSensitive(args []interface{}) []interface{} {
+	redacted := make([]interface{}, len(args))
+	for i, arg := range args {
+		if _, ok := arg.(sensitiveArg); ok {
+			redacted[i] = RedactedArg
+		} else {
+			redacted[i] = arg
+		}
+	}
+	return redacted
+}
+
+// libsqlDir is the directory of the libsql sources
+var libsqlDir = func() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Dir(file)
+}()
+
+// callerFrame returns the frame of the first caller outside of libsql of the
+// operation being intercepted, skipping the frames of the interceptors
+func callerFrame() runtime.Frame {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	intercepted := false
+	for {
+		frame, more := frames.Next()
+		inLibsql := filepath.Dir(frame.File) == libsqlDir && !strings.HasSuffix(frame.File, "_test.go")
+		switch {
+		case inLibsql && strings.HasSuffix(frame.Function, ".(*config).intercept"):
+			intercepted = true
+		case intercepted && !inLibsql:
+			return frame
+		}
+		if !more {
+			return runtime.Frame{}
+		}
+	}
+}
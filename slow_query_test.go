@@ -0,0 +1,102 @@
+package libsql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SlowQueryConfig_Explains(t *testing.T) {
+	ctx := context.Background()
+	const expQuery = "SELECT x FROM aTable WHERE y = ? AND z = ?"
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	sqlConn := NewSqlConnMock(t)
+	defer sqlConn.MinimockFinish()
+
+	queryRows := newRowsMock(t, 1)
+	defer queryRows.MinimockFinish()
+
+	explainRows := NewSqlRowsMock(t)
+	defer explainRows.MinimockFinish()
+
+	sqlDB.QueryMock.Expect(ctx, expQuery, 1, "secret").Return(queryRows, nil)
+	sqlDB.ConnMock.Return(sqlConn, nil)
+	sqlConn.QueryMock.Set(func(_ context.Context, query string, args ...interface{}) (sqlRows, error) {
+		require.Equal(t, "EXPLAIN FORMAT=JSON "+expQuery, query)
+		require.Equal(t, []interface{}{1, "secret"}, args)
+		return explainRows, nil
+	})
+	sqlConn.CloseMock.Return(nil)
+	next := 0
+	explainRows.NextMock.Set(func() bool {
+		next++
+		return next == 1
+	})
+	explainRows.ScanMock.Set(func(dest ...interface{}) error {
+		*dest[0].(*string) = `{"query_block": {}}`
+		return nil
+	})
+	explainRows.ErrMock.Return(nil)
+	explainRows.CloseMock.Return(nil)
+
+	reports := make(chan SlowQuery, 1)
+	cfg := SlowQueryConfig{Threshold: time.Nanosecond, Explain: MySQLExplain}
+	db := newDatabase(sqlDB, newConfig([]Option{cfg.Option(func(slow SlowQuery) {
+		reports <- slow
+	})}))
+
+	require.NoError(t, db.Scan(ctx, Into(), expQuery, 1, Sensitive("secret")))
+
+	slow := <-reports
+	require.NoError(t, slow.ExplainErr)
+	require.Equal(t, `{"query_block": {}}`, slow.Plan)
+	require.Equal(t, expQuery, slow.SQL)
+	require.Equal(t, []interface{}{1, RedactedArg}, slow.Args)
+	require.NotZero(t, slow.Duration)
+	require.Equal(t, "oss.indeed.com/go/libsql.Test_SlowQueryConfig_Explains", slow.Caller.Function)
+}
+
+func Test_SlowQueryConfig_RateLimit(t *testing.T) {
+	clock := newFakeClock()
+	report := func(SlowQuery) {}
+	d := &slowQueryDetector{cfg: SlowQueryConfig{ExplainInterval: time.Second, Now: clock.Now}, report: report}
+
+	require.True(t, d.startExplain())
+	require.False(t, d.startExplain())
+	d.endExplain()
+	require.False(t, d.startExplain())
+
+	clock.Advance(time.Second)
+	require.True(t, d.startExplain())
+}
+
+func Test_SlowQueryConfig_SkipsWithoutExplain(t *testing.T) {
+	var reports []SlowQuery
+	opt := SlowQueryConfig{Threshold: time.Second}.Option(func(slow SlowQuery) {
+		reports = append(reports, slow)
+	})
+	cfg := newConfig([]Option{opt})
+
+	op := &Operation{Kind: OperationScanOne, SQL: "SELECT 1"}
+	err := cfg.interceptors[0](context.Background(), op, func(context.Context) error {
+		op.Duration = 2 * time.Second
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	require.Equal(t, ErrExplainSkipped, reports[0].ExplainErr)
+}
+
+func Test_slowQueryDetector_applies(t *testing.T) {
+	d := &slowQueryDetector{}
+	require.True(t, d.applies(&Operation{Kind: OperationScan, SQL: "SELECT 1"}))
+	require.True(t, d.applies(&Operation{Kind: OperationScanOne, SQL: "select 1", Prepared: true}))
+	require.False(t, d.applies(&Operation{Kind: OperationScan, SQL: "SELECT 1", InTransaction: true}))
+	require.False(t, d.applies(&Operation{Kind: OperationScan, SQL: "SHOW TABLES"}))
+	require.False(t, d.applies(&Operation{Kind: OperationUpdate, SQL: "SELECT 1"}))
+}
@@ -0,0 +1,282 @@
+package libsql
+
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// SqlBeginnerMock implements sqlBeginner
+type SqlBeginnerMock struct {
+	t minimock.Tester
+
+	funcBegin          func(ctx context.Context) (s1 sqlTx, err error)
+	inspectFuncBegin   func(ctx context.Context)
+	afterBeginCounter  uint64
+	beforeBeginCounter uint64
+	BeginMock          mSqlBeginnerMockBegin
+}
+
+// NewSqlBeginnerMock returns a mock for sqlBeginner
+func NewSqlBeginnerMock(t minimock.Tester) *SqlBeginnerMock {
+	m := &SqlBeginnerMock{t: t}
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.BeginMock = mSqlBeginnerMockBegin{mock: m}
+	m.BeginMock.callArgs = []*SqlBeginnerMockBeginParams{}
+
+	return m
+}
+
+type mSqlBeginnerMockBegin struct {
+	mock               *SqlBeginnerMock
+	defaultExpectation *SqlBeginnerMockBeginExpectation
+	expectations       []*SqlBeginnerMockBeginExpectation
+
+	callArgs []*SqlBeginnerMockBeginParams
+	mutex    sync.RWMutex
+}
+
+// SqlBeginnerMockBeginExpectation specifies expectation struct of the sqlBeginner.Begin
+type SqlBeginnerMockBeginExpectation struct {
+	mock    *SqlBeginnerMock
+	params  *SqlBeginnerMockBeginParams
+	results *SqlBeginnerMockBeginResults
+	Counter uint64
+}
+
+// SqlBeginnerMockBeginParams contains parameters of the sqlBeginner.Begin
+type SqlBeginnerMockBeginParams struct {
+	ctx context.Context
+}
+
+// SqlBeginnerMockBeginResults contains results of the sqlBeginner.Begin
+type SqlBeginnerMockBeginResults struct {
+	s1  sqlTx
+	err error
+}
+
+// Expect sets up expected params for sqlBeginner.Begin
+func (mmBegin *mSqlBeginnerMockBegin) Expect(ctx context.Context) *mSqlBeginnerMockBegin {
+	if mmBegin.mock.funcBegin != nil {
+		mmBegin.mock.t.Fatalf("SqlBeginnerMock.Begin mock is already set by Set")
+	}
+
+	if mmBegin.defaultExpectation == nil {
+		mmBegin.defaultExpectation = &SqlBeginnerMockBeginExpectation{}
+	}
+
+	mmBegin.defaultExpectation.params = &SqlBeginnerMockBeginParams{ctx}
+	for _, e := range mmBegin.expectations {
+		if minimock.Equal(e.params, mmBegin.defaultExpectation.params) {
+			mmBegin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmBegin.defaultExpectation.params)
+		}
+	}
+
+	return mmBegin
+}
+
+// Inspect accepts an inspector function that has same arguments as the sqlBeginner.Begin
+func (mmBegin *mSqlBeginnerMockBegin) Inspect(f func(ctx context.Context)) *mSqlBeginnerMockBegin {
+	if mmBegin.mock.inspectFuncBegin != nil {
+		mmBegin.mock.t.Fatalf("Inspect function is already set for SqlBeginnerMock.Begin")
+	}
+
+	mmBegin.mock.inspectFuncBegin = f
+
+	return mmBegin
+}
+
+// Return sets up results that will be returned by sqlBeginner.Begin
+func (mmBegin *mSqlBeginnerMockBegin) Return(s1 sqlTx, err error) *SqlBeginnerMock {
+	if mmBegin.mock.funcBegin != nil {
+		mmBegin.mock.t.Fatalf("SqlBeginnerMock.Begin mock is already set by Set")
+	}
+
+	if mmBegin.defaultExpectation == nil {
+		mmBegin.defaultExpectation = &SqlBeginnerMockBeginExpectation{mock: mmBegin.mock}
+	}
+	mmBegin.defaultExpectation.results = &SqlBeginnerMockBeginResults{s1, err}
+	return mmBegin.mock
+}
+
+//Set uses given function f to mock the sqlBeginner.Begin method
+func (mmBegin *mSqlBeginnerMockBegin) Set(f func(ctx context.Context) (s1 sqlTx, err error)) *SqlBeginnerMock {
+	if mmBegin.defaultExpectation != nil {
+		mmBegin.mock.t.Fatalf("Default expectation is already set for the sqlBeginner.Begin method")
+	}
+
+	if len(mmBegin.expectations) > 0 {
+		mmBegin.mock.t.Fatalf("Some expectations are already set for the sqlBeginner.Begin method")
+	}
+
+	mmBegin.mock.funcBegin = f
+	return mmBegin.mock
+}
+
+// When sets expectation for the sqlBeginner.Begin which will trigger the result defined by the following
+// Then helper
+func (mmBegin *mSqlBeginnerMockBegin) When(ctx context.Context) *SqlBeginnerMockBeginExpectation {
+	if mmBegin.mock.funcBegin != nil {
+		mmBegin.mock.t.Fatalf("SqlBeginnerMock.Begin mock is already set by Set")
+	}
+
+	expectation := &SqlBeginnerMockBeginExpectation{
+		mock:   mmBegin.mock,
+		params: &SqlBeginnerMockBeginParams{ctx},
+	}
+	mmBegin.expectations = append(mmBegin.expectations, expectation)
+	return expectation
+}
+
+// Then sets up sqlBeginner.Begin return parameters for the expectation previously defined by the When method
+func (e *SqlBeginnerMockBeginExpectation) Then(s1 sqlTx, err error) *SqlBeginnerMock {
+	e.results = &SqlBeginnerMockBeginResults{s1, err}
+	return e.mock
+}
+
+// Begin implements sqlBeginner
+func (mmBegin *SqlBeginnerMock) Begin(ctx context.Context) (s1 sqlTx, err error) {
+	mm_atomic.AddUint64(&mmBegin.beforeBeginCounter, 1)
+	defer mm_atomic.AddUint64(&mmBegin.afterBeginCounter, 1)
+
+	if mmBegin.inspectFuncBegin != nil {
+		mmBegin.inspectFuncBegin(ctx)
+	}
+
+	mm_params := &SqlBeginnerMockBeginParams{ctx}
+
+	// Record call args
+	mmBegin.BeginMock.mutex.Lock()
+	mmBegin.BeginMock.callArgs = append(mmBegin.BeginMock.callArgs, mm_params)
+	mmBegin.BeginMock.mutex.Unlock()
+
+	for _, e := range mmBegin.BeginMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmBegin.BeginMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmBegin.BeginMock.defaultExpectation.Counter, 1)
+		mm_want := mmBegin.BeginMock.defaultExpectation.params
+		mm_got := SqlBeginnerMockBeginParams{ctx}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmBegin.t.Errorf("SqlBeginnerMock.Begin got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmBegin.BeginMock.defaultExpectation.results
+		if mm_results == nil {
+			mmBegin.t.Fatal("No results are set for the SqlBeginnerMock.Begin")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmBegin.funcBegin != nil {
+		return mmBegin.funcBegin(ctx)
+	}
+	mmBegin.t.Fatalf("Unexpected call to SqlBeginnerMock.Begin. %v", ctx)
+	return
+}
+
+// BeginAfterCounter returns a count of finished SqlBeginnerMock.Begin invocations
+func (mmBegin *SqlBeginnerMock) BeginAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBegin.afterBeginCounter)
+}
+
+// BeginBeforeCounter returns a count of SqlBeginnerMock.Begin invocations
+func (mmBegin *SqlBeginnerMock) BeginBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBegin.beforeBeginCounter)
+}
+
+// Calls returns a list of arguments used in each call to SqlBeginnerMock.Begin.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmBegin *mSqlBeginnerMockBegin) Calls() []*SqlBeginnerMockBeginParams {
+	mmBegin.mutex.RLock()
+
+	argCopy := make([]*SqlBeginnerMockBeginParams, len(mmBegin.callArgs))
+	copy(argCopy, mmBegin.callArgs)
+
+	mmBegin.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockBeginDone returns true if the count of the Begin invocations corresponds
+// the number of defined expectations
+func (m *SqlBeginnerMock) MinimockBeginDone() bool {
+	for _, e := range m.BeginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BeginMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterBeginCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBegin != nil && mm_atomic.LoadUint64(&m.afterBeginCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockBeginInspect logs each unmet expectation
+func (m *SqlBeginnerMock) MinimockBeginInspect() {
+	for _, e := range m.BeginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SqlBeginnerMock.Begin with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BeginMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterBeginCounter) < 1 {
+		if m.BeginMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to SqlBeginnerMock.Begin")
+		} else {
+			m.t.Errorf("Expected call to SqlBeginnerMock.Begin with params: %#v", *m.BeginMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBegin != nil && mm_atomic.LoadUint64(&m.afterBeginCounter) < 1 {
+		m.t.Error("Expected call to SqlBeginnerMock.Begin")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *SqlBeginnerMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockBeginInspect()
+		m.t.FailNow()
+	}
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *SqlBeginnerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *SqlBeginnerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockBeginDone()
+}
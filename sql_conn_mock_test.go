@@ -0,0 +1,1126 @@
+package libsql
+
+// Code generated by http://github.com/gojuno/minimock (dev). DO NOT EDIT.
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// SqlConnMock implements sqlConn
+type SqlConnMock struct {
+	t minimock.Tester
+
+	funcBegin          func(ctx context.Context) (s1 sqlTx, err error)
+	inspectFuncBegin   func(ctx context.Context)
+	afterBeginCounter  uint64
+	beforeBeginCounter uint64
+	BeginMock          mSqlConnMockBegin
+
+	funcClose          func() (err error)
+	inspectFuncClose   func()
+	afterCloseCounter  uint64
+	beforeCloseCounter uint64
+	CloseMock          mSqlConnMockClose
+
+	funcExec          func(ctx context.Context, query string, args ...interface{}) (r1 sql.Result, err error)
+	inspectFuncExec   func(ctx context.Context, query string, args ...interface{})
+	afterExecCounter  uint64
+	beforeExecCounter uint64
+	ExecMock          mSqlConnMockExec
+
+	funcPrepare          func(ctx context.Context, query string) (s1 sqlStmt, err error)
+	inspectFuncPrepare   func(ctx context.Context, query string)
+	afterPrepareCounter  uint64
+	beforePrepareCounter uint64
+	PrepareMock          mSqlConnMockPrepare
+
+	funcQuery          func(ctx context.Context, query string, args ...interface{}) (s1 sqlRows, err error)
+	inspectFuncQuery   func(ctx context.Context, query string, args ...interface{})
+	afterQueryCounter  uint64
+	beforeQueryCounter uint64
+	QueryMock          mSqlConnMockQuery
+}
+
+// NewSqlConnMock returns a mock for sqlConn
+func NewSqlConnMock(t minimock.Tester) *SqlConnMock {
+	m := &SqlConnMock{t: t}
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.BeginMock = mSqlConnMockBegin{mock: m}
+	m.BeginMock.callArgs = []*SqlConnMockBeginParams{}
+
+	m.CloseMock = mSqlConnMockClose{mock: m}
+
+	m.ExecMock = mSqlConnMockExec{mock: m}
+	m.ExecMock.callArgs = []*SqlConnMockExecParams{}
+
+	m.PrepareMock = mSqlConnMockPrepare{mock: m}
+	m.PrepareMock.callArgs = []*SqlConnMockPrepareParams{}
+
+	m.QueryMock = mSqlConnMockQuery{mock: m}
+	m.QueryMock.callArgs = []*SqlConnMockQueryParams{}
+
+	return m
+}
+
+type mSqlConnMockBegin struct {
+	mock               *SqlConnMock
+	defaultExpectation *SqlConnMockBeginExpectation
+	expectations       []*SqlConnMockBeginExpectation
+
+	callArgs []*SqlConnMockBeginParams
+	mutex    sync.RWMutex
+}
+
+// SqlConnMockBeginExpectation specifies expectation struct of the sqlConn.Begin
+type SqlConnMockBeginExpectation struct {
+	mock    *SqlConnMock
+	params  *SqlConnMockBeginParams
+	results *SqlConnMockBeginResults
+	Counter uint64
+}
+
+// SqlConnMockBeginParams contains parameters of the sqlConn.Begin
+type SqlConnMockBeginParams struct {
+	ctx context.Context
+}
+
+// SqlConnMockBeginResults contains results of the sqlConn.Begin
+type SqlConnMockBeginResults struct {
+	s1  sqlTx
+	err error
+}
+
+// Expect sets up expected params for sqlConn.Begin
+func (mmBegin *mSqlConnMockBegin) Expect(ctx context.Context) *mSqlConnMockBegin {
+	if mmBegin.mock.funcBegin != nil {
+		mmBegin.mock.t.Fatalf("SqlConnMock.Begin mock is already set by Set")
+	}
+
+	if mmBegin.defaultExpectation == nil {
+		mmBegin.defaultExpectation = &SqlConnMockBeginExpectation{}
+	}
+
+	mmBegin.defaultExpectation.params = &SqlConnMockBeginParams{ctx}
+	for _, e := range mmBegin.expectations {
+		if minimock.Equal(e.params, mmBegin.defaultExpectation.params) {
+			mmBegin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmBegin.defaultExpectation.params)
+		}
+	}
+
+	return mmBegin
+}
+
+// Inspect accepts an inspector function that has same arguments as the sqlConn.Begin
+func (mmBegin *mSqlConnMockBegin) Inspect(f func(ctx context.Context)) *mSqlConnMockBegin {
+	if mmBegin.mock.inspectFuncBegin != nil {
+		mmBegin.mock.t.Fatalf("Inspect function is already set for SqlConnMock.Begin")
+	}
+
+	mmBegin.mock.inspectFuncBegin = f
+
+	return mmBegin
+}
+
+// Return sets up results that will be returned by sqlConn.Begin
+func (mmBegin *mSqlConnMockBegin) Return(s1 sqlTx, err error) *SqlConnMock {
+	if mmBegin.mock.funcBegin != nil {
+		mmBegin.mock.t.Fatalf("SqlConnMock.Begin mock is already set by Set")
+	}
+
+	if mmBegin.defaultExpectation == nil {
+		mmBegin.defaultExpectation = &SqlConnMockBeginExpectation{mock: mmBegin.mock}
+	}
+	mmBegin.defaultExpectation.results = &SqlConnMockBeginResults{s1, err}
+	return mmBegin.mock
+}
+
+//Set uses given function f to mock the sqlConn.Begin method
+func (mmBegin *mSqlConnMockBegin) Set(f func(ctx context.Context) (s1 sqlTx, err error)) *SqlConnMock {
+	if mmBegin.defaultExpectation != nil {
+		mmBegin.mock.t.Fatalf("Default expectation is already set for the sqlConn.Begin method")
+	}
+
+	if len(mmBegin.expectations) > 0 {
+		mmBegin.mock.t.Fatalf("Some expectations are already set for the sqlConn.Begin method")
+	}
+
+	mmBegin.mock.funcBegin = f
+	return mmBegin.mock
+}
+
+// When sets expectation for the sqlConn.Begin which will trigger the result defined by the following
+// Then helper
+func (mmBegin *mSqlConnMockBegin) When(ctx context.Context) *SqlConnMockBeginExpectation {
+	if mmBegin.mock.funcBegin != nil {
+		mmBegin.mock.t.Fatalf("SqlConnMock.Begin mock is already set by Set")
+	}
+
+	expectation := &SqlConnMockBeginExpectation{
+		mock:   mmBegin.mock,
+		params: &SqlConnMockBeginParams{ctx},
+	}
+	mmBegin.expectations = append(mmBegin.expectations, expectation)
+	return expectation
+}
+
+// Then sets up sqlConn.Begin return parameters for the expectation previously defined by the When method
+func (e *SqlConnMockBeginExpectation) Then(s1 sqlTx, err error) *SqlConnMock {
+	e.results = &SqlConnMockBeginResults{s1, err}
+	return e.mock
+}
+
+// Begin implements sqlConn
+func (mmBegin *SqlConnMock) Begin(ctx context.Context) (s1 sqlTx, err error) {
+	mm_atomic.AddUint64(&mmBegin.beforeBeginCounter, 1)
+	defer mm_atomic.AddUint64(&mmBegin.afterBeginCounter, 1)
+
+	if mmBegin.inspectFuncBegin != nil {
+		mmBegin.inspectFuncBegin(ctx)
+	}
+
+	mm_params := &SqlConnMockBeginParams{ctx}
+
+	// Record call args
+	mmBegin.BeginMock.mutex.Lock()
+	mmBegin.BeginMock.callArgs = append(mmBegin.BeginMock.callArgs, mm_params)
+	mmBegin.BeginMock.mutex.Unlock()
+
+	for _, e := range mmBegin.BeginMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmBegin.BeginMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmBegin.BeginMock.defaultExpectation.Counter, 1)
+		mm_want := mmBegin.BeginMock.defaultExpectation.params
+		mm_got := SqlConnMockBeginParams{ctx}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmBegin.t.Errorf("SqlConnMock.Begin got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmBegin.BeginMock.defaultExpectation.results
+		if mm_results == nil {
+			mmBegin.t.Fatal("No results are set for the SqlConnMock.Begin")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmBegin.funcBegin != nil {
+		return mmBegin.funcBegin(ctx)
+	}
+	mmBegin.t.Fatalf("Unexpected call to SqlConnMock.Begin. %v", ctx)
+	return
+}
+
+// BeginAfterCounter returns a count of finished SqlConnMock.Begin invocations
+func (mmBegin *SqlConnMock) BeginAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBegin.afterBeginCounter)
+}
+
+// BeginBeforeCounter returns a count of SqlConnMock.Begin invocations
+func (mmBegin *SqlConnMock) BeginBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBegin.beforeBeginCounter)
+}
+
+// Calls returns a list of arguments used in each call to SqlConnMock.Begin.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmBegin *mSqlConnMockBegin) Calls() []*SqlConnMockBeginParams {
+	mmBegin.mutex.RLock()
+
+	argCopy := make([]*SqlConnMockBeginParams, len(mmBegin.callArgs))
+	copy(argCopy, mmBegin.callArgs)
+
+	mmBegin.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockBeginDone returns true if the count of the Begin invocations corresponds
+// the number of defined expectations
+func (m *SqlConnMock) MinimockBeginDone() bool {
+	for _, e := range m.BeginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BeginMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterBeginCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBegin != nil && mm_atomic.LoadUint64(&m.afterBeginCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockBeginInspect logs each unmet expectation
+func (m *SqlConnMock) MinimockBeginInspect() {
+	for _, e := range m.BeginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SqlConnMock.Begin with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BeginMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterBeginCounter) < 1 {
+		if m.BeginMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to SqlConnMock.Begin")
+		} else {
+			m.t.Errorf("Expected call to SqlConnMock.Begin with params: %#v", *m.BeginMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBegin != nil && mm_atomic.LoadUint64(&m.afterBeginCounter) < 1 {
+		m.t.Error("Expected call to SqlConnMock.Begin")
+	}
+}
+
+type mSqlConnMockClose struct {
+	mock               *SqlConnMock
+	defaultExpectation *SqlConnMockCloseExpectation
+	expectations       []*SqlConnMockCloseExpectation
+}
+
+// SqlConnMockCloseExpectation specifies expectation struct of the sqlConn.Close
+type SqlConnMockCloseExpectation struct {
+	mock *SqlConnMock
+
+	results *SqlConnMockCloseResults
+	Counter uint64
+}
+
+// SqlConnMockCloseResults contains results of the sqlConn.Close
+type SqlConnMockCloseResults struct {
+	err error
+}
+
+// Expect sets up expected params for sqlConn.Close
+func (mmClose *mSqlConnMockClose) Expect() *mSqlConnMockClose {
+	if mmClose.mock.funcClose != nil {
+		mmClose.mock.t.Fatalf("SqlConnMock.Close mock is already set by Set")
+	}
+
+	if mmClose.defaultExpectation == nil {
+		mmClose.defaultExpectation = &SqlConnMockCloseExpectation{}
+	}
+
+	return mmClose
+}
+
+// Inspect accepts an inspector function that has same arguments as the sqlConn.Close
+func (mmClose *mSqlConnMockClose) Inspect(f func()) *mSqlConnMockClose {
+	if mmClose.mock.inspectFuncClose != nil {
+		mmClose.mock.t.Fatalf("Inspect function is already set for SqlConnMock.Close")
+	}
+
+	mmClose.mock.inspectFuncClose = f
+
+	return mmClose
+}
+
+// Return sets up results that will be returned by sqlConn.Close
+func (mmClose *mSqlConnMockClose) Return(err error) *SqlConnMock {
+	if mmClose.mock.funcClose != nil {
+		mmClose.mock.t.Fatalf("SqlConnMock.Close mock is already set by Set")
+	}
+
+	if mmClose.defaultExpectation == nil {
+		mmClose.defaultExpectation = &SqlConnMockCloseExpectation{mock: mmClose.mock}
+	}
+	mmClose.defaultExpectation.results = &SqlConnMockCloseResults{err}
+	return mmClose.mock
+}
+
+//Set uses given function f to mock the sqlConn.Close method
+func (mmClose *mSqlConnMockClose) Set(f func() (err error)) *SqlConnMock {
+	if mmClose.defaultExpectation != nil {
+		mmClose.mock.t.Fatalf("Default expectation is already set for the sqlConn.Close method")
+	}
+
+	if len(mmClose.expectations) > 0 {
+		mmClose.mock.t.Fatalf("Some expectations are already set for the sqlConn.Close method")
+	}
+
+	mmClose.mock.funcClose = f
+	return mmClose.mock
+}
+
+// Close implements sqlConn
+func (mmClose *SqlConnMock) Close() (err error) {
+	mm_atomic.AddUint64(&mmClose.beforeCloseCounter, 1)
+	defer mm_atomic.AddUint64(&mmClose.afterCloseCounter, 1)
+
+	if mmClose.inspectFuncClose != nil {
+		mmClose.inspectFuncClose()
+	}
+
+	if mmClose.CloseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClose.CloseMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmClose.CloseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClose.t.Fatal("No results are set for the SqlConnMock.Close")
+		}
+		return (*mm_results).err
+	}
+	if mmClose.funcClose != nil {
+		return mmClose.funcClose()
+	}
+	mmClose.t.Fatalf("Unexpected call to SqlConnMock.Close.")
+	return
+}
+
+// CloseAfterCounter returns a count of finished SqlConnMock.Close invocations
+func (mmClose *SqlConnMock) CloseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClose.afterCloseCounter)
+}
+
+// CloseBeforeCounter returns a count of SqlConnMock.Close invocations
+func (mmClose *SqlConnMock) CloseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClose.beforeCloseCounter)
+}
+
+// MinimockCloseDone returns true if the count of the Close invocations corresponds
+// the number of defined expectations
+func (m *SqlConnMock) MinimockCloseDone() bool {
+	for _, e := range m.CloseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CloseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCloseCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClose != nil && mm_atomic.LoadUint64(&m.afterCloseCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCloseInspect logs each unmet expectation
+func (m *SqlConnMock) MinimockCloseInspect() {
+	for _, e := range m.CloseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to SqlConnMock.Close")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CloseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCloseCounter) < 1 {
+		m.t.Error("Expected call to SqlConnMock.Close")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClose != nil && mm_atomic.LoadUint64(&m.afterCloseCounter) < 1 {
+		m.t.Error("Expected call to SqlConnMock.Close")
+	}
+}
+
+type mSqlConnMockExec struct {
+	mock               *SqlConnMock
+	defaultExpectation *SqlConnMockExecExpectation
+	expectations       []*SqlConnMockExecExpectation
+
+	callArgs []*SqlConnMockExecParams
+	mutex    sync.RWMutex
+}
+
+// SqlConnMockExecExpectation specifies expectation struct of the sqlConn.Exec
+type SqlConnMockExecExpectation struct {
+	mock    *SqlConnMock
+	params  *SqlConnMockExecParams
+	results *SqlConnMockExecResults
+	Counter uint64
+}
+
+// SqlConnMockExecParams contains parameters of the sqlConn.Exec
+type SqlConnMockExecParams struct {
+	ctx   context.Context
+	query string
+	args  []interface{}
+}
+
+// SqlConnMockExecResults contains results of the sqlConn.Exec
+type SqlConnMockExecResults struct {
+	r1  sql.Result
+	err error
+}
+
+// Expect sets up expected params for sqlConn.Exec
+func (mmExec *mSqlConnMockExec) Expect(ctx context.Context, query string, args ...interface{}) *mSqlConnMockExec {
+	if mmExec.mock.funcExec != nil {
+		mmExec.mock.t.Fatalf("SqlConnMock.Exec mock is already set by Set")
+	}
+
+	if mmExec.defaultExpectation == nil {
+		mmExec.defaultExpectation = &SqlConnMockExecExpectation{}
+	}
+
+	mmExec.defaultExpectation.params = &SqlConnMockExecParams{ctx, query, args}
+	for _, e := range mmExec.expectations {
+		if minimock.Equal(e.params, mmExec.defaultExpectation.params) {
+			mmExec.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExec.defaultExpectation.params)
+		}
+	}
+
+	return mmExec
+}
+
+// Inspect accepts an inspector function that has same arguments as the sqlConn.Exec
+func (mmExec *mSqlConnMockExec) Inspect(f func(ctx context.Context, query string, args ...interface{})) *mSqlConnMockExec {
+	if mmExec.mock.inspectFuncExec != nil {
+		mmExec.mock.t.Fatalf("Inspect function is already set for SqlConnMock.Exec")
+	}
+
+	mmExec.mock.inspectFuncExec = f
+
+	return mmExec
+}
+
+// Return sets up results that will be returned by sqlConn.Exec
+func (mmExec *mSqlConnMockExec) Return(r1 sql.Result, err error) *SqlConnMock {
+	if mmExec.mock.funcExec != nil {
+		mmExec.mock.t.Fatalf("SqlConnMock.Exec mock is already set by Set")
+	}
+
+	if mmExec.defaultExpectation == nil {
+		mmExec.defaultExpectation = &SqlConnMockExecExpectation{mock: mmExec.mock}
+	}
+	mmExec.defaultExpectation.results = &SqlConnMockExecResults{r1, err}
+	return mmExec.mock
+}
+
+//Set uses given function f to mock the sqlConn.Exec method
+func (mmExec *mSqlConnMockExec) Set(f func(ctx context.Context, query string, args ...interface{}) (r1 sql.Result, err error)) *SqlConnMock {
+	if mmExec.defaultExpectation != nil {
+		mmExec.mock.t.Fatalf("Default expectation is already set for the sqlConn.Exec method")
+	}
+
+	if len(mmExec.expectations) > 0 {
+		mmExec.mock.t.Fatalf("Some expectations are already set for the sqlConn.Exec method")
+	}
+
+	mmExec.mock.funcExec = f
+	return mmExec.mock
+}
+
+// When sets expectation for the sqlConn.Exec which will trigger the result defined by the following
+// Then helper
+func (mmExec *mSqlConnMockExec) When(ctx context.Context, query string, args ...interface{}) *SqlConnMockExecExpectation {
+	if mmExec.mock.funcExec != nil {
+		mmExec.mock.t.Fatalf("SqlConnMock.Exec mock is already set by Set")
+	}
+
+	expectation := &SqlConnMockExecExpectation{
+		mock:   mmExec.mock,
+		params: &SqlConnMockExecParams{ctx, query, args},
+	}
+	mmExec.expectations = append(mmExec.expectations, expectation)
+	return expectation
+}
+
+// Then sets up sqlConn.Exec return parameters for the expectation previously defined by the When method
+func (e *SqlConnMockExecExpectation) Then(r1 sql.Result, err error) *SqlConnMock {
+	e.results = &SqlConnMockExecResults{r1, err}
+	return e.mock
+}
+
+// Exec implements sqlConn
+func (mmExec *SqlConnMock) Exec(ctx context.Context, query string, args ...interface{}) (r1 sql.Result, err error) {
+	mm_atomic.AddUint64(&mmExec.beforeExecCounter, 1)
+	defer mm_atomic.AddUint64(&mmExec.afterExecCounter, 1)
+
+	if mmExec.inspectFuncExec != nil {
+		mmExec.inspectFuncExec(ctx, query, args...)
+	}
+
+	mm_params := &SqlConnMockExecParams{ctx, query, args}
+
+	// Record call args
+	mmExec.ExecMock.mutex.Lock()
+	mmExec.ExecMock.callArgs = append(mmExec.ExecMock.callArgs, mm_params)
+	mmExec.ExecMock.mutex.Unlock()
+
+	for _, e := range mmExec.ExecMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.r1, e.results.err
+		}
+	}
+
+	if mmExec.ExecMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExec.ExecMock.defaultExpectation.Counter, 1)
+		mm_want := mmExec.ExecMock.defaultExpectation.params
+		mm_got := SqlConnMockExecParams{ctx, query, args}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExec.t.Errorf("SqlConnMock.Exec got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExec.ExecMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExec.t.Fatal("No results are set for the SqlConnMock.Exec")
+		}
+		return (*mm_results).r1, (*mm_results).err
+	}
+	if mmExec.funcExec != nil {
+		return mmExec.funcExec(ctx, query, args...)
+	}
+	mmExec.t.Fatalf("Unexpected call to SqlConnMock.Exec. %v %v %v", ctx, query, args)
+	return
+}
+
+// ExecAfterCounter returns a count of finished SqlConnMock.Exec invocations
+func (mmExec *SqlConnMock) ExecAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExec.afterExecCounter)
+}
+
+// ExecBeforeCounter returns a count of SqlConnMock.Exec invocations
+func (mmExec *SqlConnMock) ExecBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExec.beforeExecCounter)
+}
+
+// Calls returns a list of arguments used in each call to SqlConnMock.Exec.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExec *mSqlConnMockExec) Calls() []*SqlConnMockExecParams {
+	mmExec.mutex.RLock()
+
+	argCopy := make([]*SqlConnMockExecParams, len(mmExec.callArgs))
+	copy(argCopy, mmExec.callArgs)
+
+	mmExec.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExecDone returns true if the count of the Exec invocations corresponds
+// the number of defined expectations
+func (m *SqlConnMock) MinimockExecDone() bool {
+	for _, e := range m.ExecMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExecMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterExecCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExec != nil && mm_atomic.LoadUint64(&m.afterExecCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockExecInspect logs each unmet expectation
+func (m *SqlConnMock) MinimockExecInspect() {
+	for _, e := range m.ExecMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SqlConnMock.Exec with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExecMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterExecCounter) < 1 {
+		if m.ExecMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to SqlConnMock.Exec")
+		} else {
+			m.t.Errorf("Expected call to SqlConnMock.Exec with params: %#v", *m.ExecMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExec != nil && mm_atomic.LoadUint64(&m.afterExecCounter) < 1 {
+		m.t.Error("Expected call to SqlConnMock.Exec")
+	}
+}
+
+type mSqlConnMockPrepare struct {
+	mock               *SqlConnMock
+	defaultExpectation *SqlConnMockPrepareExpectation
+	expectations       []*SqlConnMockPrepareExpectation
+
+	callArgs []*SqlConnMockPrepareParams
+	mutex    sync.RWMutex
+}
+
+// SqlConnMockPrepareExpectation specifies expectation struct of the sqlConn.Prepare
+type SqlConnMockPrepareExpectation struct {
+	mock    *SqlConnMock
+	params  *SqlConnMockPrepareParams
+	results *SqlConnMockPrepareResults
+	Counter uint64
+}
+
+// SqlConnMockPrepareParams contains parameters of the sqlConn.Prepare
+type SqlConnMockPrepareParams struct {
+	ctx   context.Context
+	query string
+}
+
+// SqlConnMockPrepareResults contains results of the sqlConn.Prepare
+type SqlConnMockPrepareResults struct {
+	s1  sqlStmt
+	err error
+}
+
+// Expect sets up expected params for sqlConn.Prepare
+func (mmPrepare *mSqlConnMockPrepare) Expect(ctx context.Context, query string) *mSqlConnMockPrepare {
+	if mmPrepare.mock.funcPrepare != nil {
+		mmPrepare.mock.t.Fatalf("SqlConnMock.Prepare mock is already set by Set")
+	}
+
+	if mmPrepare.defaultExpectation == nil {
+		mmPrepare.defaultExpectation = &SqlConnMockPrepareExpectation{}
+	}
+
+	mmPrepare.defaultExpectation.params = &SqlConnMockPrepareParams{ctx, query}
+	for _, e := range mmPrepare.expectations {
+		if minimock.Equal(e.params, mmPrepare.defaultExpectation.params) {
+			mmPrepare.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPrepare.defaultExpectation.params)
+		}
+	}
+
+	return mmPrepare
+}
+
+// Inspect accepts an inspector function that has same arguments as the sqlConn.Prepare
+func (mmPrepare *mSqlConnMockPrepare) Inspect(f func(ctx context.Context, query string)) *mSqlConnMockPrepare {
+	if mmPrepare.mock.inspectFuncPrepare != nil {
+		mmPrepare.mock.t.Fatalf("Inspect function is already set for SqlConnMock.Prepare")
+	}
+
+	mmPrepare.mock.inspectFuncPrepare = f
+
+	return mmPrepare
+}
+
+// Return sets up results that will be returned by sqlConn.Prepare
+func (mmPrepare *mSqlConnMockPrepare) Return(s1 sqlStmt, err error) *SqlConnMock {
+	if mmPrepare.mock.funcPrepare != nil {
+		mmPrepare.mock.t.Fatalf("SqlConnMock.Prepare mock is already set by Set")
+	}
+
+	if mmPrepare.defaultExpectation == nil {
+		mmPrepare.defaultExpectation = &SqlConnMockPrepareExpectation{mock: mmPrepare.mock}
+	}
+	mmPrepare.defaultExpectation.results = &SqlConnMockPrepareResults{s1, err}
+	return mmPrepare.mock
+}
+
+//Set uses given function f to mock the sqlConn.Prepare method
+func (mmPrepare *mSqlConnMockPrepare) Set(f func(ctx context.Context, query string) (s1 sqlStmt, err error)) *SqlConnMock {
+	if mmPrepare.defaultExpectation != nil {
+		mmPrepare.mock.t.Fatalf("Default expectation is already set for the sqlConn.Prepare method")
+	}
+
+	if len(mmPrepare.expectations) > 0 {
+		mmPrepare.mock.t.Fatalf("Some expectations are already set for the sqlConn.Prepare method")
+	}
+
+	mmPrepare.mock.funcPrepare = f
+	return mmPrepare.mock
+}
+
+// When sets expectation for the sqlConn.Prepare which will trigger the result defined by the following
+// Then helper
+func (mmPrepare *mSqlConnMockPrepare) When(ctx context.Context, query string) *SqlConnMockPrepareExpectation {
+	if mmPrepare.mock.funcPrepare != nil {
+		mmPrepare.mock.t.Fatalf("SqlConnMock.Prepare mock is already set by Set")
+	}
+
+	expectation := &SqlConnMockPrepareExpectation{
+		mock:   mmPrepare.mock,
+		params: &SqlConnMockPrepareParams{ctx, query},
+	}
+	mmPrepare.expectations = append(mmPrepare.expectations, expectation)
+	return expectation
+}
+
+// Then sets up sqlConn.Prepare return parameters for the expectation previously defined by the When method
+func (e *SqlConnMockPrepareExpectation) Then(s1 sqlStmt, err error) *SqlConnMock {
+	e.results = &SqlConnMockPrepareResults{s1, err}
+	return e.mock
+}
+
+// Prepare implements sqlConn
+func (mmPrepare *SqlConnMock) Prepare(ctx context.Context, query string) (s1 sqlStmt, err error) {
+	mm_atomic.AddUint64(&mmPrepare.beforePrepareCounter, 1)
+	defer mm_atomic.AddUint64(&mmPrepare.afterPrepareCounter, 1)
+
+	if mmPrepare.inspectFuncPrepare != nil {
+		mmPrepare.inspectFuncPrepare(ctx, query)
+	}
+
+	mm_params := &SqlConnMockPrepareParams{ctx, query}
+
+	// Record call args
+	mmPrepare.PrepareMock.mutex.Lock()
+	mmPrepare.PrepareMock.callArgs = append(mmPrepare.PrepareMock.callArgs, mm_params)
+	mmPrepare.PrepareMock.mutex.Unlock()
+
+	for _, e := range mmPrepare.PrepareMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmPrepare.PrepareMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPrepare.PrepareMock.defaultExpectation.Counter, 1)
+		mm_want := mmPrepare.PrepareMock.defaultExpectation.params
+		mm_got := SqlConnMockPrepareParams{ctx, query}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPrepare.t.Errorf("SqlConnMock.Prepare got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPrepare.PrepareMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPrepare.t.Fatal("No results are set for the SqlConnMock.Prepare")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmPrepare.funcPrepare != nil {
+		return mmPrepare.funcPrepare(ctx, query)
+	}
+	mmPrepare.t.Fatalf("Unexpected call to SqlConnMock.Prepare. %v %v", ctx, query)
+	return
+}
+
+// PrepareAfterCounter returns a count of finished SqlConnMock.Prepare invocations
+func (mmPrepare *SqlConnMock) PrepareAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPrepare.afterPrepareCounter)
+}
+
+// PrepareBeforeCounter returns a count of SqlConnMock.Prepare invocations
+func (mmPrepare *SqlConnMock) PrepareBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPrepare.beforePrepareCounter)
+}
+
+// Calls returns a list of arguments used in each call to SqlConnMock.Prepare.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPrepare *mSqlConnMockPrepare) Calls() []*SqlConnMockPrepareParams {
+	mmPrepare.mutex.RLock()
+
+	argCopy := make([]*SqlConnMockPrepareParams, len(mmPrepare.callArgs))
+	copy(argCopy, mmPrepare.callArgs)
+
+	mmPrepare.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPrepareDone returns true if the count of the Prepare invocations corresponds
+// the number of defined expectations
+func (m *SqlConnMock) MinimockPrepareDone() bool {
+	for _, e := range m.PrepareMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PrepareMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPrepareCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPrepare != nil && mm_atomic.LoadUint64(&m.afterPrepareCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockPrepareInspect logs each unmet expectation
+func (m *SqlConnMock) MinimockPrepareInspect() {
+	for _, e := range m.PrepareMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SqlConnMock.Prepare with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PrepareMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPrepareCounter) < 1 {
+		if m.PrepareMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to SqlConnMock.Prepare")
+		} else {
+			m.t.Errorf("Expected call to SqlConnMock.Prepare with params: %#v", *m.PrepareMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPrepare != nil && mm_atomic.LoadUint64(&m.afterPrepareCounter) < 1 {
+		m.t.Error("Expected call to SqlConnMock.Prepare")
+	}
+}
+
+type mSqlConnMockQuery struct {
+	mock               *SqlConnMock
+	defaultExpectation *SqlConnMockQueryExpectation
+	expectations       []*SqlConnMockQueryExpectation
+
+	callArgs []*SqlConnMockQueryParams
+	mutex    sync.RWMutex
+}
+
+// SqlConnMockQueryExpectation specifies expectation struct of the sqlConn.Query
+type SqlConnMockQueryExpectation struct {
+	mock    *SqlConnMock
+	params  *SqlConnMockQueryParams
+	results *SqlConnMockQueryResults
+	Counter uint64
+}
+
+// SqlConnMockQueryParams contains parameters of the sqlConn.Query
+type SqlConnMockQueryParams struct {
+	ctx   context.Context
+	query string
+	args  []interface{}
+}
+
+// SqlConnMockQueryResults contains results of the sqlConn.Query
+type SqlConnMockQueryResults struct {
+	s1  sqlRows
+	err error
+}
+
+// Expect sets up expected params for sqlConn.Query
+func (mmQuery *mSqlConnMockQuery) Expect(ctx context.Context, query string, args ...interface{}) *mSqlConnMockQuery {
+	if mmQuery.mock.funcQuery != nil {
+		mmQuery.mock.t.Fatalf("SqlConnMock.Query mock is already set by Set")
+	}
+
+	if mmQuery.defaultExpectation == nil {
+		mmQuery.defaultExpectation = &SqlConnMockQueryExpectation{}
+	}
+
+	mmQuery.defaultExpectation.params = &SqlConnMockQueryParams{ctx, query, args}
+	for _, e := range mmQuery.expectations {
+		if minimock.Equal(e.params, mmQuery.defaultExpectation.params) {
+			mmQuery.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmQuery.defaultExpectation.params)
+		}
+	}
+
+	return mmQuery
+}
+
+// Inspect accepts an inspector function that has same arguments as the sqlConn.Query
+func (mmQuery *mSqlConnMockQuery) Inspect(f func(ctx context.Context, query string, args ...interface{})) *mSqlConnMockQuery {
+	if mmQuery.mock.inspectFuncQuery != nil {
+		mmQuery.mock.t.Fatalf("Inspect function is already set for SqlConnMock.Query")
+	}
+
+	mmQuery.mock.inspectFuncQuery = f
+
+	return mmQuery
+}
+
+// Return sets up results that will be returned by sqlConn.Query
+func (mmQuery *mSqlConnMockQuery) Return(s1 sqlRows, err error) *SqlConnMock {
+	if mmQuery.mock.funcQuery != nil {
+		mmQuery.mock.t.Fatalf("SqlConnMock.Query mock is already set by Set")
+	}
+
+	if mmQuery.defaultExpectation == nil {
+		mmQuery.defaultExpectation = &SqlConnMockQueryExpectation{mock: mmQuery.mock}
+	}
+	mmQuery.defaultExpectation.results = &SqlConnMockQueryResults{s1, err}
+	return mmQuery.mock
+}
+
+//Set uses given function f to mock the sqlConn.Query method
+func (mmQuery *mSqlConnMockQuery) Set(f func(ctx context.Context, query string, args ...interface{}) (s1 sqlRows, err error)) *SqlConnMock {
+	if mmQuery.defaultExpectation != nil {
+		mmQuery.mock.t.Fatalf("Default expectation is already set for the sqlConn.Query method")
+	}
+
+	if len(mmQuery.expectations) > 0 {
+		mmQuery.mock.t.Fatalf("Some expectations are already set for the sqlConn.Query method")
+	}
+
+	mmQuery.mock.funcQuery = f
+	return mmQuery.mock
+}
+
+// When sets expectation for the sqlConn.Query which will trigger the result defined by the following
+// Then helper
+func (mmQuery *mSqlConnMockQuery) When(ctx context.Context, query string, args ...interface{}) *SqlConnMockQueryExpectation {
+	if mmQuery.mock.funcQuery != nil {
+		mmQuery.mock.t.Fatalf("SqlConnMock.Query mock is already set by Set")
+	}
+
+	expectation := &SqlConnMockQueryExpectation{
+		mock:   mmQuery.mock,
+		params: &SqlConnMockQueryParams{ctx, query, args},
+	}
+	mmQuery.expectations = append(mmQuery.expectations, expectation)
+	return expectation
+}
+
+// Then sets up sqlConn.Query return parameters for the expectation previously defined by the When method
+func (e *SqlConnMockQueryExpectation) Then(s1 sqlRows, err error) *SqlConnMock {
+	e.results = &SqlConnMockQueryResults{s1, err}
+	return e.mock
+}
+
+// Query implements sqlConn
+func (mmQuery *SqlConnMock) Query(ctx context.Context, query string, args ...interface{}) (s1 sqlRows, err error) {
+	mm_atomic.AddUint64(&mmQuery.beforeQueryCounter, 1)
+	defer mm_atomic.AddUint64(&mmQuery.afterQueryCounter, 1)
+
+	if mmQuery.inspectFuncQuery != nil {
+		mmQuery.inspectFuncQuery(ctx, query, args...)
+	}
+
+	mm_params := &SqlConnMockQueryParams{ctx, query, args}
+
+	// Record call args
+	mmQuery.QueryMock.mutex.Lock()
+	mmQuery.QueryMock.callArgs = append(mmQuery.QueryMock.callArgs, mm_params)
+	mmQuery.QueryMock.mutex.Unlock()
+
+	for _, e := range mmQuery.QueryMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmQuery.QueryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmQuery.QueryMock.defaultExpectation.Counter, 1)
+		mm_want := mmQuery.QueryMock.defaultExpectation.params
+		mm_got := SqlConnMockQueryParams{ctx, query, args}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmQuery.t.Errorf("SqlConnMock.Query got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmQuery.QueryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmQuery.t.Fatal("No results are set for the SqlConnMock.Query")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmQuery.funcQuery != nil {
+		return mmQuery.funcQuery(ctx, query, args...)
+	}
+	mmQuery.t.Fatalf("Unexpected call to SqlConnMock.Query. %v %v %v", ctx, query, args)
+	return
+}
+
+// QueryAfterCounter returns a count of finished SqlConnMock.Query invocations
+func (mmQuery *SqlConnMock) QueryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmQuery.afterQueryCounter)
+}
+
+// QueryBeforeCounter returns a count of SqlConnMock.Query invocations
+func (mmQuery *SqlConnMock) QueryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmQuery.beforeQueryCounter)
+}
+
+// Calls returns a list of arguments used in each call to SqlConnMock.Query.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmQuery *mSqlConnMockQuery) Calls() []*SqlConnMockQueryParams {
+	mmQuery.mutex.RLock()
+
+	argCopy := make([]*SqlConnMockQueryParams, len(mmQuery.callArgs))
+	copy(argCopy, mmQuery.callArgs)
+
+	mmQuery.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockQueryDone returns true if the count of the Query invocations corresponds
+// the number of defined expectations
+func (m *SqlConnMock) MinimockQueryDone() bool {
+	for _, e := range m.QueryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.QueryMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterQueryCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcQuery != nil && mm_atomic.LoadUint64(&m.afterQueryCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockQueryInspect logs each unmet expectation
+func (m *SqlConnMock) MinimockQueryInspect() {
+	for _, e := range m.QueryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SqlConnMock.Query with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.QueryMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterQueryCounter) < 1 {
+		if m.QueryMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to SqlConnMock.Query")
+		} else {
+			m.t.Errorf("Expected call to SqlConnMock.Query with params: %#v", *m.QueryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcQuery != nil && mm_atomic.LoadUint64(&m.afterQueryCounter) < 1 {
+		m.t.Error("Expected call to SqlConnMock.Query")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *SqlConnMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockBeginInspect()
+
+		m.MinimockCloseInspect()
+
+		m.MinimockExecInspect()
+
+		m.MinimockPrepareInspect()
+
+		m.MinimockQueryInspect()
+		m.t.FailNow()
+	}
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *SqlConnMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *SqlConnMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockBeginDone() &&
+		m.MinimockCloseDone() &&
+		m.MinimockExecDone() &&
+		m.MinimockPrepareDone() &&
+		m.MinimockQueryDone()
+}
@@ -7,6 +7,7 @@ import (
 	"database/sql"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
@@ -28,12 +29,24 @@ type SqlDBMock struct {
 	beforeCloseCounter uint64
 	CloseMock          mSqlDBMockClose
 
+	funcConn          func(ctx context.Context) (s1 sqlConn, err error)
+	inspectFuncConn   func(ctx context.Context)
+	afterConnCounter  uint64
+	beforeConnCounter uint64
+	ConnMock          mSqlDBMockConn
+
 	funcExec          func(ctx context.Context, query string, args ...interface{}) (r1 sql.Result, err error)
 	inspectFuncExec   func(ctx context.Context, query string, args ...interface{})
 	afterExecCounter  uint64
 	beforeExecCounter uint64
 	ExecMock          mSqlDBMockExec
 
+	funcPing          func(ctx context.Context) (err error)
+	inspectFuncPing   func(ctx context.Context)
+	afterPingCounter  uint64
+	beforePingCounter uint64
+	PingMock          mSqlDBMockPing
+
 	funcPrepare          func(ctx context.Context, query string) (s1 sqlStmt, err error)
 	inspectFuncPrepare   func(ctx context.Context, query string)
 	afterPrepareCounter  uint64
@@ -45,6 +58,36 @@ type SqlDBMock struct {
 	afterQueryCounter  uint64
 	beforeQueryCounter uint64
 	QueryMock          mSqlDBMockQuery
+
+	funcSetConnMaxIdleTime          func(d time.Duration)
+	inspectFuncSetConnMaxIdleTime   func(d time.Duration)
+	afterSetConnMaxIdleTimeCounter  uint64
+	beforeSetConnMaxIdleTimeCounter uint64
+	SetConnMaxIdleTimeMock          mSqlDBMockSetConnMaxIdleTime
+
+	funcSetConnMaxLifetime          func(d time.Duration)
+	inspectFuncSetConnMaxLifetime   func(d time.Duration)
+	afterSetConnMaxLifetimeCounter  uint64
+	beforeSetConnMaxLifetimeCounter uint64
+	SetConnMaxLifetimeMock          mSqlDBMockSetConnMaxLifetime
+
+	funcSetMaxIdleConns          func(n int)
+	inspectFuncSetMaxIdleConns   func(n int)
+	afterSetMaxIdleConnsCounter  uint64
+	beforeSetMaxIdleConnsCounter uint64
+	SetMaxIdleConnsMock          mSqlDBMockSetMaxIdleConns
+
+	funcSetMaxOpenConns          func(n int)
+	inspectFuncSetMaxOpenConns   func(n int)
+	afterSetMaxOpenConnsCounter  uint64
+	beforeSetMaxOpenConnsCounter uint64
+	SetMaxOpenConnsMock          mSqlDBMockSetMaxOpenConns
+
+	funcStats          func() (d1 sql.DBStats)
+	inspectFuncStats   func()
+	afterStatsCounter  uint64
+	beforeStatsCounter uint64
+	StatsMock          mSqlDBMockStats
 }
 
 // NewSqlDBMock returns a mock for sqlDB
@@ -59,15 +102,35 @@ func NewSqlDBMock(t minimock.Tester) *SqlDBMock {
 
 	m.CloseMock = mSqlDBMockClose{mock: m}
 
+	m.ConnMock = mSqlDBMockConn{mock: m}
+	m.ConnMock.callArgs = []*SqlDBMockConnParams{}
+
 	m.ExecMock = mSqlDBMockExec{mock: m}
 	m.ExecMock.callArgs = []*SqlDBMockExecParams{}
 
+	m.PingMock = mSqlDBMockPing{mock: m}
+	m.PingMock.callArgs = []*SqlDBMockPingParams{}
+
 	m.PrepareMock = mSqlDBMockPrepare{mock: m}
 	m.PrepareMock.callArgs = []*SqlDBMockPrepareParams{}
 
 	m.QueryMock = mSqlDBMockQuery{mock: m}
 	m.QueryMock.callArgs = []*SqlDBMockQueryParams{}
 
+	m.SetConnMaxIdleTimeMock = mSqlDBMockSetConnMaxIdleTime{mock: m}
+	m.SetConnMaxIdleTimeMock.callArgs = []*SqlDBMockSetConnMaxIdleTimeParams{}
+
+	m.SetConnMaxLifetimeMock = mSqlDBMockSetConnMaxLifetime{mock: m}
+	m.SetConnMaxLifetimeMock.callArgs = []*SqlDBMockSetConnMaxLifetimeParams{}
+
+	m.SetMaxIdleConnsMock = mSqlDBMockSetMaxIdleConns{mock: m}
+	m.SetMaxIdleConnsMock.callArgs = []*SqlDBMockSetMaxIdleConnsParams{}
+
+	m.SetMaxOpenConnsMock = mSqlDBMockSetMaxOpenConns{mock: m}
+	m.SetMaxOpenConnsMock.callArgs = []*SqlDBMockSetMaxOpenConnsParams{}
+
+	m.StatsMock = mSqlDBMockStats{mock: m}
+
 	return m
 }
 
@@ -430,6 +493,222 @@ func (m *SqlDBMock) MinimockCloseInspect() {
 	}
 }
 
+type mSqlDBMockConn struct {
+	mock               *SqlDBMock
+	defaultExpectation *SqlDBMockConnExpectation
+	expectations       []*SqlDBMockConnExpectation
+
+	callArgs []*SqlDBMockConnParams
+	mutex    sync.RWMutex
+}
+
+// SqlDBMockConnExpectation specifies expectation struct of the sqlDB.Conn
+type SqlDBMockConnExpectation struct {
+	mock    *SqlDBMock
+	params  *SqlDBMockConnParams
+	results *SqlDBMockConnResults
+	Counter uint64
+}
+
+// SqlDBMockConnParams contains parameters of the sqlDB.Conn
+type SqlDBMockConnParams struct {
+	ctx context.Context
+}
+
+// SqlDBMockConnResults contains results of the sqlDB.Conn
+type SqlDBMockConnResults struct {
+	s1  sqlConn
+	err error
+}
+
+// Expect sets up expected params for sqlDB.Conn
+func (mmConn *mSqlDBMockConn) Expect(ctx context.Context) *mSqlDBMockConn {
+	if mmConn.mock.funcConn != nil {
+		mmConn.mock.t.Fatalf("SqlDBMock.Conn mock is already set by Set")
+	}
+
+	if mmConn.defaultExpectation == nil {
+		mmConn.defaultExpectation = &SqlDBMockConnExpectation{}
+	}
+
+	mmConn.defaultExpectation.params = &SqlDBMockConnParams{ctx}
+	for _, e := range mmConn.expectations {
+		if minimock.Equal(e.params, mmConn.defaultExpectation.params) {
+			mmConn.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmConn.defaultExpectation.params)
+		}
+	}
+
+	return mmConn
+}
+
+// Inspect accepts an inspector function that has same arguments as the sqlDB.Conn
+func (mmConn *mSqlDBMockConn) Inspect(f func(ctx context.Context)) *mSqlDBMockConn {
+	if mmConn.mock.inspectFuncConn != nil {
+		mmConn.mock.t.Fatalf("Inspect function is already set for SqlDBMock.Conn")
+	}
+
+	mmConn.mock.inspectFuncConn = f
+
+	return mmConn
+}
+
+// Return sets up results that will be returned by sqlDB.Conn
+func (mmConn *mSqlDBMockConn) Return(s1 sqlConn, err error) *SqlDBMock {
+	if mmConn.mock.funcConn != nil {
+		mmConn.mock.t.Fatalf("SqlDBMock.Conn mock is already set by Set")
+	}
+
+	if mmConn.defaultExpectation == nil {
+		mmConn.defaultExpectation = &SqlDBMockConnExpectation{mock: mmConn.mock}
+	}
+	mmConn.defaultExpectation.results = &SqlDBMockConnResults{s1, err}
+	return mmConn.mock
+}
+
+//Set uses given function f to mock the sqlDB.Conn method
+func (mmConn *mSqlDBMockConn) Set(f func(ctx context.Context) (s1 sqlConn, err error)) *SqlDBMock {
+	if mmConn.defaultExpectation != nil {
+		mmConn.mock.t.Fatalf("Default expectation is already set for the sqlDB.Conn method")
+	}
+
+	if len(mmConn.expectations) > 0 {
+		mmConn.mock.t.Fatalf("Some expectations are already set for the sqlDB.Conn method")
+	}
+
+	mmConn.mock.funcConn = f
+	return mmConn.mock
+}
+
+// When sets expectation for the sqlDB.Conn which will trigger the result defined by the following
+// Then helper
+func (mmConn *mSqlDBMockConn) When(ctx context.Context) *SqlDBMockConnExpectation {
+	if mmConn.mock.funcConn != nil {
+		mmConn.mock.t.Fatalf("SqlDBMock.Conn mock is already set by Set")
+	}
+
+	expectation := &SqlDBMockConnExpectation{
+		mock:   mmConn.mock,
+		params: &SqlDBMockConnParams{ctx},
+	}
+	mmConn.expectations = append(mmConn.expectations, expectation)
+	return expectation
+}
+
+// Then sets up sqlDB.Conn return parameters for the expectation previously defined by the When method
+func (e *SqlDBMockConnExpectation) Then(s1 sqlConn, err error) *SqlDBMock {
+	e.results = &SqlDBMockConnResults{s1, err}
+	return e.mock
+}
+
+// Conn implements sqlDB
+func (mmConn *SqlDBMock) Conn(ctx context.Context) (s1 sqlConn, err error) {
+	mm_atomic.AddUint64(&mmConn.beforeConnCounter, 1)
+	defer mm_atomic.AddUint64(&mmConn.afterConnCounter, 1)
+
+	if mmConn.inspectFuncConn != nil {
+		mmConn.inspectFuncConn(ctx)
+	}
+
+	mm_params := &SqlDBMockConnParams{ctx}
+
+	// Record call args
+	mmConn.ConnMock.mutex.Lock()
+	mmConn.ConnMock.callArgs = append(mmConn.ConnMock.callArgs, mm_params)
+	mmConn.ConnMock.mutex.Unlock()
+
+	for _, e := range mmConn.ConnMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmConn.ConnMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmConn.ConnMock.defaultExpectation.Counter, 1)
+		mm_want := mmConn.ConnMock.defaultExpectation.params
+		mm_got := SqlDBMockConnParams{ctx}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmConn.t.Errorf("SqlDBMock.Conn got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmConn.ConnMock.defaultExpectation.results
+		if mm_results == nil {
+			mmConn.t.Fatal("No results are set for the SqlDBMock.Conn")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmConn.funcConn != nil {
+		return mmConn.funcConn(ctx)
+	}
+	mmConn.t.Fatalf("Unexpected call to SqlDBMock.Conn. %v", ctx)
+	return
+}
+
+// ConnAfterCounter returns a count of finished SqlDBMock.Conn invocations
+func (mmConn *SqlDBMock) ConnAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConn.afterConnCounter)
+}
+
+// ConnBeforeCounter returns a count of SqlDBMock.Conn invocations
+func (mmConn *SqlDBMock) ConnBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConn.beforeConnCounter)
+}
+
+// Calls returns a list of arguments used in each call to SqlDBMock.Conn.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmConn *mSqlDBMockConn) Calls() []*SqlDBMockConnParams {
+	mmConn.mutex.RLock()
+
+	argCopy := make([]*SqlDBMockConnParams, len(mmConn.callArgs))
+	copy(argCopy, mmConn.callArgs)
+
+	mmConn.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockConnDone returns true if the count of the Conn invocations corresponds
+// the number of defined expectations
+func (m *SqlDBMock) MinimockConnDone() bool {
+	for _, e := range m.ConnMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ConnMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterConnCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcConn != nil && mm_atomic.LoadUint64(&m.afterConnCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockConnInspect logs each unmet expectation
+func (m *SqlDBMock) MinimockConnInspect() {
+	for _, e := range m.ConnMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SqlDBMock.Conn with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ConnMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterConnCounter) < 1 {
+		if m.ConnMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to SqlDBMock.Conn")
+		} else {
+			m.t.Errorf("Expected call to SqlDBMock.Conn with params: %#v", *m.ConnMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcConn != nil && mm_atomic.LoadUint64(&m.afterConnCounter) < 1 {
+		m.t.Error("Expected call to SqlDBMock.Conn")
+	}
+}
+
 type mSqlDBMockExec struct {
 	mock               *SqlDBMock
 	defaultExpectation *SqlDBMockExecExpectation
@@ -648,6 +927,221 @@ func (m *SqlDBMock) MinimockExecInspect() {
 	}
 }
 
+type mSqlDBMockPing struct {
+	mock               *SqlDBMock
+	defaultExpectation *SqlDBMockPingExpectation
+	expectations       []*SqlDBMockPingExpectation
+
+	callArgs []*SqlDBMockPingParams
+	mutex    sync.RWMutex
+}
+
+// SqlDBMockPingExpectation specifies expectation struct of the sqlDB.Ping
+type SqlDBMockPingExpectation struct {
+	mock    *SqlDBMock
+	params  *SqlDBMockPingParams
+	results *SqlDBMockPingResults
+	Counter uint64
+}
+
+// SqlDBMockPingParams contains parameters of the sqlDB.Ping
+type SqlDBMockPingParams struct {
+	ctx context.Context
+}
+
+// SqlDBMockPingResults contains results of the sqlDB.Ping
+type SqlDBMockPingResults struct {
+	err error
+}
+
+// Expect sets up expected params for sqlDB.Ping
+func (mmPing *mSqlDBMockPing) Expect(ctx context.Context) *mSqlDBMockPing {
+	if mmPing.mock.funcPing != nil {
+		mmPing.mock.t.Fatalf("SqlDBMock.Ping mock is already set by Set")
+	}
+
+	if mmPing.defaultExpectation == nil {
+		mmPing.defaultExpectation = &SqlDBMockPingExpectation{}
+	}
+
+	mmPing.defaultExpectation.params = &SqlDBMockPingParams{ctx}
+	for _, e := range mmPing.expectations {
+		if minimock.Equal(e.params, mmPing.defaultExpectation.params) {
+			mmPing.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPing.defaultExpectation.params)
+		}
+	}
+
+	return mmPing
+}
+
+// Inspect accepts an inspector function that has same arguments as the sqlDB.Ping
+func (mmPing *mSqlDBMockPing) Inspect(f func(ctx context.Context)) *mSqlDBMockPing {
+	if mmPing.mock.inspectFuncPing != nil {
+		mmPing.mock.t.Fatalf("Inspect function is already set for SqlDBMock.Ping")
+	}
+
+	mmPing.mock.inspectFuncPing = f
+
+	return mmPing
+}
+
+// Return sets up results that will be returned by sqlDB.Ping
+func (mmPing *mSqlDBMockPing) Return(err error) *SqlDBMock {
+	if mmPing.mock.funcPing != nil {
+		mmPing.mock.t.Fatalf("SqlDBMock.Ping mock is already set by Set")
+	}
+
+	if mmPing.defaultExpectation == nil {
+		mmPing.defaultExpectation = &SqlDBMockPingExpectation{mock: mmPing.mock}
+	}
+	mmPing.defaultExpectation.results = &SqlDBMockPingResults{err}
+	return mmPing.mock
+}
+
+//Set uses given function f to mock the sqlDB.Ping method
+func (mmPing *mSqlDBMockPing) Set(f func(ctx context.Context) (err error)) *SqlDBMock {
+	if mmPing.defaultExpectation != nil {
+		mmPing.mock.t.Fatalf("Default expectation is already set for the sqlDB.Ping method")
+	}
+
+	if len(mmPing.expectations) > 0 {
+		mmPing.mock.t.Fatalf("Some expectations are already set for the sqlDB.Ping method")
+	}
+
+	mmPing.mock.funcPing = f
+	return mmPing.mock
+}
+
+// When sets expectation for the sqlDB.Ping which will trigger the result defined by the following
+// Then helper
+func (mmPing *mSqlDBMockPing) When(ctx context.Context) *SqlDBMockPingExpectation {
+	if mmPing.mock.funcPing != nil {
+		mmPing.mock.t.Fatalf("SqlDBMock.Ping mock is already set by Set")
+	}
+
+	expectation := &SqlDBMockPingExpectation{
+		mock:   mmPing.mock,
+		params: &SqlDBMockPingParams{ctx},
+	}
+	mmPing.expectations = append(mmPing.expectations, expectation)
+	return expectation
+}
+
+// Then sets up sqlDB.Ping return parameters for the expectation previously defined by the When method
+func (e *SqlDBMockPingExpectation) Then(err error) *SqlDBMock {
+	e.results = &SqlDBMockPingResults{err}
+	return e.mock
+}
+
+// Ping implements sqlDB
+func (mmPing *SqlDBMock) Ping(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmPing.beforePingCounter, 1)
+	defer mm_atomic.AddUint64(&mmPing.afterPingCounter, 1)
+
+	if mmPing.inspectFuncPing != nil {
+		mmPing.inspectFuncPing(ctx)
+	}
+
+	mm_params := &SqlDBMockPingParams{ctx}
+
+	// Record call args
+	mmPing.PingMock.mutex.Lock()
+	mmPing.PingMock.callArgs = append(mmPing.PingMock.callArgs, mm_params)
+	mmPing.PingMock.mutex.Unlock()
+
+	for _, e := range mmPing.PingMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPing.PingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPing.PingMock.defaultExpectation.Counter, 1)
+		mm_want := mmPing.PingMock.defaultExpectation.params
+		mm_got := SqlDBMockPingParams{ctx}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPing.t.Errorf("SqlDBMock.Ping got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPing.PingMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPing.t.Fatal("No results are set for the SqlDBMock.Ping")
+		}
+		return (*mm_results).err
+	}
+	if mmPing.funcPing != nil {
+		return mmPing.funcPing(ctx)
+	}
+	mmPing.t.Fatalf("Unexpected call to SqlDBMock.Ping. %v", ctx)
+	return
+}
+
+// PingAfterCounter returns a count of finished SqlDBMock.Ping invocations
+func (mmPing *SqlDBMock) PingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPing.afterPingCounter)
+}
+
+// PingBeforeCounter returns a count of SqlDBMock.Ping invocations
+func (mmPing *SqlDBMock) PingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPing.beforePingCounter)
+}
+
+// Calls returns a list of arguments used in each call to SqlDBMock.Ping.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPing *mSqlDBMockPing) Calls() []*SqlDBMockPingParams {
+	mmPing.mutex.RLock()
+
+	argCopy := make([]*SqlDBMockPingParams, len(mmPing.callArgs))
+	copy(argCopy, mmPing.callArgs)
+
+	mmPing.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPingDone returns true if the count of the Ping invocations corresponds
+// the number of defined expectations
+func (m *SqlDBMock) MinimockPingDone() bool {
+	for _, e := range m.PingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PingMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPingCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPing != nil && mm_atomic.LoadUint64(&m.afterPingCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockPingInspect logs each unmet expectation
+func (m *SqlDBMock) MinimockPingInspect() {
+	for _, e := range m.PingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SqlDBMock.Ping with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PingMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterPingCounter) < 1 {
+		if m.PingMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to SqlDBMock.Ping")
+		} else {
+			m.t.Errorf("Expected call to SqlDBMock.Ping with params: %#v", *m.PingMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPing != nil && mm_atomic.LoadUint64(&m.afterPingCounter) < 1 {
+		m.t.Error("Expected call to SqlDBMock.Ping")
+	}
+}
+
 type mSqlDBMockPrepare struct {
 	mock               *SqlDBMock
 	defaultExpectation *SqlDBMockPrepareExpectation
@@ -1083,18 +1577,923 @@ func (m *SqlDBMock) MinimockQueryInspect() {
 	}
 }
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *SqlDBMock) MinimockFinish() {
-	if !m.minimockDone() {
-		m.MinimockBeginInspect()
+type mSqlDBMockSetConnMaxIdleTime struct {
+	mock               *SqlDBMock
+	defaultExpectation *SqlDBMockSetConnMaxIdleTimeExpectation
+	expectations       []*SqlDBMockSetConnMaxIdleTimeExpectation
 
-		m.MinimockCloseInspect()
+	callArgs []*SqlDBMockSetConnMaxIdleTimeParams
+	mutex    sync.RWMutex
+}
 
-		m.MinimockExecInspect()
+// SqlDBMockSetConnMaxIdleTimeExpectation specifies expectation struct of the sqlDB.SetConnMaxIdleTime
+type SqlDBMockSetConnMaxIdleTimeExpectation struct {
+	mock   *SqlDBMock
+	params *SqlDBMockSetConnMaxIdleTimeParams
 
-		m.MinimockPrepareInspect()
+	Counter uint64
+}
 
-		m.MinimockQueryInspect()
+// SqlDBMockSetConnMaxIdleTimeParams contains parameters of the sqlDB.SetConnMaxIdleTime
+type SqlDBMockSetConnMaxIdleTimeParams struct {
+	d time.Duration
+}
+
+// Expect sets up expected params for sqlDB.SetConnMaxIdleTime
+func (mmSetConnMaxIdleTime *mSqlDBMockSetConnMaxIdleTime) Expect(d time.Duration) *mSqlDBMockSetConnMaxIdleTime {
+	if mmSetConnMaxIdleTime.mock.funcSetConnMaxIdleTime != nil {
+		mmSetConnMaxIdleTime.mock.t.Fatalf("SqlDBMock.SetConnMaxIdleTime mock is already set by Set")
+	}
+
+	if mmSetConnMaxIdleTime.defaultExpectation == nil {
+		mmSetConnMaxIdleTime.defaultExpectation = &SqlDBMockSetConnMaxIdleTimeExpectation{}
+	}
+
+	mmSetConnMaxIdleTime.defaultExpectation.params = &SqlDBMockSetConnMaxIdleTimeParams{d}
+	for _, e := range mmSetConnMaxIdleTime.expectations {
+		if minimock.Equal(e.params, mmSetConnMaxIdleTime.defaultExpectation.params) {
+			mmSetConnMaxIdleTime.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetConnMaxIdleTime.defaultExpectation.params)
+		}
+	}
+
+	return mmSetConnMaxIdleTime
+}
+
+// Inspect accepts an inspector function that has same arguments as the sqlDB.SetConnMaxIdleTime
+func (mmSetConnMaxIdleTime *mSqlDBMockSetConnMaxIdleTime) Inspect(f func(d time.Duration)) *mSqlDBMockSetConnMaxIdleTime {
+	if mmSetConnMaxIdleTime.mock.inspectFuncSetConnMaxIdleTime != nil {
+		mmSetConnMaxIdleTime.mock.t.Fatalf("Inspect function is already set for SqlDBMock.SetConnMaxIdleTime")
+	}
+
+	mmSetConnMaxIdleTime.mock.inspectFuncSetConnMaxIdleTime = f
+
+	return mmSetConnMaxIdleTime
+}
+
+// Return sets up results that will be returned by sqlDB.SetConnMaxIdleTime
+func (mmSetConnMaxIdleTime *mSqlDBMockSetConnMaxIdleTime) Return() *SqlDBMock {
+	if mmSetConnMaxIdleTime.mock.funcSetConnMaxIdleTime != nil {
+		mmSetConnMaxIdleTime.mock.t.Fatalf("SqlDBMock.SetConnMaxIdleTime mock is already set by Set")
+	}
+
+	if mmSetConnMaxIdleTime.defaultExpectation == nil {
+		mmSetConnMaxIdleTime.defaultExpectation = &SqlDBMockSetConnMaxIdleTimeExpectation{mock: mmSetConnMaxIdleTime.mock}
+	}
+
+	return mmSetConnMaxIdleTime.mock
+}
+
+//Set uses given function f to mock the sqlDB.SetConnMaxIdleTime method
+func (mmSetConnMaxIdleTime *mSqlDBMockSetConnMaxIdleTime) Set(f func(d time.Duration)) *SqlDBMock {
+	if mmSetConnMaxIdleTime.defaultExpectation != nil {
+		mmSetConnMaxIdleTime.mock.t.Fatalf("Default expectation is already set for the sqlDB.SetConnMaxIdleTime method")
+	}
+
+	if len(mmSetConnMaxIdleTime.expectations) > 0 {
+		mmSetConnMaxIdleTime.mock.t.Fatalf("Some expectations are already set for the sqlDB.SetConnMaxIdleTime method")
+	}
+
+	mmSetConnMaxIdleTime.mock.funcSetConnMaxIdleTime = f
+	return mmSetConnMaxIdleTime.mock
+}
+
+// SetConnMaxIdleTime implements sqlDB
+func (mmSetConnMaxIdleTime *SqlDBMock) SetConnMaxIdleTime(d time.Duration) {
+	mm_atomic.AddUint64(&mmSetConnMaxIdleTime.beforeSetConnMaxIdleTimeCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetConnMaxIdleTime.afterSetConnMaxIdleTimeCounter, 1)
+
+	if mmSetConnMaxIdleTime.inspectFuncSetConnMaxIdleTime != nil {
+		mmSetConnMaxIdleTime.inspectFuncSetConnMaxIdleTime(d)
+	}
+
+	mm_params := &SqlDBMockSetConnMaxIdleTimeParams{d}
+
+	// Record call args
+	mmSetConnMaxIdleTime.SetConnMaxIdleTimeMock.mutex.Lock()
+	mmSetConnMaxIdleTime.SetConnMaxIdleTimeMock.callArgs = append(mmSetConnMaxIdleTime.SetConnMaxIdleTimeMock.callArgs, mm_params)
+	mmSetConnMaxIdleTime.SetConnMaxIdleTimeMock.mutex.Unlock()
+
+	for _, e := range mmSetConnMaxIdleTime.SetConnMaxIdleTimeMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmSetConnMaxIdleTime.SetConnMaxIdleTimeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetConnMaxIdleTime.SetConnMaxIdleTimeMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetConnMaxIdleTime.SetConnMaxIdleTimeMock.defaultExpectation.params
+		mm_got := SqlDBMockSetConnMaxIdleTimeParams{d}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetConnMaxIdleTime.t.Errorf("SqlDBMock.SetConnMaxIdleTime got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmSetConnMaxIdleTime.funcSetConnMaxIdleTime != nil {
+		mmSetConnMaxIdleTime.funcSetConnMaxIdleTime(d)
+		return
+	}
+	mmSetConnMaxIdleTime.t.Fatalf("Unexpected call to SqlDBMock.SetConnMaxIdleTime. %v", d)
+
+}
+
+// SetConnMaxIdleTimeAfterCounter returns a count of finished SqlDBMock.SetConnMaxIdleTime invocations
+func (mmSetConnMaxIdleTime *SqlDBMock) SetConnMaxIdleTimeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetConnMaxIdleTime.afterSetConnMaxIdleTimeCounter)
+}
+
+// SetConnMaxIdleTimeBeforeCounter returns a count of SqlDBMock.SetConnMaxIdleTime invocations
+func (mmSetConnMaxIdleTime *SqlDBMock) SetConnMaxIdleTimeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetConnMaxIdleTime.beforeSetConnMaxIdleTimeCounter)
+}
+
+// Calls returns a list of arguments used in each call to SqlDBMock.SetConnMaxIdleTime.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetConnMaxIdleTime *mSqlDBMockSetConnMaxIdleTime) Calls() []*SqlDBMockSetConnMaxIdleTimeParams {
+	mmSetConnMaxIdleTime.mutex.RLock()
+
+	argCopy := make([]*SqlDBMockSetConnMaxIdleTimeParams, len(mmSetConnMaxIdleTime.callArgs))
+	copy(argCopy, mmSetConnMaxIdleTime.callArgs)
+
+	mmSetConnMaxIdleTime.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetConnMaxIdleTimeDone returns true if the count of the SetConnMaxIdleTime invocations corresponds
+// the number of defined expectations
+func (m *SqlDBMock) MinimockSetConnMaxIdleTimeDone() bool {
+	for _, e := range m.SetConnMaxIdleTimeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetConnMaxIdleTimeMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetConnMaxIdleTimeCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetConnMaxIdleTime != nil && mm_atomic.LoadUint64(&m.afterSetConnMaxIdleTimeCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSetConnMaxIdleTimeInspect logs each unmet expectation
+func (m *SqlDBMock) MinimockSetConnMaxIdleTimeInspect() {
+	for _, e := range m.SetConnMaxIdleTimeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SqlDBMock.SetConnMaxIdleTime with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetConnMaxIdleTimeMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetConnMaxIdleTimeCounter) < 1 {
+		if m.SetConnMaxIdleTimeMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to SqlDBMock.SetConnMaxIdleTime")
+		} else {
+			m.t.Errorf("Expected call to SqlDBMock.SetConnMaxIdleTime with params: %#v", *m.SetConnMaxIdleTimeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetConnMaxIdleTime != nil && mm_atomic.LoadUint64(&m.afterSetConnMaxIdleTimeCounter) < 1 {
+		m.t.Error("Expected call to SqlDBMock.SetConnMaxIdleTime")
+	}
+}
+
+type mSqlDBMockSetConnMaxLifetime struct {
+	mock               *SqlDBMock
+	defaultExpectation *SqlDBMockSetConnMaxLifetimeExpectation
+	expectations       []*SqlDBMockSetConnMaxLifetimeExpectation
+
+	callArgs []*SqlDBMockSetConnMaxLifetimeParams
+	mutex    sync.RWMutex
+}
+
+// SqlDBMockSetConnMaxLifetimeExpectation specifies expectation struct of the sqlDB.SetConnMaxLifetime
+type SqlDBMockSetConnMaxLifetimeExpectation struct {
+	mock   *SqlDBMock
+	params *SqlDBMockSetConnMaxLifetimeParams
+
+	Counter uint64
+}
+
+// SqlDBMockSetConnMaxLifetimeParams contains parameters of the sqlDB.SetConnMaxLifetime
+type SqlDBMockSetConnMaxLifetimeParams struct {
+	d time.Duration
+}
+
+// Expect sets up expected params for sqlDB.SetConnMaxLifetime
+func (mmSetConnMaxLifetime *mSqlDBMockSetConnMaxLifetime) Expect(d time.Duration) *mSqlDBMockSetConnMaxLifetime {
+	if mmSetConnMaxLifetime.mock.funcSetConnMaxLifetime != nil {
+		mmSetConnMaxLifetime.mock.t.Fatalf("SqlDBMock.SetConnMaxLifetime mock is already set by Set")
+	}
+
+	if mmSetConnMaxLifetime.defaultExpectation == nil {
+		mmSetConnMaxLifetime.defaultExpectation = &SqlDBMockSetConnMaxLifetimeExpectation{}
+	}
+
+	mmSetConnMaxLifetime.defaultExpectation.params = &SqlDBMockSetConnMaxLifetimeParams{d}
+	for _, e := range mmSetConnMaxLifetime.expectations {
+		if minimock.Equal(e.params, mmSetConnMaxLifetime.defaultExpectation.params) {
+			mmSetConnMaxLifetime.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetConnMaxLifetime.defaultExpectation.params)
+		}
+	}
+
+	return mmSetConnMaxLifetime
+}
+
+// Inspect accepts an inspector function that has same arguments as the sqlDB.SetConnMaxLifetime
+func (mmSetConnMaxLifetime *mSqlDBMockSetConnMaxLifetime) Inspect(f func(d time.Duration)) *mSqlDBMockSetConnMaxLifetime {
+	if mmSetConnMaxLifetime.mock.inspectFuncSetConnMaxLifetime != nil {
+		mmSetConnMaxLifetime.mock.t.Fatalf("Inspect function is already set for SqlDBMock.SetConnMaxLifetime")
+	}
+
+	mmSetConnMaxLifetime.mock.inspectFuncSetConnMaxLifetime = f
+
+	return mmSetConnMaxLifetime
+}
+
+// Return sets up results that will be returned by sqlDB.SetConnMaxLifetime
+func (mmSetConnMaxLifetime *mSqlDBMockSetConnMaxLifetime) Return() *SqlDBMock {
+	if mmSetConnMaxLifetime.mock.funcSetConnMaxLifetime != nil {
+		mmSetConnMaxLifetime.mock.t.Fatalf("SqlDBMock.SetConnMaxLifetime mock is already set by Set")
+	}
+
+	if mmSetConnMaxLifetime.defaultExpectation == nil {
+		mmSetConnMaxLifetime.defaultExpectation = &SqlDBMockSetConnMaxLifetimeExpectation{mock: mmSetConnMaxLifetime.mock}
+	}
+
+	return mmSetConnMaxLifetime.mock
+}
+
+//Set uses given function f to mock the sqlDB.SetConnMaxLifetime method
+func (mmSetConnMaxLifetime *mSqlDBMockSetConnMaxLifetime) Set(f func(d time.Duration)) *SqlDBMock {
+	if mmSetConnMaxLifetime.defaultExpectation != nil {
+		mmSetConnMaxLifetime.mock.t.Fatalf("Default expectation is already set for the sqlDB.SetConnMaxLifetime method")
+	}
+
+	if len(mmSetConnMaxLifetime.expectations) > 0 {
+		mmSetConnMaxLifetime.mock.t.Fatalf("Some expectations are already set for the sqlDB.SetConnMaxLifetime method")
+	}
+
+	mmSetConnMaxLifetime.mock.funcSetConnMaxLifetime = f
+	return mmSetConnMaxLifetime.mock
+}
+
+// SetConnMaxLifetime implements sqlDB
+func (mmSetConnMaxLifetime *SqlDBMock) SetConnMaxLifetime(d time.Duration) {
+	mm_atomic.AddUint64(&mmSetConnMaxLifetime.beforeSetConnMaxLifetimeCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetConnMaxLifetime.afterSetConnMaxLifetimeCounter, 1)
+
+	if mmSetConnMaxLifetime.inspectFuncSetConnMaxLifetime != nil {
+		mmSetConnMaxLifetime.inspectFuncSetConnMaxLifetime(d)
+	}
+
+	mm_params := &SqlDBMockSetConnMaxLifetimeParams{d}
+
+	// Record call args
+	mmSetConnMaxLifetime.SetConnMaxLifetimeMock.mutex.Lock()
+	mmSetConnMaxLifetime.SetConnMaxLifetimeMock.callArgs = append(mmSetConnMaxLifetime.SetConnMaxLifetimeMock.callArgs, mm_params)
+	mmSetConnMaxLifetime.SetConnMaxLifetimeMock.mutex.Unlock()
+
+	for _, e := range mmSetConnMaxLifetime.SetConnMaxLifetimeMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmSetConnMaxLifetime.SetConnMaxLifetimeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetConnMaxLifetime.SetConnMaxLifetimeMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetConnMaxLifetime.SetConnMaxLifetimeMock.defaultExpectation.params
+		mm_got := SqlDBMockSetConnMaxLifetimeParams{d}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetConnMaxLifetime.t.Errorf("SqlDBMock.SetConnMaxLifetime got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmSetConnMaxLifetime.funcSetConnMaxLifetime != nil {
+		mmSetConnMaxLifetime.funcSetConnMaxLifetime(d)
+		return
+	}
+	mmSetConnMaxLifetime.t.Fatalf("Unexpected call to SqlDBMock.SetConnMaxLifetime. %v", d)
+
+}
+
+// SetConnMaxLifetimeAfterCounter returns a count of finished SqlDBMock.SetConnMaxLifetime invocations
+func (mmSetConnMaxLifetime *SqlDBMock) SetConnMaxLifetimeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetConnMaxLifetime.afterSetConnMaxLifetimeCounter)
+}
+
+// SetConnMaxLifetimeBeforeCounter returns a count of SqlDBMock.SetConnMaxLifetime invocations
+func (mmSetConnMaxLifetime *SqlDBMock) SetConnMaxLifetimeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetConnMaxLifetime.beforeSetConnMaxLifetimeCounter)
+}
+
+// Calls returns a list of arguments used in each call to SqlDBMock.SetConnMaxLifetime.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetConnMaxLifetime *mSqlDBMockSetConnMaxLifetime) Calls() []*SqlDBMockSetConnMaxLifetimeParams {
+	mmSetConnMaxLifetime.mutex.RLock()
+
+	argCopy := make([]*SqlDBMockSetConnMaxLifetimeParams, len(mmSetConnMaxLifetime.callArgs))
+	copy(argCopy, mmSetConnMaxLifetime.callArgs)
+
+	mmSetConnMaxLifetime.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetConnMaxLifetimeDone returns true if the count of the SetConnMaxLifetime invocations corresponds
+// the number of defined expectations
+func (m *SqlDBMock) MinimockSetConnMaxLifetimeDone() bool {
+	for _, e := range m.SetConnMaxLifetimeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetConnMaxLifetimeMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetConnMaxLifetimeCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetConnMaxLifetime != nil && mm_atomic.LoadUint64(&m.afterSetConnMaxLifetimeCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSetConnMaxLifetimeInspect logs each unmet expectation
+func (m *SqlDBMock) MinimockSetConnMaxLifetimeInspect() {
+	for _, e := range m.SetConnMaxLifetimeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SqlDBMock.SetConnMaxLifetime with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetConnMaxLifetimeMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetConnMaxLifetimeCounter) < 1 {
+		if m.SetConnMaxLifetimeMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to SqlDBMock.SetConnMaxLifetime")
+		} else {
+			m.t.Errorf("Expected call to SqlDBMock.SetConnMaxLifetime with params: %#v", *m.SetConnMaxLifetimeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetConnMaxLifetime != nil && mm_atomic.LoadUint64(&m.afterSetConnMaxLifetimeCounter) < 1 {
+		m.t.Error("Expected call to SqlDBMock.SetConnMaxLifetime")
+	}
+}
+
+type mSqlDBMockSetMaxIdleConns struct {
+	mock               *SqlDBMock
+	defaultExpectation *SqlDBMockSetMaxIdleConnsExpectation
+	expectations       []*SqlDBMockSetMaxIdleConnsExpectation
+
+	callArgs []*SqlDBMockSetMaxIdleConnsParams
+	mutex    sync.RWMutex
+}
+
+// SqlDBMockSetMaxIdleConnsExpectation specifies expectation struct of the sqlDB.SetMaxIdleConns
+type SqlDBMockSetMaxIdleConnsExpectation struct {
+	mock   *SqlDBMock
+	params *SqlDBMockSetMaxIdleConnsParams
+
+	Counter uint64
+}
+
+// SqlDBMockSetMaxIdleConnsParams contains parameters of the sqlDB.SetMaxIdleConns
+type SqlDBMockSetMaxIdleConnsParams struct {
+	n int
+}
+
+// Expect sets up expected params for sqlDB.SetMaxIdleConns
+func (mmSetMaxIdleConns *mSqlDBMockSetMaxIdleConns) Expect(n int) *mSqlDBMockSetMaxIdleConns {
+	if mmSetMaxIdleConns.mock.funcSetMaxIdleConns != nil {
+		mmSetMaxIdleConns.mock.t.Fatalf("SqlDBMock.SetMaxIdleConns mock is already set by Set")
+	}
+
+	if mmSetMaxIdleConns.defaultExpectation == nil {
+		mmSetMaxIdleConns.defaultExpectation = &SqlDBMockSetMaxIdleConnsExpectation{}
+	}
+
+	mmSetMaxIdleConns.defaultExpectation.params = &SqlDBMockSetMaxIdleConnsParams{n}
+	for _, e := range mmSetMaxIdleConns.expectations {
+		if minimock.Equal(e.params, mmSetMaxIdleConns.defaultExpectation.params) {
+			mmSetMaxIdleConns.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetMaxIdleConns.defaultExpectation.params)
+		}
+	}
+
+	return mmSetMaxIdleConns
+}
+
+// Inspect accepts an inspector function that has same arguments as the sqlDB.SetMaxIdleConns
+func (mmSetMaxIdleConns *mSqlDBMockSetMaxIdleConns) Inspect(f func(n int)) *mSqlDBMockSetMaxIdleConns {
+	if mmSetMaxIdleConns.mock.inspectFuncSetMaxIdleConns != nil {
+		mmSetMaxIdleConns.mock.t.Fatalf("Inspect function is already set for SqlDBMock.SetMaxIdleConns")
+	}
+
+	mmSetMaxIdleConns.mock.inspectFuncSetMaxIdleConns = f
+
+	return mmSetMaxIdleConns
+}
+
+// Return sets up results that will be returned by sqlDB.SetMaxIdleConns
+func (mmSetMaxIdleConns *mSqlDBMockSetMaxIdleConns) Return() *SqlDBMock {
+	if mmSetMaxIdleConns.mock.funcSetMaxIdleConns != nil {
+		mmSetMaxIdleConns.mock.t.Fatalf("SqlDBMock.SetMaxIdleConns mock is already set by Set")
+	}
+
+	if mmSetMaxIdleConns.defaultExpectation == nil {
+		mmSetMaxIdleConns.defaultExpectation = &SqlDBMockSetMaxIdleConnsExpectation{mock: mmSetMaxIdleConns.mock}
+	}
+
+	return mmSetMaxIdleConns.mock
+}
+
+//Set uses given function f to mock the sqlDB.SetMaxIdleConns method
+func (mmSetMaxIdleConns *mSqlDBMockSetMaxIdleConns) Set(f func(n int)) *SqlDBMock {
+	if mmSetMaxIdleConns.defaultExpectation != nil {
+		mmSetMaxIdleConns.mock.t.Fatalf("Default expectation is already set for the sqlDB.SetMaxIdleConns method")
+	}
+
+	if len(mmSetMaxIdleConns.expectations) > 0 {
+		mmSetMaxIdleConns.mock.t.Fatalf("Some expectations are already set for the sqlDB.SetMaxIdleConns method")
+	}
+
+	mmSetMaxIdleConns.mock.funcSetMaxIdleConns = f
+	return mmSetMaxIdleConns.mock
+}
+
+// SetMaxIdleConns implements sqlDB
+func (mmSetMaxIdleConns *SqlDBMock) SetMaxIdleConns(n int) {
+	mm_atomic.AddUint64(&mmSetMaxIdleConns.beforeSetMaxIdleConnsCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetMaxIdleConns.afterSetMaxIdleConnsCounter, 1)
+
+	if mmSetMaxIdleConns.inspectFuncSetMaxIdleConns != nil {
+		mmSetMaxIdleConns.inspectFuncSetMaxIdleConns(n)
+	}
+
+	mm_params := &SqlDBMockSetMaxIdleConnsParams{n}
+
+	// Record call args
+	mmSetMaxIdleConns.SetMaxIdleConnsMock.mutex.Lock()
+	mmSetMaxIdleConns.SetMaxIdleConnsMock.callArgs = append(mmSetMaxIdleConns.SetMaxIdleConnsMock.callArgs, mm_params)
+	mmSetMaxIdleConns.SetMaxIdleConnsMock.mutex.Unlock()
+
+	for _, e := range mmSetMaxIdleConns.SetMaxIdleConnsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmSetMaxIdleConns.SetMaxIdleConnsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetMaxIdleConns.SetMaxIdleConnsMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetMaxIdleConns.SetMaxIdleConnsMock.defaultExpectation.params
+		mm_got := SqlDBMockSetMaxIdleConnsParams{n}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetMaxIdleConns.t.Errorf("SqlDBMock.SetMaxIdleConns got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmSetMaxIdleConns.funcSetMaxIdleConns != nil {
+		mmSetMaxIdleConns.funcSetMaxIdleConns(n)
+		return
+	}
+	mmSetMaxIdleConns.t.Fatalf("Unexpected call to SqlDBMock.SetMaxIdleConns. %v", n)
+
+}
+
+// SetMaxIdleConnsAfterCounter returns a count of finished SqlDBMock.SetMaxIdleConns invocations
+func (mmSetMaxIdleConns *SqlDBMock) SetMaxIdleConnsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetMaxIdleConns.afterSetMaxIdleConnsCounter)
+}
+
+// SetMaxIdleConnsBeforeCounter returns a count of SqlDBMock.SetMaxIdleConns invocations
+func (mmSetMaxIdleConns *SqlDBMock) SetMaxIdleConnsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetMaxIdleConns.beforeSetMaxIdleConnsCounter)
+}
+
+// Calls returns a list of arguments used in each call to SqlDBMock.SetMaxIdleConns.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetMaxIdleConns *mSqlDBMockSetMaxIdleConns) Calls() []*SqlDBMockSetMaxIdleConnsParams {
+	mmSetMaxIdleConns.mutex.RLock()
+
+	argCopy := make([]*SqlDBMockSetMaxIdleConnsParams, len(mmSetMaxIdleConns.callArgs))
+	copy(argCopy, mmSetMaxIdleConns.callArgs)
+
+	mmSetMaxIdleConns.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetMaxIdleConnsDone returns true if the count of the SetMaxIdleConns invocations corresponds
+// the number of defined expectations
+func (m *SqlDBMock) MinimockSetMaxIdleConnsDone() bool {
+	for _, e := range m.SetMaxIdleConnsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetMaxIdleConnsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetMaxIdleConnsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetMaxIdleConns != nil && mm_atomic.LoadUint64(&m.afterSetMaxIdleConnsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSetMaxIdleConnsInspect logs each unmet expectation
+func (m *SqlDBMock) MinimockSetMaxIdleConnsInspect() {
+	for _, e := range m.SetMaxIdleConnsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SqlDBMock.SetMaxIdleConns with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetMaxIdleConnsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetMaxIdleConnsCounter) < 1 {
+		if m.SetMaxIdleConnsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to SqlDBMock.SetMaxIdleConns")
+		} else {
+			m.t.Errorf("Expected call to SqlDBMock.SetMaxIdleConns with params: %#v", *m.SetMaxIdleConnsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetMaxIdleConns != nil && mm_atomic.LoadUint64(&m.afterSetMaxIdleConnsCounter) < 1 {
+		m.t.Error("Expected call to SqlDBMock.SetMaxIdleConns")
+	}
+}
+
+type mSqlDBMockSetMaxOpenConns struct {
+	mock               *SqlDBMock
+	defaultExpectation *SqlDBMockSetMaxOpenConnsExpectation
+	expectations       []*SqlDBMockSetMaxOpenConnsExpectation
+
+	callArgs []*SqlDBMockSetMaxOpenConnsParams
+	mutex    sync.RWMutex
+}
+
+// SqlDBMockSetMaxOpenConnsExpectation specifies expectation struct of the sqlDB.SetMaxOpenConns
+type SqlDBMockSetMaxOpenConnsExpectation struct {
+	mock   *SqlDBMock
+	params *SqlDBMockSetMaxOpenConnsParams
+
+	Counter uint64
+}
+
+// SqlDBMockSetMaxOpenConnsParams contains parameters of the sqlDB.SetMaxOpenConns
+type SqlDBMockSetMaxOpenConnsParams struct {
+	n int
+}
+
+// Expect sets up expected params for sqlDB.SetMaxOpenConns
+func (mmSetMaxOpenConns *mSqlDBMockSetMaxOpenConns) Expect(n int) *mSqlDBMockSetMaxOpenConns {
+	if mmSetMaxOpenConns.mock.funcSetMaxOpenConns != nil {
+		mmSetMaxOpenConns.mock.t.Fatalf("SqlDBMock.SetMaxOpenConns mock is already set by Set")
+	}
+
+	if mmSetMaxOpenConns.defaultExpectation == nil {
+		mmSetMaxOpenConns.defaultExpectation = &SqlDBMockSetMaxOpenConnsExpectation{}
+	}
+
+	mmSetMaxOpenConns.defaultExpectation.params = &SqlDBMockSetMaxOpenConnsParams{n}
+	for _, e := range mmSetMaxOpenConns.expectations {
+		if minimock.Equal(e.params, mmSetMaxOpenConns.defaultExpectation.params) {
+			mmSetMaxOpenConns.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetMaxOpenConns.defaultExpectation.params)
+		}
+	}
+
+	return mmSetMaxOpenConns
+}
+
+// Inspect accepts an inspector function that has same arguments as the sqlDB.SetMaxOpenConns
+func (mmSetMaxOpenConns *mSqlDBMockSetMaxOpenConns) Inspect(f func(n int)) *mSqlDBMockSetMaxOpenConns {
+	if mmSetMaxOpenConns.mock.inspectFuncSetMaxOpenConns != nil {
+		mmSetMaxOpenConns.mock.t.Fatalf("Inspect function is already set for SqlDBMock.SetMaxOpenConns")
+	}
+
+	mmSetMaxOpenConns.mock.inspectFuncSetMaxOpenConns = f
+
+	return mmSetMaxOpenConns
+}
+
+// Return sets up results that will be returned by sqlDB.SetMaxOpenConns
+func (mmSetMaxOpenConns *mSqlDBMockSetMaxOpenConns) Return() *SqlDBMock {
+	if mmSetMaxOpenConns.mock.funcSetMaxOpenConns != nil {
+		mmSetMaxOpenConns.mock.t.Fatalf("SqlDBMock.SetMaxOpenConns mock is already set by Set")
+	}
+
+	if mmSetMaxOpenConns.defaultExpectation == nil {
+		mmSetMaxOpenConns.defaultExpectation = &SqlDBMockSetMaxOpenConnsExpectation{mock: mmSetMaxOpenConns.mock}
+	}
+
+	return mmSetMaxOpenConns.mock
+}
+
+//Set uses given function f to mock the sqlDB.SetMaxOpenConns method
+func (mmSetMaxOpenConns *mSqlDBMockSetMaxOpenConns) Set(f func(n int)) *SqlDBMock {
+	if mmSetMaxOpenConns.defaultExpectation != nil {
+		mmSetMaxOpenConns.mock.t.Fatalf("Default expectation is already set for the sqlDB.SetMaxOpenConns method")
+	}
+
+	if len(mmSetMaxOpenConns.expectations) > 0 {
+		mmSetMaxOpenConns.mock.t.Fatalf("Some expectations are already set for the sqlDB.SetMaxOpenConns method")
+	}
+
+	mmSetMaxOpenConns.mock.funcSetMaxOpenConns = f
+	return mmSetMaxOpenConns.mock
+}
+
+// SetMaxOpenConns implements sqlDB
+func (mmSetMaxOpenConns *SqlDBMock) SetMaxOpenConns(n int) {
+	mm_atomic.AddUint64(&mmSetMaxOpenConns.beforeSetMaxOpenConnsCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetMaxOpenConns.afterSetMaxOpenConnsCounter, 1)
+
+	if mmSetMaxOpenConns.inspectFuncSetMaxOpenConns != nil {
+		mmSetMaxOpenConns.inspectFuncSetMaxOpenConns(n)
+	}
+
+	mm_params := &SqlDBMockSetMaxOpenConnsParams{n}
+
+	// Record call args
+	mmSetMaxOpenConns.SetMaxOpenConnsMock.mutex.Lock()
+	mmSetMaxOpenConns.SetMaxOpenConnsMock.callArgs = append(mmSetMaxOpenConns.SetMaxOpenConnsMock.callArgs, mm_params)
+	mmSetMaxOpenConns.SetMaxOpenConnsMock.mutex.Unlock()
+
+	for _, e := range mmSetMaxOpenConns.SetMaxOpenConnsMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmSetMaxOpenConns.SetMaxOpenConnsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetMaxOpenConns.SetMaxOpenConnsMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetMaxOpenConns.SetMaxOpenConnsMock.defaultExpectation.params
+		mm_got := SqlDBMockSetMaxOpenConnsParams{n}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetMaxOpenConns.t.Errorf("SqlDBMock.SetMaxOpenConns got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmSetMaxOpenConns.funcSetMaxOpenConns != nil {
+		mmSetMaxOpenConns.funcSetMaxOpenConns(n)
+		return
+	}
+	mmSetMaxOpenConns.t.Fatalf("Unexpected call to SqlDBMock.SetMaxOpenConns. %v", n)
+
+}
+
+// SetMaxOpenConnsAfterCounter returns a count of finished SqlDBMock.SetMaxOpenConns invocations
+func (mmSetMaxOpenConns *SqlDBMock) SetMaxOpenConnsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetMaxOpenConns.afterSetMaxOpenConnsCounter)
+}
+
+// SetMaxOpenConnsBeforeCounter returns a count of SqlDBMock.SetMaxOpenConns invocations
+func (mmSetMaxOpenConns *SqlDBMock) SetMaxOpenConnsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetMaxOpenConns.beforeSetMaxOpenConnsCounter)
+}
+
+// Calls returns a list of arguments used in each call to SqlDBMock.SetMaxOpenConns.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetMaxOpenConns *mSqlDBMockSetMaxOpenConns) Calls() []*SqlDBMockSetMaxOpenConnsParams {
+	mmSetMaxOpenConns.mutex.RLock()
+
+	argCopy := make([]*SqlDBMockSetMaxOpenConnsParams, len(mmSetMaxOpenConns.callArgs))
+	copy(argCopy, mmSetMaxOpenConns.callArgs)
+
+	mmSetMaxOpenConns.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetMaxOpenConnsDone returns true if the count of the SetMaxOpenConns invocations corresponds
+// the number of defined expectations
+func (m *SqlDBMock) MinimockSetMaxOpenConnsDone() bool {
+	for _, e := range m.SetMaxOpenConnsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetMaxOpenConnsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetMaxOpenConnsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetMaxOpenConns != nil && mm_atomic.LoadUint64(&m.afterSetMaxOpenConnsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSetMaxOpenConnsInspect logs each unmet expectation
+func (m *SqlDBMock) MinimockSetMaxOpenConnsInspect() {
+	for _, e := range m.SetMaxOpenConnsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SqlDBMock.SetMaxOpenConns with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetMaxOpenConnsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSetMaxOpenConnsCounter) < 1 {
+		if m.SetMaxOpenConnsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to SqlDBMock.SetMaxOpenConns")
+		} else {
+			m.t.Errorf("Expected call to SqlDBMock.SetMaxOpenConns with params: %#v", *m.SetMaxOpenConnsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetMaxOpenConns != nil && mm_atomic.LoadUint64(&m.afterSetMaxOpenConnsCounter) < 1 {
+		m.t.Error("Expected call to SqlDBMock.SetMaxOpenConns")
+	}
+}
+
+type mSqlDBMockStats struct {
+	mock               *SqlDBMock
+	defaultExpectation *SqlDBMockStatsExpectation
+	expectations       []*SqlDBMockStatsExpectation
+}
+
+// SqlDBMockStatsExpectation specifies expectation struct of the sqlDB.Stats
+type SqlDBMockStatsExpectation struct {
+	mock *SqlDBMock
+
+	results *SqlDBMockStatsResults
+	Counter uint64
+}
+
+// SqlDBMockStatsResults contains results of the sqlDB.Stats
+type SqlDBMockStatsResults struct {
+	d1 sql.DBStats
+}
+
+// Expect sets up expected params for sqlDB.Stats
+func (mmStats *mSqlDBMockStats) Expect() *mSqlDBMockStats {
+	if mmStats.mock.funcStats != nil {
+		mmStats.mock.t.Fatalf("SqlDBMock.Stats mock is already set by Set")
+	}
+
+	if mmStats.defaultExpectation == nil {
+		mmStats.defaultExpectation = &SqlDBMockStatsExpectation{}
+	}
+
+	return mmStats
+}
+
+// Inspect accepts an inspector function that has same arguments as the sqlDB.Stats
+func (mmStats *mSqlDBMockStats) Inspect(f func()) *mSqlDBMockStats {
+	if mmStats.mock.inspectFuncStats != nil {
+		mmStats.mock.t.Fatalf("Inspect function is already set for SqlDBMock.Stats")
+	}
+
+	mmStats.mock.inspectFuncStats = f
+
+	return mmStats
+}
+
+// Return sets up results that will be returned by sqlDB.Stats
+func (mmStats *mSqlDBMockStats) Return(d1 sql.DBStats) *SqlDBMock {
+	if mmStats.mock.funcStats != nil {
+		mmStats.mock.t.Fatalf("SqlDBMock.Stats mock is already set by Set")
+	}
+
+	if mmStats.defaultExpectation == nil {
+		mmStats.defaultExpectation = &SqlDBMockStatsExpectation{mock: mmStats.mock}
+	}
+	mmStats.defaultExpectation.results = &SqlDBMockStatsResults{d1}
+	return mmStats.mock
+}
+
+//Set uses given function f to mock the sqlDB.Stats method
+func (mmStats *mSqlDBMockStats) Set(f func() (d1 sql.DBStats)) *SqlDBMock {
+	if mmStats.defaultExpectation != nil {
+		mmStats.mock.t.Fatalf("Default expectation is already set for the sqlDB.Stats method")
+	}
+
+	if len(mmStats.expectations) > 0 {
+		mmStats.mock.t.Fatalf("Some expectations are already set for the sqlDB.Stats method")
+	}
+
+	mmStats.mock.funcStats = f
+	return mmStats.mock
+}
+
+// Stats implements sqlDB
+func (mmStats *SqlDBMock) Stats() (d1 sql.DBStats) {
+	mm_atomic.AddUint64(&mmStats.beforeStatsCounter, 1)
+	defer mm_atomic.AddUint64(&mmStats.afterStatsCounter, 1)
+
+	if mmStats.inspectFuncStats != nil {
+		mmStats.inspectFuncStats()
+	}
+
+	if mmStats.StatsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmStats.StatsMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmStats.StatsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmStats.t.Fatal("No results are set for the SqlDBMock.Stats")
+		}
+		return (*mm_results).d1
+	}
+	if mmStats.funcStats != nil {
+		return mmStats.funcStats()
+	}
+	mmStats.t.Fatalf("Unexpected call to SqlDBMock.Stats.")
+	return
+}
+
+// StatsAfterCounter returns a count of finished SqlDBMock.Stats invocations
+func (mmStats *SqlDBMock) StatsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStats.afterStatsCounter)
+}
+
+// StatsBeforeCounter returns a count of SqlDBMock.Stats invocations
+func (mmStats *SqlDBMock) StatsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStats.beforeStatsCounter)
+}
+
+// MinimockStatsDone returns true if the count of the Stats invocations corresponds
+// the number of defined expectations
+func (m *SqlDBMock) MinimockStatsDone() bool {
+	for _, e := range m.StatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StatsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterStatsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStats != nil && mm_atomic.LoadUint64(&m.afterStatsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockStatsInspect logs each unmet expectation
+func (m *SqlDBMock) MinimockStatsInspect() {
+	for _, e := range m.StatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to SqlDBMock.Stats")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StatsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterStatsCounter) < 1 {
+		m.t.Error("Expected call to SqlDBMock.Stats")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStats != nil && mm_atomic.LoadUint64(&m.afterStatsCounter) < 1 {
+		m.t.Error("Expected call to SqlDBMock.Stats")
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *SqlDBMock) MinimockFinish() {
+	if !m.minimockDone() {
+		m.MinimockBeginInspect()
+
+		m.MinimockCloseInspect()
+
+		m.MinimockConnInspect()
+
+		m.MinimockExecInspect()
+
+		m.MinimockPingInspect()
+
+		m.MinimockPrepareInspect()
+
+		m.MinimockQueryInspect()
+
+		m.MinimockSetConnMaxIdleTimeInspect()
+
+		m.MinimockSetConnMaxLifetimeInspect()
+
+		m.MinimockSetMaxIdleConnsInspect()
+
+		m.MinimockSetMaxOpenConnsInspect()
+
+		m.MinimockStatsInspect()
 		m.t.FailNow()
 	}
 }
@@ -1120,7 +2519,14 @@ func (m *SqlDBMock) minimockDone() bool {
 	return done &&
 		m.MinimockBeginDone() &&
 		m.MinimockCloseDone() &&
+		m.MinimockConnDone() &&
 		m.MinimockExecDone() &&
+		m.MinimockPingDone() &&
 		m.MinimockPrepareDone() &&
-		m.MinimockQueryDone()
+		m.MinimockQueryDone() &&
+		m.MinimockSetConnMaxIdleTimeDone() &&
+		m.MinimockSetConnMaxLifetimeDone() &&
+		m.MinimockSetMaxIdleConnsDone() &&
+		m.MinimockSetMaxOpenConnsDone() &&
+		m.MinimockStatsDone()
 }
@@ -20,6 +20,12 @@ type SqlRowsMock struct {
 	beforeCloseCounter uint64
 	CloseMock          mSqlRowsMockClose
 
+	funcColumns          func() (sa1 []string, err error)
+	inspectFuncColumns   func()
+	afterColumnsCounter  uint64
+	beforeColumnsCounter uint64
+	ColumnsMock          mSqlRowsMockColumns
+
 	funcErr          func() (err error)
 	inspectFuncErr   func()
 	afterErrCounter  uint64
@@ -48,6 +54,8 @@ func NewSqlRowsMock(t minimock.Tester) *SqlRowsMock {
 
 	m.CloseMock = mSqlRowsMockClose{mock: m}
 
+	m.ColumnsMock = mSqlRowsMockColumns{mock: m}
+
 	m.ErrMock = mSqlRowsMockErr{mock: m}
 
 	m.NextMock = mSqlRowsMockNext{mock: m}
@@ -201,6 +209,150 @@ func (m *SqlRowsMock) MinimockCloseInspect() {
 	}
 }
 
+type mSqlRowsMockColumns struct {
+	mock               *SqlRowsMock
+	defaultExpectation *SqlRowsMockColumnsExpectation
+	expectations       []*SqlRowsMockColumnsExpectation
+}
+
+// SqlRowsMockColumnsExpectation specifies expectation struct of the sqlRows.Columns
+type SqlRowsMockColumnsExpectation struct {
+	mock *SqlRowsMock
+
+	results *SqlRowsMockColumnsResults
+	Counter uint64
+}
+
+// SqlRowsMockColumnsResults contains results of the sqlRows.Columns
+type SqlRowsMockColumnsResults struct {
+	sa1 []string
+	err error
+}
+
+// Expect sets up expected params for sqlRows.Columns
+func (mmColumns *mSqlRowsMockColumns) Expect() *mSqlRowsMockColumns {
+	if mmColumns.mock.funcColumns != nil {
+		mmColumns.mock.t.Fatalf("SqlRowsMock.Columns mock is already set by Set")
+	}
+
+	if mmColumns.defaultExpectation == nil {
+		mmColumns.defaultExpectation = &SqlRowsMockColumnsExpectation{}
+	}
+
+	return mmColumns
+}
+
+// Inspect accepts an inspector function that has same arguments as the sqlRows.Columns
+func (mmColumns *mSqlRowsMockColumns) Inspect(f func()) *mSqlRowsMockColumns {
+	if mmColumns.mock.inspectFuncColumns != nil {
+		mmColumns.mock.t.Fatalf("Inspect function is already set for SqlRowsMock.Columns")
+	}
+
+	mmColumns.mock.inspectFuncColumns = f
+
+	return mmColumns
+}
+
+// Return sets up results that will be returned by sqlRows.Columns
+func (mmColumns *mSqlRowsMockColumns) Return(sa1 []string, err error) *SqlRowsMock {
+	if mmColumns.mock.funcColumns != nil {
+		mmColumns.mock.t.Fatalf("SqlRowsMock.Columns mock is already set by Set")
+	}
+
+	if mmColumns.defaultExpectation == nil {
+		mmColumns.defaultExpectation = &SqlRowsMockColumnsExpectation{mock: mmColumns.mock}
+	}
+	mmColumns.defaultExpectation.results = &SqlRowsMockColumnsResults{sa1, err}
+	return mmColumns.mock
+}
+
+//Set uses given function f to mock the sqlRows.Columns method
+func (mmColumns *mSqlRowsMockColumns) Set(f func() (sa1 []string, err error)) *SqlRowsMock {
+	if mmColumns.defaultExpectation != nil {
+		mmColumns.mock.t.Fatalf("Default expectation is already set for the sqlRows.Columns method")
+	}
+
+	if len(mmColumns.expectations) > 0 {
+		mmColumns.mock.t.Fatalf("Some expectations are already set for the sqlRows.Columns method")
+	}
+
+	mmColumns.mock.funcColumns = f
+	return mmColumns.mock
+}
+
+// Columns implements sqlRows
+func (mmColumns *SqlRowsMock) Columns() (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmColumns.beforeColumnsCounter, 1)
+	defer mm_atomic.AddUint64(&mmColumns.afterColumnsCounter, 1)
+
+	if mmColumns.inspectFuncColumns != nil {
+		mmColumns.inspectFuncColumns()
+	}
+
+	if mmColumns.ColumnsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmColumns.ColumnsMock.defaultExpectation.Counter, 1)
+
+		mm_results := mmColumns.ColumnsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmColumns.t.Fatal("No results are set for the SqlRowsMock.Columns")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmColumns.funcColumns != nil {
+		return mmColumns.funcColumns()
+	}
+	mmColumns.t.Fatalf("Unexpected call to SqlRowsMock.Columns.")
+	return
+}
+
+// ColumnsAfterCounter returns a count of finished SqlRowsMock.Columns invocations
+func (mmColumns *SqlRowsMock) ColumnsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmColumns.afterColumnsCounter)
+}
+
+// ColumnsBeforeCounter returns a count of SqlRowsMock.Columns invocations
+func (mmColumns *SqlRowsMock) ColumnsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmColumns.beforeColumnsCounter)
+}
+
+// MinimockColumnsDone returns true if the count of the Columns invocations corresponds
+// the number of defined expectations
+func (m *SqlRowsMock) MinimockColumnsDone() bool {
+	for _, e := range m.ColumnsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ColumnsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterColumnsCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcColumns != nil && mm_atomic.LoadUint64(&m.afterColumnsCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockColumnsInspect logs each unmet expectation
+func (m *SqlRowsMock) MinimockColumnsInspect() {
+	for _, e := range m.ColumnsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to SqlRowsMock.Columns")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ColumnsMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterColumnsCounter) < 1 {
+		m.t.Error("Expected call to SqlRowsMock.Columns")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcColumns != nil && mm_atomic.LoadUint64(&m.afterColumnsCounter) < 1 {
+		m.t.Error("Expected call to SqlRowsMock.Columns")
+	}
+}
+
 type mSqlRowsMockErr struct {
 	mock               *SqlRowsMock
 	defaultExpectation *SqlRowsMockErrExpectation
@@ -707,6 +859,8 @@ func (m *SqlRowsMock) MinimockFinish() {
 	if !m.minimockDone() {
 		m.MinimockCloseInspect()
 
+		m.MinimockColumnsInspect()
+
 		m.MinimockErrInspect()
 
 		m.MinimockNextInspect()
@@ -736,6 +890,7 @@ func (m *SqlRowsMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockCloseDone() &&
+		m.MinimockColumnsDone() &&
 		m.MinimockErrDone() &&
 		m.MinimockNextDone() &&
 		m.MinimockScanDone()
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"io"
+	"time"
 )
 
 //go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i sqlDB -s _mock_test.go
@@ -13,7 +14,32 @@ type sqlDB interface {
 
 	sqlQueryer
 	sqlPreparer
+	sqlBeginner
 
+	Conn(ctx context.Context) (sqlConn, error)
+
+	Ping(ctx context.Context) error
+	Stats() sql.DBStats
+
+	SetMaxOpenConns(n int)
+	SetMaxIdleConns(n int)
+	SetConnMaxLifetime(d time.Duration)
+	SetConnMaxIdleTime(d time.Duration)
+}
+
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i sqlConn -s _mock_test.go
+
+type sqlConn interface {
+	io.Closer
+
+	sqlQueryer
+	sqlPreparer
+	sqlBeginner
+}
+
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i sqlBeginner -s _mock_test.go
+
+type sqlBeginner interface {
 	Begin(ctx context.Context) (sqlTx, error)
 }
 
@@ -57,6 +83,7 @@ type sqlRows interface {
 	Next() bool
 	Scan(...interface{}) error
 	Err() error
+	Columns() ([]string, error)
 }
 
 //go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i sqlResult -s _mock_test.go
@@ -23,6 +23,10 @@ func newSQLTx(tx *sql.Tx) sqlTx {
 	return sqlTxImpl{tx}
 }
 
+func newSQLConn(conn *sql.Conn) sqlConn {
+	return sqlConnImpl{conn}
+}
+
 type sqlDBImpl struct {
 	*sql.DB
 }
@@ -44,12 +48,23 @@ func (s sqlDBImpl) Prepare(ctx context.Context, query string) (sqlStmt, error) {
 	return newSQLStmt(stmt), err
 }
 
-// Begin implements sqlDB.Begin
+// Begin implements sqlBeginner.Begin
 func (s sqlDBImpl) Begin(ctx context.Context) (sqlTx, error) {
 	tx, err := s.DB.BeginTx(ctx, nil)
 	return newSQLTx(tx), err
 }
 
+// Conn implements sqlDB.Conn
+func (s sqlDBImpl) Conn(ctx context.Context) (sqlConn, error) {
+	conn, err := s.DB.Conn(ctx)
+	return newSQLConn(conn), err
+}
+
+// Ping implements sqlDB.Ping
+func (s sqlDBImpl) Ping(ctx context.Context) error {
+	return s.DB.PingContext(ctx)
+}
+
 type sqlStmtImpl struct {
 	*sql.Stmt
 }
@@ -85,3 +100,30 @@ func (s sqlTxImpl) Prepare(ctx context.Context, query string) (sqlStmt, error) {
 	stmt, err := s.Tx.PrepareContext(ctx, query)
 	return newSQLStmt(stmt), err
 }
+
+type sqlConnImpl struct {
+	*sql.Conn
+}
+
+// Query implements sqlQueryer.Query
+func (s sqlConnImpl) Query(ctx context.Context, query string, args ...interface{}) (sqlRows, error) {
+	rows, err := s.Conn.QueryContext(ctx, query, args...)
+	return newSQLRows(rows), err
+}
+
+// Exec implements sqlQueryer.Exec
+func (s sqlConnImpl) Exec(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return s.Conn.ExecContext(ctx, query, args...)
+}
+
+// Prepare implements sqlPreparer.Prepare
+func (s sqlConnImpl) Prepare(ctx context.Context, query string) (sqlStmt, error) {
+	stmt, err := s.Conn.PrepareContext(ctx, query)
+	return newSQLStmt(stmt), err
+}
+
+// Begin implements sqlBeginner.Begin
+func (s sqlConnImpl) Begin(ctx context.Context) (sqlTx, error) {
+	tx, err := s.Conn.BeginTx(ctx, nil)
+	return newSQLTx(tx), err
+}
@@ -5,30 +5,46 @@ import (
 	"database/sql"
 )
 
-func newStatement(sqlStatement sqlStmt) Statement {
-	return &statementImpl{statement: sqlStatement, scan: defaultScanDoer()}
+func newStatement(sqlStatement sqlStmt, sql string, cfg *config) Statement {
+	return &statementImpl{statement: sqlStatement, sql: sql, scan: defaultScanDoer(), cfg: cfg}
 }
 
 type statementImpl struct {
 	statement sqlStmt
+	sql       string
 	scan      scanDoer
+	cfg       *config
 }
 
 var _ Statement = (*statementImpl)(nil)
 
 // Scan implements Statement.Scan
 func (s statementImpl) Scan(ctx context.Context, scanner RowScanner, args ...interface{}) error {
-	return s.scan.Do(scanner, false, s.queryFunc(ctx, args...))
+	op := s.operation(OperationScan, args)
+	return s.cfg.interceptScan(ctx, op, scanner, func(ctx context.Context, scanner RowScanner) error {
+		return s.scan.Do(scanner, false, s.cfg.scanLimiter(ctx, op.SQL), s.queryFunc(ctx, op.Args...))
+	})
 }
 
 // ScanOne implements Statement.ScanOne
 func (s statementImpl) ScanOne(ctx context.Context, scanner RowScanner, args ...interface{}) error {
-	return s.scan.Do(scanner, true, s.queryFunc(ctx, args...))
+	op := s.operation(OperationScanOne, args)
+	return s.cfg.interceptScan(ctx, op, scanner, func(ctx context.Context, scanner RowScanner) error {
+		return noRowsOf(s.scan.Do(scanner, true, s.cfg.scanLimiter(ctx, op.SQL), s.queryFunc(ctx, op.Args...)), op.SQL)
+	})
+}
+
+// ScanOptional implements Statement.ScanOptional
+func (s statementImpl) ScanOptional(ctx context.Context, scanner RowScanner, args ...interface{}) (bool, error) {
+	return found(s.ScanOne(ctx, scanner, args...))
 }
 
 // Update implements Statement.Update
 func (s statementImpl) Update(ctx context.Context, args ...interface{}) (sql.Result, error) {
-	return s.statement.Exec(ctx, args...)
+	op := s.operation(OperationUpdate, args)
+	return s.cfg.interceptUpdate(ctx, op, func(ctx context.Context) (sql.Result, error) {
+		return s.statement.Exec(ctx, driverArgs(op.Args)...)
+	})
 }
 
 // UpdateAndGetRowsAffected implements Statement.UpdateAndGetRowsAffected
@@ -41,8 +57,26 @@ func (s statementImpl) UpdateAndGetLastInsertID(ctx context.Context, args ...int
 	return lastInsertID(s.Update(ctx, args...))
 }
 
+// UpdateReturning implements Statement.UpdateReturning
+func (s statementImpl) UpdateReturning(ctx context.Context, scanner RowScanner, args ...interface{}) (int64, error) {
+	op := s.operation(OperationUpdate, args)
+	var rowsAffected int64
+	err := s.cfg.interceptScan(ctx, op, scanner, func(ctx context.Context, scanner RowScanner) error {
+		var err error
+		rowsAffected, err = updateReturning(s.scan, scanner, s.queryFunc(ctx, op.Args...))
+		return err
+	})
+	return rowsAffected, err
+}
+
+func (s statementImpl) operation(kind OperationKind, args []interface{}) *Operation {
+	op := s.cfg.operation(kind, s.sql, args)
+	op.Prepared = true
+	return op
+}
+
 func (s statementImpl) queryFunc(ctx context.Context, args ...interface{}) func() (sqlRows, error) {
 	return func() (sqlRows, error) {
-		return s.statement.Query(ctx, args...)
+		return s.statement.Query(ctx, driverArgs(args)...)
 	}
 }
@@ -0,0 +1,268 @@
+package libsql
+
+import (
+	"container/list"
+	"context"
+	"database/sql"
+	"strings"
+	"sync"
+)
+
+// WithStatementCache makes the Database's Queryer methods transparently
+// prepare statements and reuse them for subsequent calls with the same SQL.
+// The query tags of WithQueryTagComments are not part of the prepared SQL.
+//
+// A StatementCache may be shared between Databases, e.g. the primary and the
+// replicas of a cluster: the statements of each Database are cached separately,
+// and the size of the cache bounds their total number.
+func WithStatementCache(cache *StatementCache) Option {
+	return func(c *config) {
+		c.stmtCache = cache
+	}
+}
+
+// StatementCacheStats are statistics of a StatementCache
+type StatementCacheStats struct {
+	// Hits is the number of calls that reused a cached statement
+	Hits uint64
+	// Misses is the number of calls that prepared a new statement
+	Misses uint64
+	// Evictions is the number of statements closed to keep the cache within its size
+	Evictions uint64
+	// Invalidations is the number of statements discarded after the database
+	// reported that they need to be re-prepared
+	Invalidations uint64
+	// Size is the number of currently cached statements
+	Size int
+}
+
+// StatementCache is a least recently used cache of prepared statements keyed by SQL text.
+//
+// Cached statements are closed when they are evicted, when the database reports
+// that they need to be re-prepared, and when the Database is closed.
+// StatementCache is safe for concurrent use.
+type StatementCache struct {
+	maxSize int
+
+	mu      sync.Mutex
+	owners  uint64
+	lru     *list.List
+	entries map[stmtCacheKey]*list.Element
+	stats   StatementCacheStats
+}
+
+// stmtCacheKey identifies the statement of a Database for the given SQL
+type stmtCacheKey struct {
+	// owner identifies the Database the statement was prepared on
+	owner uint64
+	sql   string
+}
+
+// NewStatementCache creates a StatementCache holding at most maxSize statements.
+// A maxSize less than 1 is treated as 1.
+func NewStatementCache(maxSize int) *StatementCache {
+	if maxSize < 1 {
+		maxSize = 1
+	}
+	return &StatementCache{
+		maxSize: maxSize,
+		lru:     list.New(),
+		entries: make(map[stmtCacheKey]*list.Element),
+	}
+}
+
+// Stats returns the current statistics of the cache
+func (c *StatementCache) Stats() StatementCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats := c.stats
+	stats.Size = c.lru.Len()
+	return stats
+}
+
+type cachedStmt struct {
+	// key identifies the statement by its SQL without query tags
+	key  stmtCacheKey
+	stmt sqlStmt
+
+	// refs is the number of calls currently using stmt
+	refs int
+	// removed is set once the statement is no longer in the cache
+	removed bool
+}
+
+// wrap returns db using the cache for queries, with statements of its own
+func (c *StatementCache) wrap(db sqlDB) sqlDB {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.owners++
+	return stmtCachingDB{sqlDB: db, cache: c, owner: c.owners}
+}
+
+// acquire returns a cached statement for sql, preparing it if needed.
+// The caller must release the returned statement.
+//
+// Statements are cached and prepared with their SQL without query tags, so that
+// a query takes a single entry and a single statement whatever its query tags.
+func (c *StatementCache) acquire(ctx context.Context, db stmtCachingDB, sql string) (*cachedStmt, error) {
+	key := stmtCacheKey{owner: db.owner, sql: stripQueryTagComment(sql)}
+
+	c.mu.Lock()
+	if elem, ok := c.entries[key]; ok {
+		c.stats.Hits++
+		c.lru.MoveToFront(elem)
+		entry := elem.Value.(*cachedStmt)
+		entry.refs++
+		c.mu.Unlock()
+		return entry, nil
+	}
+	c.stats.Misses++
+	c.mu.Unlock()
+
+	stmt, err := db.sqlDB.Prepare(ctx, key.sql)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	if elem, ok := c.entries[key]; ok {
+		// the statement was cached concurrently
+		entry := elem.Value.(*cachedStmt)
+		entry.refs++
+		c.mu.Unlock()
+		ignoreClose(stmt)
+		return entry, nil
+	}
+	var evicted []*cachedStmt
+	entry := &cachedStmt{key: key, stmt: stmt, refs: 1}
+	c.entries[key] = c.lru.PushFront(entry)
+	for c.lru.Len() > c.maxSize {
+		c.stats.Evictions++
+		if e := c.removeLocked(c.lru.Back()); e != nil {
+			evicted = append(evicted, e)
+		}
+	}
+	c.mu.Unlock()
+
+	closeStmts(evicted)
+	return entry, nil
+}
+
+// release marks the end of a call using entry
+func (c *StatementCache) release(entry *cachedStmt) {
+	c.mu.Lock()
+	entry.refs--
+	closeNow := entry.removed && entry.refs == 0
+	c.mu.Unlock()
+
+	if closeNow {
+		ignoreClose(entry.stmt)
+	}
+}
+
+// invalidate removes entry from the cache
+func (c *StatementCache) invalidate(entry *cachedStmt) {
+	c.mu.Lock()
+	var toClose *cachedStmt
+	if elem, ok := c.entries[entry.key]; ok && elem.Value == entry {
+		c.stats.Invalidations++
+		toClose = c.removeLocked(elem)
+	}
+	c.mu.Unlock()
+
+	if toClose != nil {
+		ignoreClose(toClose.stmt)
+	}
+}
+
+// clear removes the statements of the Database identified by owner from the cache
+func (c *StatementCache) clear(owner uint64) {
+	c.mu.Lock()
+	var toClose []*cachedStmt
+	for elem := c.lru.Front(); elem != nil; {
+		next := elem.Next()
+		if elem.Value.(*cachedStmt).key.owner == owner {
+			if e := c.removeLocked(elem); e != nil {
+				toClose = append(toClose, e)
+			}
+		}
+		elem = next
+	}
+	c.mu.Unlock()
+
+	closeStmts(toClose)
+}
+
+// removeLocked removes elem from the cache and returns its entry if it can be closed immediately
+func (c *StatementCache) removeLocked(elem *list.Element) *cachedStmt {
+	entry := c.lru.Remove(elem).(*cachedStmt)
+	delete(c.entries, entry.key)
+	entry.removed = true
+	if entry.refs > 0 {
+		// closed by the last release
+		return nil
+	}
+	return entry
+}
+
+func closeStmts(entries []*cachedStmt) {
+	for _, entry := range entries {
+		ignoreClose(entry.stmt)
+	}
+}
+
+// needsRepreparation reports whether err indicates that a prepared statement
+// is no longer valid, e.g. because the table it refers to has been altered
+func needsRepreparation(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "needs re-preparation") ||
+		strings.Contains(msg, "needs to be re-prepared")
+}
+
+// stmtCachingDB is a sqlDB executing queries via statements from a StatementCache
+type stmtCachingDB struct {
+	sqlDB
+
+	cache *StatementCache
+	owner uint64
+}
+
+var _ sqlDB = (*stmtCachingDB)(nil)
+
+// Query implements sqlQueryer.Query
+func (s stmtCachingDB) Query(ctx context.Context, query string, args ...interface{}) (sqlRows, error) {
+	entry, err := s.cache.acquire(ctx, s, query)
+	if err != nil {
+		return nil, err
+	}
+	// closing a *sql.Stmt is deferred by database/sql until its rows are closed,
+	// so the statement may be released before the rows are consumed
+	defer s.cache.release(entry)
+
+	rows, err := entry.stmt.Query(ctx, args...)
+	if err != nil && needsRepreparation(err) {
+		s.cache.invalidate(entry)
+	}
+	return rows, err
+}
+
+// Exec implements sqlQueryer.Exec
+func (s stmtCachingDB) Exec(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	entry, err := s.cache.acquire(ctx, s, query)
+	if err != nil {
+		return nil, err
+	}
+	defer s.cache.release(entry)
+
+	result, err := entry.stmt.Exec(ctx, args...)
+	if err != nil && needsRepreparation(err) {
+		s.cache.invalidate(entry)
+	}
+	return result, err
+}
+
+// Close implements io.Closer
+func (s stmtCachingDB) Close() error {
+	s.cache.clear(s.owner)
+	return s.sqlDB.Close()
+}
@@ -0,0 +1,217 @@
+package libsql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_StatementCache_ReusesStatements(t *testing.T) {
+	ctx := context.Background()
+	const expQuery = "SELECT x FROM aTable WHERE y = ?"
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	sqlStmt := NewSqlStmtMock(t)
+	defer sqlStmt.MinimockFinish()
+
+	sqlResult := NewSqlResultMock(t)
+	defer sqlResult.MinimockFinish()
+
+	sqlDB.PrepareMock.Expect(ctx, expQuery).Return(sqlStmt, nil)
+	sqlStmt.ExecMock.Return(sqlResult, nil)
+
+	cache := NewStatementCache(10)
+	db := cache.wrap(sqlDB)
+
+	for i := 0; i < 3; i++ {
+		actualResult, err := db.Exec(ctx, expQuery, i)
+		require.NoError(t, err)
+		require.Equal(t, sqlResult, actualResult)
+	}
+
+	require.Equal(t, uint64(1), sqlDB.PrepareAfterCounter())
+	require.Equal(t, StatementCacheStats{Hits: 2, Misses: 1, Size: 1}, cache.Stats())
+}
+
+func Test_StatementCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	ctx := context.Background()
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	stmts := map[string]*SqlStmtMock{}
+	for _, query := range []string{"SELECT 1", "SELECT 2", "SELECT 3"} {
+		stmt := NewSqlStmtMock(t)
+		defer stmt.MinimockFinish()
+		stmt.QueryMock.Return(nil, nil)
+		stmts[query] = stmt
+		sqlDB.PrepareMock.When(ctx, query).Then(stmt, nil)
+	}
+
+	stmts["SELECT 2"].CloseMock.Return(nil)
+
+	cache := NewStatementCache(2)
+	db := cache.wrap(sqlDB)
+
+	for _, query := range []string{"SELECT 1", "SELECT 2", "SELECT 1", "SELECT 3"} {
+		_, err := db.Query(ctx, query)
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, uint64(0), stmts["SELECT 1"].CloseAfterCounter())
+	require.Equal(t, uint64(1), stmts["SELECT 2"].CloseAfterCounter())
+	require.Equal(t, uint64(0), stmts["SELECT 3"].CloseAfterCounter())
+	require.Equal(t, StatementCacheStats{Hits: 1, Misses: 3, Evictions: 1, Size: 2}, cache.Stats())
+}
+
+func Test_StatementCache_EvictedStatementIsClosedAfterRelease(t *testing.T) {
+	ctx := context.Background()
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	stmt1 := NewSqlStmtMock(t)
+	defer stmt1.MinimockFinish()
+	stmt1.CloseMock.Return(nil)
+
+	stmt2 := NewSqlStmtMock(t)
+	defer stmt2.MinimockFinish()
+
+	sqlDB.PrepareMock.When(ctx, "SELECT 1").Then(stmt1, nil)
+	sqlDB.PrepareMock.When(ctx, "SELECT 2").Then(stmt2, nil)
+
+	cache := NewStatementCache(1)
+	db := cache.wrap(sqlDB).(stmtCachingDB)
+
+	entry1, err := cache.acquire(ctx, db, "SELECT 1")
+	require.NoError(t, err)
+
+	entry2, err := cache.acquire(ctx, db, "SELECT 2")
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), stmt1.CloseAfterCounter())
+
+	cache.release(entry1)
+	require.Equal(t, uint64(1), stmt1.CloseAfterCounter())
+
+	cache.release(entry2)
+	require.Equal(t, uint64(0), stmt2.CloseAfterCounter())
+}
+
+func Test_StatementCache_InvalidatesStatementsNeedingRepreparation(t *testing.T) {
+	ctx := context.Background()
+	const expQuery = "SELECT x FROM aTable"
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	sqlStmt := NewSqlStmtMock(t)
+	defer sqlStmt.MinimockFinish()
+
+	expErr := errors.New("Error 1615: Prepared statement needs to be re-prepared")
+	sqlDB.PrepareMock.Expect(ctx, expQuery).Return(sqlStmt, nil)
+	sqlStmt.QueryMock.Return(nil, expErr)
+	sqlStmt.CloseMock.Return(nil)
+
+	cache := NewStatementCache(10)
+	db := cache.wrap(sqlDB)
+
+	_, err := db.Query(ctx, expQuery)
+	require.Equal(t, expErr, err)
+	require.Equal(t, uint64(1), sqlStmt.CloseAfterCounter())
+	require.Equal(t, StatementCacheStats{Misses: 1, Invalidations: 1}, cache.Stats())
+}
+
+func Test_StatementCache_PrepareErrorIsReturned(t *testing.T) {
+	ctx := context.Background()
+	const expQuery = "SELECT x FROM aTable"
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	expErr := errors.New("a-test-error")
+	sqlDB.PrepareMock.Expect(ctx, expQuery).Return(nil, expErr)
+
+	cache := NewStatementCache(10)
+	db := cache.wrap(sqlDB)
+
+	_, err := db.Exec(ctx, expQuery)
+	require.Equal(t, expErr, err)
+	require.Equal(t, StatementCacheStats{Misses: 1}, cache.Stats())
+}
+
+func Test_StatementCache_CloseClosesStatements(t *testing.T) {
+	ctx := context.Background()
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	sqlStmt := NewSqlStmtMock(t)
+	defer sqlStmt.MinimockFinish()
+
+	sqlRows := NewSqlRowsMock(t)
+	defer sqlRows.MinimockFinish()
+
+	sqlDB.PrepareMock.Return(sqlStmt, nil)
+	sqlDB.CloseMock.Return(nil)
+	sqlStmt.QueryMock.Return(sqlRows, nil)
+	sqlStmt.CloseMock.Return(nil)
+	sqlRows.NextMock.Return(false)
+	sqlRows.ErrMock.Return(nil)
+	sqlRows.CloseMock.Return(nil)
+
+	cache := NewStatementCache(10)
+	db := newDatabase(cache.wrap(sqlDB), nil)
+
+	require.NoError(t, db.Scan(ctx, Into(), "SELECT 1"))
+	require.NoError(t, db.Close())
+	require.Equal(t, uint64(1), sqlStmt.CloseAfterCounter())
+	require.Equal(t, 0, cache.Stats().Size)
+}
+
+func Test_StatementCache_IsSharedBetweenDatabases(t *testing.T) {
+	ctx := context.Background()
+	const expQuery = "SELECT x FROM aTable"
+
+	sqlDB1 := NewSqlDBMock(t)
+	defer sqlDB1.MinimockFinish()
+
+	sqlDB2 := NewSqlDBMock(t)
+	defer sqlDB2.MinimockFinish()
+
+	stmt1 := NewSqlStmtMock(t)
+	defer stmt1.MinimockFinish()
+
+	stmt2 := NewSqlStmtMock(t)
+	defer stmt2.MinimockFinish()
+
+	sqlDB1.PrepareMock.Expect(ctx, expQuery).Return(stmt1, nil)
+	sqlDB1.CloseMock.Return(nil)
+	sqlDB2.PrepareMock.Expect(ctx, expQuery).Return(stmt2, nil)
+	stmt1.QueryMock.Return(nil, nil)
+	stmt1.CloseMock.Return(nil)
+	stmt2.QueryMock.Return(nil, nil)
+
+	cache := NewStatementCache(10)
+	db1 := cache.wrap(sqlDB1)
+	db2 := cache.wrap(sqlDB2)
+
+	for i := 0; i < 2; i++ {
+		_, err := db1.Query(ctx, expQuery)
+		require.NoError(t, err)
+		_, err = db2.Query(ctx, expQuery)
+		require.NoError(t, err)
+	}
+	require.Equal(t, uint64(2), stmt1.QueryAfterCounter())
+	require.Equal(t, uint64(2), stmt2.QueryAfterCounter())
+	require.Equal(t, StatementCacheStats{Hits: 2, Misses: 2, Size: 2}, cache.Stats())
+
+	// closing a Database only closes its statements
+	require.NoError(t, db1.Close())
+	require.Equal(t, uint64(1), stmt1.CloseAfterCounter())
+	require.Equal(t, 1, cache.Stats().Size)
+}
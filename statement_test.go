@@ -47,6 +47,24 @@ func (s *StatementSuite) TestScanOne() {
 	s.doTestScan(s.statement.ScanOne, true)
 }
 
+func (s *StatementSuite) TestScanOptional() {
+	s.statement.sql = "SELECT x FROM aTable WHERE y = ?"
+	results := []error{ErrNoRows, nil}
+	s.scan.DoMock.Set(func(rowScanner RowScanner, oneRow bool, limits *scanLimiter, query func() (sqlRows, error)) (err error) {
+		s.Require().True(oneRow)
+		err, results = results[0], results[1:]
+		return err
+	})
+
+	found, err := s.statement.ScanOptional(context.Background(), Into(), 1)
+	s.Require().NoError(err)
+	s.Require().False(found)
+
+	found, err = s.statement.ScanOptional(context.Background(), Into(), 1)
+	s.Require().NoError(err)
+	s.Require().True(found)
+}
+
 func (s *StatementSuite) TestUpdate() {
 
 	sqlResultMock := NewSqlResultMock(s.T())
@@ -105,6 +123,35 @@ func (s *StatementSuite) TestUpdateAndGetLastInsertID() {
 	s.Require().Equal(expLastInsertID, actualLastInsertID)
 }
 
+func (s *StatementSuite) TestUpdateReturning() {
+	expRowScanner := NewRowScannerMock(s.T())
+	defer expRowScanner.MinimockFinish()
+
+	expSqlRows := NewSqlRowsMock(s.T())
+	defer expSqlRows.MinimockFinish()
+
+	expCtx := context.Background()
+	expArgs := []interface{}{1, 94}
+
+	s.sqlStatement.QueryMock.When(
+		expCtx,
+		expArgs...,
+	).Then(expSqlRows, (error)(nil))
+
+	expRowScanner.RowScannedMock.Return((error)(nil))
+
+	s.scan.DoMock.Set(func(rowScanner RowScanner, oneRow bool, limits *scanLimiter, query func() (sqlRows, error)) (err error) {
+		s.Require().False(oneRow)
+		_, _ = query()
+		s.Require().NoError(rowScanner.RowScanned())
+		return (error)(nil)
+	})
+
+	actualRowsAffected, err := s.statement.UpdateReturning(expCtx, expRowScanner, expArgs...)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), actualRowsAffected)
+}
+
 func (s *StatementSuite) doTestScan(
 	scan func(context.Context, RowScanner, ...interface{}) error,
 	expectedOneRow bool,
@@ -123,7 +170,7 @@ func (s *StatementSuite) doTestScan(
 		expArgs...,
 	).Then(expSqlRows, (error)(nil))
 
-	s.scan.DoMock.Set(func(rowScanner RowScanner, oneRow bool, query func() (sqlRows, error)) (err error) {
+	s.scan.DoMock.Set(func(rowScanner RowScanner, oneRow bool, limits *scanLimiter, query func() (sqlRows, error)) (err error) {
 		s.Require().Equal(expRowScanner, rowScanner)
 		s.Require().Equal(expectedOneRow, oneRow)
 		// execute query and and the assertion is that the call is
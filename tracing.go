@@ -0,0 +1,270 @@
+package libsql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Attribute keys of the spans started by TracingConfig.Interceptor,
+// following the OpenTelemetry semantic conventions for database client spans
+const (
+	SpanKeySystem       = "db.system"
+	SpanKeyNamespace    = "db.namespace"
+	SpanKeyOperation    = "db.operation.name"
+	SpanKeyQueryText    = "db.query.text"
+	SpanKeyReturnedRows = "db.response.returned_rows"
+	SpanKeyErrorType    = "error.type"
+	// SpanKeyLibsqlOperation is the kind of the libsql Operation
+	SpanKeyLibsqlOperation = "libsql.operation"
+)
+
+// SpanAttribute is a key-value attribute of a Span
+type SpanAttribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Tracer starts Spans. It is implemented by adapters of tracing libraries.
+type Tracer interface {
+	// StartSpan starts a span that is a child of the span of ctx, if any,
+	// and returns a context holding the new span
+	StartSpan(ctx context.Context, name string, attrs ...SpanAttribute) (context.Context, Span)
+}
+
+// Span is a span started by a Tracer
+type Span interface {
+	// SetAttributes adds attributes to the span
+	SetAttributes(attrs ...SpanAttribute)
+
+	// RecordError records that the operation of the span failed with err
+	RecordError(err error)
+
+	// End ends the span
+	End()
+}
+
+// NoopTracer is a Tracer starting spans that do nothing
+var NoopTracer Tracer = noopTracer{}
+
+type noopTracer struct{}
+
+// StartSpan implements Tracer.StartSpan
+func (noopTracer) StartSpan(ctx context.Context, _ string, _ ...SpanAttribute) (context.Context, Span) {
+	return ctx, noopSpan{}
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttributes(...SpanAttribute) {}
+func (noopSpan) RecordError(error)              {}
+func (noopSpan) End()                           {}
+
+// TracingConfig configures tracing of database operations
+type TracingConfig struct {
+	// System is the value of the db.system attribute, e.g. "mysql" or "postgresql".
+	// The attribute is omitted when empty
+	System string
+
+	// Namespace is the value of the db.namespace attribute, usually the database name.
+	// The attribute is omitted when empty
+	Namespace string
+
+	// NormalizeSQL makes db.query.text normalized with NormalizeSQL,
+	// which also removes literal values from it
+	NormalizeSQL bool
+}
+
+// Interceptor returns an Interceptor starting a span with tracer for each operation.
+//
+// A transaction gets a span enclosing the spans of its begin, commit or rollback,
+// and of the operations performed in it. The spans of operations performed
+// in a transaction are children of the transaction span, but they are not
+// propagated to the contexts these operations are performed with.
+// A nil tracer is the NoopTracer.
+func (c TracingConfig) Interceptor(tracer Tracer) Interceptor {
+	if tracer == nil {
+		tracer = NoopTracer
+	}
+	t := &queryTracer{cfg: c, tracer: tracer}
+	return t.intercept
+}
+
+type queryTracer struct {
+	cfg    TracingConfig
+	tracer Tracer
+}
+
+func (t *queryTracer) intercept(ctx context.Context, op *Operation, next func(context.Context) error) error {
+	parent := ctx
+	if op.TransactionContext != nil {
+		parent = op.TransactionContext
+	}
+	spanCtx, span := t.tracer.StartSpan(parent, spanName(op), t.attrs(op)...)
+	defer span.End()
+	if op.TransactionContext == nil {
+		ctx = spanCtx
+	}
+
+	err := next(ctx)
+
+	if op.Kind == OperationScan || op.Kind == OperationScanOne || op.RowsScanned > 0 {
+		span.SetAttributes(SpanAttribute{Key: SpanKeyReturnedRows, Value: op.RowsScanned})
+	}
+	if err != nil && !errors.Is(err, ErrNoRows) {
+		span.SetAttributes(SpanAttribute{Key: SpanKeyErrorType, Value: fmt.Sprintf("%T", err)})
+		span.RecordError(err)
+	}
+	return err
+}
+
+func (t *queryTracer) attrs(op *Operation) []SpanAttribute {
+	attrs := make([]SpanAttribute, 0, 5)
+	if t.cfg.System != "" {
+		attrs = append(attrs, SpanAttribute{Key: SpanKeySystem, Value: t.cfg.System})
+	}
+	if t.cfg.Namespace != "" {
+		attrs = append(attrs, SpanAttribute{Key: SpanKeyNamespace, Value: t.cfg.Namespace})
+	}
+	if name := operationName(op); name != "" {
+		attrs = append(attrs, SpanAttribute{Key: SpanKeyOperation, Value: name})
+	}
+	if op.SQL != "" {
+		sql := op.SQL
+		if t.cfg.NormalizeSQL {
+			sql = NormalizeSQL(sql)
+		}
+		attrs = append(attrs, SpanAttribute{Key: SpanKeyQueryText, Value: sql})
+	}
+	attrs = append(attrs, SpanAttribute{Key: SpanKeyLibsqlOperation, Value: op.Kind.String()})
+	return attrs
+}
+
+// operationName returns the database operation name of op,
+// i.e. the first keyword of its SQL or the transaction control statement
+func operationName(op *Operation) string {
+	switch op.Kind {
+	case OperationBegin:
+		return "BEGIN"
+	case OperationCommit:
+		return "COMMIT"
+	case OperationRollback:
+		return "ROLLBACK"
+	case OperationTransaction:
+		return ""
+	}
+	sql := strings.TrimLeft(NormalizeSQL(op.SQL), "(")
+	if i := strings.IndexAny(sql, " ("); i >= 0 {
+		sql = sql[:i]
+	}
+	return strings.ToUpper(sql)
+}
+
+// spanName returns the name of the span of op
+func spanName(op *Operation) string {
+	if op.Kind == OperationTransaction {
+		return "transaction"
+	}
+	name := operationName(op)
+	if name == "" {
+		name = op.Kind.String()
+	}
+	if op.Kind == OperationPrepare {
+		return "prepare " + name
+	}
+	return name
+}
+
+// RecordedSpan is a span recorded by a RecordingTracer
+type RecordedSpan struct {
+	// ID is the one-based position of the span in the order spans were started
+	ID int
+	// ParentID is the ID of the parent span, zero for root spans
+	ParentID   int
+	Name       string
+	Attributes map[string]interface{}
+	Errors     []error
+	Ended      bool
+}
+
+// RecordingTracer is a Tracer recording spans in memory, intended for tests
+type RecordingTracer struct {
+	mu    sync.Mutex
+	spans []*RecordedSpan
+}
+
+var _ Tracer = (*RecordingTracer)(nil)
+
+// NewRecordingTracer returns a new RecordingTracer
+func NewRecordingTracer() *RecordingTracer {
+	return &RecordingTracer{}
+}
+
+type recordedSpanKey struct{}
+
+// StartSpan implements Tracer.StartSpan
+func (r *RecordingTracer) StartSpan(ctx context.Context, name string, attrs ...SpanAttribute) (context.Context, Span) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	span := &RecordedSpan{
+		ID:         len(r.spans) + 1,
+		Name:       name,
+		Attributes: map[string]interface{}{},
+	}
+	if parent, ok := ctx.Value(recordedSpanKey{}).(int); ok {
+		span.ParentID = parent
+	}
+	for _, attr := range attrs {
+		span.Attributes[attr.Key] = attr.Value
+	}
+	r.spans = append(r.spans, span)
+	return context.WithValue(ctx, recordedSpanKey{}, span.ID), &recordingSpan{tracer: r, span: span}
+}
+
+// Spans returns copies of the recorded spans in the order they were started
+func (r *RecordingTracer) Spans() []RecordedSpan {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	spans := make([]RecordedSpan, len(r.spans))
+	for i, span := range r.spans {
+		spans[i] = *span
+		spans[i].Attributes = make(map[string]interface{}, len(span.Attributes))
+		for k, v := range span.Attributes {
+			spans[i].Attributes[k] = v
+		}
+		spans[i].Errors = append([]error(nil), span.Errors...)
+	}
+	return spans
+}
+
+type recordingSpan struct {
+	tracer *RecordingTracer
+	span   *RecordedSpan
+}
+
+// SetAttributes implements Span.SetAttributes
+func (s *recordingSpan) SetAttributes(attrs ...SpanAttribute) {
+	s.tracer.mu.Lock()
+	defer s.tracer.mu.Unlock()
+	for _, attr := range attrs {
+		s.span.Attributes[attr.Key] = attr.Value
+	}
+}
+
+// RecordError implements Span.RecordError
+func (s *recordingSpan) RecordError(err error) {
+	s.tracer.mu.Lock()
+	defer s.tracer.mu.Unlock()
+	s.span.Errors = append(s.span.Errors, err)
+}
+
+// End implements Span.End
+func (s *recordingSpan) End() {
+	s.tracer.mu.Lock()
+	defer s.tracer.mu.Unlock()
+	s.span.Ended = true
+}
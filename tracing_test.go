@@ -0,0 +1,149 @@
+package libsql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TracingConfig_Scan(t *testing.T) {
+	ctx := context.Background()
+	const expQuery = "SELECT x FROM aTable WHERE y = 1"
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	rows := newRowsMock(t, 2)
+	defer rows.MinimockFinish()
+
+	tracer := NewRecordingTracer()
+	cfg := TracingConfig{System: "mysql", Namespace: "aDatabase", NormalizeSQL: true}
+	db := newDatabase(sqlDB, newConfig([]Option{WithInterceptors(cfg.Interceptor(tracer))}))
+
+	sqlDB.QueryMock.Set(func(ctx context.Context, query string, args ...interface{}) (sqlRows, error) {
+		require.Equal(t, 1, ctx.Value(recordedSpanKey{}))
+		return rows, nil
+	})
+
+	require.NoError(t, db.Scan(ctx, Into(), expQuery))
+	require.Equal(t, []RecordedSpan{{
+		ID:   1,
+		Name: "SELECT",
+		Attributes: map[string]interface{}{
+			SpanKeySystem:          "mysql",
+			SpanKeyNamespace:       "aDatabase",
+			SpanKeyOperation:       "SELECT",
+			SpanKeyQueryText:       "SELECT x FROM aTable WHERE y = ?",
+			SpanKeyLibsqlOperation: "scan",
+			SpanKeyReturnedRows:    int64(2),
+		},
+		Ended: true,
+	}}, tracer.Spans())
+}
+
+func Test_TracingConfig_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	sqlRows := NewSqlRowsMock(t)
+	defer sqlRows.MinimockFinish()
+
+	sqlRows.NextMock.Return(false)
+	sqlRows.ErrMock.Return(nil)
+	sqlRows.CloseMock.Return(nil)
+
+	expErr := errors.New("a-test-error")
+	sqlDB.QueryMock.Return(sqlRows, nil)
+	sqlDB.ExecMock.Return(nil, expErr)
+
+	tracer := NewRecordingTracer()
+	db := newDatabase(sqlDB, newConfig([]Option{WithInterceptors(TracingConfig{}.Interceptor(tracer))}))
+
+	require.ErrorIs(t, db.ScanOne(ctx, Into(), "SELECT 1"), ErrNoRows)
+	_, err := db.Update(ctx, "UPDATE aTable SET x = 1")
+	require.ErrorIs(t, err, expErr)
+
+	spans := tracer.Spans()
+	require.Len(t, spans, 2)
+	require.Empty(t, spans[0].Errors)
+	require.NotContains(t, spans[0].Attributes, SpanKeyErrorType)
+	require.Equal(t, "UPDATE", spans[1].Name)
+	require.Equal(t, []error{expErr}, spans[1].Errors)
+	require.Equal(t, "*errors.fundamental", spans[1].Attributes[SpanKeyErrorType])
+}
+
+func Test_TracingConfig_Transaction(t *testing.T) {
+	ctx := context.Background()
+	const expQuery = "UPDATE aTable SET x = ?"
+
+	sqlDB := NewSqlDBMock(t)
+	defer sqlDB.MinimockFinish()
+
+	sqlTx := NewSqlTxMock(t)
+	defer sqlTx.MinimockFinish()
+
+	sqlStmt := NewSqlStmtMock(t)
+	defer sqlStmt.MinimockFinish()
+
+	sqlResult := NewSqlResultMock(t)
+	defer sqlResult.MinimockFinish()
+
+	sqlDB.BeginMock.Return(sqlTx, nil)
+	sqlTx.PrepareMock.Return(sqlStmt, nil)
+	sqlTx.CommitMock.Return(nil)
+	sqlTx.RollbackMock.Return(sql.ErrTxDone)
+	sqlStmt.ExecMock.Return(sqlResult, nil)
+	sqlStmt.CloseMock.Return(nil)
+
+	tracer := NewRecordingTracer()
+	db := newDatabase(sqlDB, newConfig([]Option{WithInterceptors(TracingConfig{}.Interceptor(tracer))}))
+
+	err := db.Transaction(ctx, func(tx Transaction) error {
+		return tx.Prepared(ctx, expQuery, func(stmt Statement) error {
+			_, err := stmt.Update(ctx, 42)
+			return err
+		})
+	})
+	require.NoError(t, err)
+
+	type span struct {
+		ID, ParentID int
+		Name         string
+	}
+	var actual []span
+	for _, s := range tracer.Spans() {
+		require.True(t, s.Ended)
+		actual = append(actual, span{ID: s.ID, ParentID: s.ParentID, Name: s.Name})
+	}
+	require.Equal(t, []span{
+		{ID: 1, Name: "transaction"},
+		{ID: 2, ParentID: 1, Name: "BEGIN"},
+		{ID: 3, ParentID: 1, Name: "prepare UPDATE"},
+		{ID: 4, ParentID: 1, Name: "UPDATE"},
+		{ID: 5, ParentID: 1, Name: "COMMIT"},
+	}, actual)
+}
+
+func Test_TracingConfig_NoopTracer(t *testing.T) {
+	intercept := TracingConfig{}.Interceptor(nil)
+	ctx := context.Background()
+	err := intercept(ctx, &Operation{Kind: OperationScan}, func(actualCtx context.Context) error {
+		require.Equal(t, ctx, actualCtx)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func Test_spanName(t *testing.T) {
+	require.Equal(t, "SELECT", spanName(&Operation{Kind: OperationScan, SQL: "/* comment */ select 1"}))
+	require.Equal(t, "SELECT", spanName(&Operation{Kind: OperationScan, SQL: "(SELECT 1) UNION (SELECT 2)"}))
+	require.Equal(t, "prepare INSERT", spanName(&Operation{Kind: OperationPrepare, SQL: "INSERT INTO aTable(x) VALUES (?)"}))
+	require.Equal(t, "ROLLBACK", spanName(&Operation{Kind: OperationRollback}))
+	require.Equal(t, "update", spanName(&Operation{Kind: OperationUpdate}))
+}
@@ -1,9 +1,14 @@
 package libsql
 
-func newTransaction(tx sqlTx) Transaction {
+import (
+	"context"
+	"database/sql"
+)
+
+func newTransaction(tx sqlTx, cfg *config) Transaction {
 	return &transactionImpl{
-		Queryer:  newQueryerMixin(tx),
-		Preparer: newPreparerMixin(tx),
+		Queryer:  newQueryerMixin(tx, cfg),
+		Preparer: newPreparerMixin(tx, cfg),
 	}
 }
 
@@ -13,3 +18,52 @@ type transactionImpl struct {
 }
 
 var _ Transaction = (*transactionImpl)(nil)
+
+// runTransaction begins a transaction and performs work in it.
+// The transaction is committed if work returns nil, and rolled back otherwise.
+func runTransaction(
+	ctx context.Context,
+	beginner sqlBeginner,
+	newTX func(sqlTx, *config) Transaction,
+	work func(Transaction) error,
+	cfg *config,
+) error {
+	return cfg.intercept(ctx, cfg.operation(OperationTransaction, "", nil), func(ctx context.Context) error {
+		var tx sqlTx
+		err := cfg.intercept(ctx, cfg.operation(OperationBegin, "", nil), func(ctx context.Context) error {
+			var err error
+			tx, err = beginner.Begin(ctx)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		txCfg := cfg.forTransaction(ctx)
+		commitAttempted := false
+		rollbackIfNeeded := func() {
+			if commitAttempted {
+				// the transaction is done whether the commit succeeded or not
+				_ = tx.Rollback()
+				return
+			}
+			_ = txCfg.intercept(ctx, txCfg.operation(OperationRollback, "", nil), func(context.Context) error {
+				if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+					// failed to rollback a transaction
+					return err
+				}
+				return nil
+			})
+		}
+		defer rollbackIfNeeded()
+
+		if err := work(newTX(tx, txCfg)); err != nil {
+			return err
+		}
+
+		commitAttempted = true
+		return txCfg.intercept(ctx, txCfg.operation(OperationCommit, "", nil), func(context.Context) error {
+			return tx.Commit()
+		})
+	})
+}
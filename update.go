@@ -15,3 +15,13 @@ func lastInsertID(r sql.Result, err error) (int64, error) {
 	}
 	return r.LastInsertId()
 }
+
+// updateReturning scans the rows returned by a write, without scan limits
+// since the write is done when its rows are scanned
+func updateReturning(scan scanDoer, scanner RowScanner, query func() (sqlRows, error)) (int64, error) {
+	counter := &countingScanner{RowScanner: scanner}
+	if err := scan.Do(counter, false, nil, query); err != nil {
+		return 0, err
+	}
+	return counter.rowsScanned, nil
+}
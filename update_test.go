@@ -46,3 +46,15 @@ func Test_lastInsertID_CallsLastInsertID(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedLastInsertID, actualLastInsertID)
 }
+
+func Test_updateReturning_ReturnsError(t *testing.T) {
+	scanDoer := NewScanDoerMock(t)
+	defer scanDoer.MinimockFinish()
+
+	expErr := errors.New("a-test-error")
+	scanDoer.DoMock.Return(expErr)
+
+	actualRowsAffected, actualError := updateReturning(scanDoer, Into(), nil)
+	require.Equal(t, expErr, actualError)
+	require.Zero(t, actualRowsAffected)
+}